@@ -0,0 +1,12 @@
+data "hcloud_iso" "by_id" {
+  id = 8637
+}
+
+data "hcloud_iso" "by_name" {
+  name              = "Windows-Server-2022-English.iso"
+  with_architecture = "x86"
+}
+
+resource "hcloud_server" "main" {
+  iso = data.hcloud_iso.by_name.id
+}
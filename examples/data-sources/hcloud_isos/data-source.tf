@@ -0,0 +1,7 @@
+data "hcloud_isos" "by_architecture" {
+  with_architecture = ["arm"]
+}
+
+data "hcloud_isos" "private" {
+  with_type = "private"
+}
@@ -0,0 +1,10 @@
+data "hcloud_load_balancer_metrics" "requests" {
+  load_balancer_id = hcloud_load_balancer.main.id
+  type             = "requests_per_second"
+  start            = timeadd(plantimestamp(), "-24h")
+  end              = plantimestamp()
+}
+
+output "requests_per_second" {
+  value = [for v in data.hcloud_load_balancer_metrics.requests.time_series["requests_per_second"].values : tonumber(v.value)]
+}
@@ -0,0 +1,19 @@
+locals {
+  domain_names = ["example.com", "*.example.com"]
+}
+
+data "hcloud_managed_certificate_dns_validation" "main" {
+  domain_names = local.domain_names
+}
+
+resource "hcloud_managed_certificate" "main" {
+  name         = "example"
+  domain_names = local.domain_names
+
+  lifecycle {
+    precondition {
+      condition     = data.hcloud_managed_certificate_dns_validation.main.valid
+      error_message = "The domains ${join(", ", [for d in data.hcloud_managed_certificate_dns_validation.main.domains : d.domain_name if !d.valid])} cannot be validated."
+    }
+  }
+}
@@ -0,0 +1,21 @@
+data "hcloud_pricing" "main" {}
+
+locals {
+  server_type = "cpx22"
+  location    = "fsn1"
+
+  server_monthly_price = one(flatten([
+    for server_type in data.hcloud_pricing.main.server_types : [
+      for price in server_type.prices : tonumber(price.price_monthly.gross)
+      if price.location == local.location
+    ]
+    if server_type.name == local.server_type
+  ]))
+}
+
+check "budget" {
+  assert {
+    condition     = local.server_monthly_price <= 10
+    error_message = "The server costs ${local.server_monthly_price} ${data.hcloud_pricing.main.currency} per month, which exceeds the budget."
+  }
+}
@@ -0,0 +1,19 @@
+data "hcloud_server_metrics" "cpu" {
+  server_id = hcloud_server.main.id
+  type      = "cpu"
+  start     = timeadd(plantimestamp(), "-1h")
+  end       = plantimestamp()
+  step      = 60
+}
+
+locals {
+  cpu_values = [for v in data.hcloud_server_metrics.cpu.time_series["cpu"].values : tonumber(v.value)]
+  cpu_max    = length(local.cpu_values) > 0 ? max(local.cpu_values...) : 0
+}
+
+check "cpu_usage" {
+  assert {
+    condition     = local.cpu_max < 90
+    error_message = "The server CPU usage reached ${local.cpu_max}% in the last hour, consider a larger server type."
+  }
+}
@@ -0,0 +1,8 @@
+data "hcloud_zone_file" "example" {
+  zone = "example.com"
+}
+
+resource "local_file" "example" {
+  filename = "${path.module}/example.com.zone"
+  content  = data.hcloud_zone_file.example.zonefile
+}
@@ -0,0 +1,17 @@
+resource "hcloud_server" "main" {
+  name        = "my-server"
+  server_type = "cx23"
+  image       = "debian-12"
+  location    = "fsn1"
+}
+
+ephemeral "hcloud_server_rescue" "main" {
+  server_id = hcloud_server.main.id
+  type      = "linux64"
+}
+
+provider "ssh" {
+  host     = hcloud_server.main.ipv4_address
+  user     = "root"
+  password = ephemeral.hcloud_server_rescue.main.root_password
+}
@@ -0,0 +1,20 @@
+resource "hcloud_server" "main" {
+  name        = "my-server"
+  server_type = "cx23"
+  image       = "debian-12"
+  location    = "fsn1"
+}
+
+ephemeral "hcloud_server_root_password" "main" {
+  server_id = hcloud_server.main.id
+}
+
+resource "vault_kv_secret_v2" "main" {
+  mount = "secret"
+  name  = "servers/my-server"
+
+  data_json_wo = jsonencode({
+    root_password = ephemeral.hcloud_server_root_password.main.root_password
+  })
+  data_json_wo_version = 1
+}
@@ -0,0 +1,9 @@
+resource "hcloud_zone_rrset" "example_caa" {
+  zone = hcloud_zone.example.name
+  name = "@"
+  type = "CAA"
+  records = [
+    { value = provider::hcloud::caa_record(0, "issue", "letsencrypt.org") },
+    { value = provider::hcloud::caa_record(0, "iodef", "mailto:security@example.com") },
+  ]
+}
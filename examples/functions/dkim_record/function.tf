@@ -0,0 +1,13 @@
+resource "tls_private_key" "dkim" {
+  algorithm = "RSA"
+  rsa_bits  = 2048
+}
+
+resource "hcloud_zone_rrset" "example_dkim" {
+  zone = hcloud_zone.example.name
+  name = "mail._domainkey"
+  type = "TXT"
+  records = [
+    { value = provider::hcloud::dkim_record("rsa", tls_private_key.dkim.public_key_pem) },
+  ]
+}
@@ -0,0 +1,8 @@
+resource "hcloud_zone_rrset" "example_dmarc" {
+  zone = hcloud_zone.example.name
+  name = "_dmarc"
+  type = "TXT"
+  records = [
+    { value = provider::hcloud::dmarc_record("quarantine", { rua = "mailto:dmarc@example.com", pct = "100" }) },
+  ]
+}
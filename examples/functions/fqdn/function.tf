@@ -0,0 +1,8 @@
+resource "hcloud_zone_rrset" "example_cname" {
+  zone = hcloud_zone.example.name
+  name = "blog"
+  type = "CNAME"
+  records = [
+    { value = provider::hcloud::fqdn("www", hcloud_zone.example.name) },
+  ]
+}
@@ -0,0 +1,13 @@
+variable "ip_range" {
+  type = string
+
+  validation {
+    condition     = provider::hcloud::is_private_range(var.ip_range)
+    error_message = "The IP range must be a private range of RFC1918."
+  }
+}
+
+resource "hcloud_network" "example" {
+  name     = "example"
+  ip_range = var.ip_range
+}
@@ -0,0 +1,9 @@
+resource "hcloud_zone_rrset" "example_mx" {
+  zone = hcloud_zone.example.name
+  name = "@"
+  type = "MX"
+  records = [
+    { value = provider::hcloud::mx_record(10, "mail1.example.com") },
+    { value = provider::hcloud::mx_record(20, "mail2.example.com") },
+  ]
+}
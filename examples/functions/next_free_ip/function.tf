@@ -0,0 +1,15 @@
+locals {
+  server_ip = provider::hcloud::next_free_ip(hcloud_network_subnet.example.ip_range, [])
+}
+
+resource "hcloud_server_network" "example" {
+  server_id  = hcloud_server.example.id
+  network_id = hcloud_network.example.id
+  ip         = local.server_ip
+}
+
+resource "hcloud_load_balancer_network" "example" {
+  load_balancer_id = hcloud_load_balancer.example.id
+  network_id       = hcloud_network.example.id
+  ip               = provider::hcloud::next_free_ip(hcloud_network_subnet.example.ip_range, [local.server_ip])
+}
@@ -0,0 +1,9 @@
+data "hcloud_zone_rrset" "example" {
+  zone = "example.com"
+  name = "@"
+  type = "TXT"
+}
+
+output "example_txt_values" {
+  value = [for record in data.hcloud_zone_rrset.example.records : provider::hcloud::parse_txt_record(record.value)]
+}
@@ -0,0 +1,8 @@
+resource "hcloud_zone_rrset" "example" {
+  zone = hcloud_zone.example.name
+  name = provider::hcloud::relative_name("www.example.com", hcloud_zone.example.name)
+  type = "A"
+  records = [
+    { value = "203.0.113.10" },
+  ]
+}
@@ -0,0 +1,8 @@
+resource "hcloud_zone_rrset" "example_spf" {
+  zone = hcloud_zone.example.name
+  name = "@"
+  type = "TXT"
+  records = [
+    { value = provider::hcloud::spf_record(["include:_spf.example.net", "ip4:203.0.113.0/24"], "~") },
+  ]
+}
@@ -0,0 +1,8 @@
+resource "hcloud_zone_rrset" "example_srv" {
+  zone = hcloud_zone.example.name
+  name = "_sip._tcp"
+  type = "SRV"
+  records = [
+    { value = provider::hcloud::srv_record(10, 5, 5060, "sip.example.com") },
+  ]
+}
@@ -0,0 +1,18 @@
+resource "hcloud_network" "example" {
+  name     = "example"
+  ip_range = "10.0.0.0/16"
+}
+
+resource "hcloud_network_subnet" "web" {
+  network_id   = hcloud_network.example.id
+  type         = "cloud"
+  network_zone = "eu-central"
+  ip_range     = provider::hcloud::subnet_allocate(hcloud_network.example.ip_range, 24, [])
+}
+
+resource "hcloud_network_subnet" "db" {
+  network_id   = hcloud_network.example.id
+  type         = "cloud"
+  network_zone = "eu-central"
+  ip_range     = provider::hcloud::subnet_allocate(hcloud_network.example.ip_range, 24, [hcloud_network_subnet.web.ip_range])
+}
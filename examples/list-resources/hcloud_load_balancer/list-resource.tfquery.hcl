@@ -0,0 +1,7 @@
+list "hcloud_load_balancer" "prod" {
+  provider = hcloud
+
+  config {
+    with_selector = "env=prod"
+  }
+}
@@ -0,0 +1,7 @@
+list "hcloud_network" "prod" {
+  provider = hcloud
+
+  config {
+    with_selector = "env=prod"
+  }
+}
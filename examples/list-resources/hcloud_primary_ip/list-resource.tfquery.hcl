@@ -0,0 +1,7 @@
+list "hcloud_primary_ip" "prod" {
+  provider = hcloud
+
+  config {
+    with_selector = "env=prod"
+  }
+}
@@ -0,0 +1,7 @@
+list "hcloud_storage_box" "prod" {
+  provider = hcloud
+
+  config {
+    with_selector = "env=prod"
+  }
+}
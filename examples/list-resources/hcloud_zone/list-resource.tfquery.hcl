@@ -0,0 +1,3 @@
+list "hcloud_zone" "all" {
+  provider = hcloud
+}
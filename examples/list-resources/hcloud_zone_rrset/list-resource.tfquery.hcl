@@ -0,0 +1,7 @@
+list "hcloud_zone_rrset" "example" {
+  provider = hcloud
+
+  config {
+    zone = "example.com"
+  }
+}
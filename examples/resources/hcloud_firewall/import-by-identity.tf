@@ -0,0 +1,6 @@
+import {
+  to = hcloud_firewall.example
+  identity = {
+    id = 123456
+  }
+}
@@ -0,0 +1,25 @@
+resource "hcloud_image" "talos" {
+  url         = "https://github.com/siderolabs/talos/releases/download/v1.10.5/hcloud-amd64.raw.xz"
+  compression = "xz"
+
+  architecture = "x86"
+  description  = "Talos v1.10.5"
+  labels = {
+    os      = "talos"
+    version = "v1.10.5"
+  }
+}
+
+resource "hcloud_image" "local" {
+  file   = "${path.module}/build/image.qcow2"
+  format = "qcow2"
+
+  architecture = "arm"
+  description  = "Custom image"
+}
+
+resource "hcloud_server" "main" {
+  name        = "talos"
+  server_type = "cpx22"
+  image       = hcloud_image.talos.id
+}
@@ -0,0 +1,6 @@
+import {
+  to = hcloud_load_balancer.example
+  identity = {
+    id = 123456
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = hcloud_load_balancer_network.attachment
+  identity = {
+    load_balancer_id = 123456
+    network_id       = 654321
+  }
+}
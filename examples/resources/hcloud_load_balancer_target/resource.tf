@@ -14,4 +14,22 @@ resource "hcloud_load_balancer_target" "load_balancer_target" {
   type             = "server"
   load_balancer_id = hcloud_load_balancer.load_balancer.id
   server_id        = hcloud_server.my_server.id
+
+  # Wait for the target to pass the health checks of the services.
+  wait_for_healthy_targets = true
+}
+
+check "load_balancer_targets_healthy" {
+  data "hcloud_load_balancer" "load_balancer" {
+    id = hcloud_load_balancer.load_balancer.id
+  }
+
+  assert {
+    condition = alltrue(flatten([
+      for target in data.hcloud_load_balancer.load_balancer.target : [
+        for status in target.health_status : status.status == "healthy"
+      ]
+    ]))
+    error_message = "Some targets of the Load Balancer are not healthy."
+  }
 }
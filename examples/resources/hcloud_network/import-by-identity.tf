@@ -0,0 +1,6 @@
+import {
+  to = hcloud_network.example
+  identity = {
+    id = 123456
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = hcloud_primary_ip.example
+  identity = {
+    id = 123456
+  }
+}
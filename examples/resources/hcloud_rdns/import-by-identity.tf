@@ -0,0 +1,7 @@
+import {
+  to = hcloud_rdns.example
+  identity = {
+    server_id  = 123456
+    ip_address = "203.0.113.10"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = hcloud_server_network.example
+  identity = {
+    server_id  = 123456
+    network_id = 654321
+  }
+}
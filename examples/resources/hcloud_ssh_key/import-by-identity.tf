@@ -0,0 +1,6 @@
+import {
+  to = hcloud_ssh_key.main
+  identity = {
+    id = 123456
+  }
+}
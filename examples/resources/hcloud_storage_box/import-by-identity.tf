@@ -0,0 +1,6 @@
+import {
+  to = hcloud_storage_box.example
+  identity = {
+    id = 123456
+  }
+}
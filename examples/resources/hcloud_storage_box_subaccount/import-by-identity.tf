@@ -0,0 +1,7 @@
+import {
+  to = hcloud_storage_box_subaccount.example
+  identity = {
+    storage_box_id = 123456
+    id             = 654321
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = hcloud_volume.example
+  identity = {
+    id = 123456
+  }
+}
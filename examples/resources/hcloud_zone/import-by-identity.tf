@@ -0,0 +1,6 @@
+import {
+  to = hcloud_zone.example_primary
+  identity = {
+    id = 123456
+  }
+}
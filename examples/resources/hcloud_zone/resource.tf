@@ -44,3 +44,10 @@ resource "hcloud_zone" "example_idna" {
   name = provider::hcloud::idna("exämple-🍪.com")
   mode = "primary"
 }
+
+resource "hcloud_zone" "example_zonefile" {
+  name = "example.org"
+  mode = "primary"
+
+  zonefile = file("${path.module}/example.org.zone")
+}
@@ -0,0 +1,8 @@
+import {
+  to = hcloud_zone_rrset.example
+  identity = {
+    zone = "example.com"
+    name = "www"
+    type = "A"
+  }
+}
@@ -0,0 +1,4 @@
+import {
+  to = hcloud_zone_rrsets.example
+  id = "$ZONE_ID_OR_NAME"
+}
@@ -0,0 +1 @@
+terraform import hcloud_zone_rrsets.example "$ZONE_ID_OR_NAME"
@@ -0,0 +1,34 @@
+resource "hcloud_zone" "example" {
+  name = "example.com"
+  mode = "primary"
+}
+
+resource "hcloud_zone_rrsets" "example" {
+  zone      = hcloud_zone.example.name
+  exclusive = true
+
+  rrsets = {
+    "@/A" = {
+      records = [
+        { value = "201.78.10.45" },
+      ]
+    }
+    "www/A" = {
+      ttl = 3600
+      records = [
+        { value = "201.78.10.45", comment = "web server 1" },
+        { value = "201.78.10.46", comment = "web server 2" },
+      ]
+    }
+    "@/MX" = {
+      records = [
+        { value = provider::hcloud::mx_record(10, "mail.example.com") },
+      ]
+    }
+    "@/TXT" = {
+      records = [
+        { value = provider::hcloud::spf_record(["mx"], "-") },
+      ]
+    }
+  }
+}
@@ -0,0 +1,16 @@
+resource "hcloud_zone_tsig_key" "example" {
+  algorithm = "hmac-sha256"
+}
+
+resource "hcloud_zone" "example" {
+  name = "example.com"
+  mode = "secondary"
+
+  primary_nameservers = [
+    {
+      address        = "203.0.113.10"
+      tsig_algorithm = hcloud_zone_tsig_key.example.algorithm
+      tsig_key       = hcloud_zone_tsig_key.example.key
+    },
+  ]
+}
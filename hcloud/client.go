@@ -0,0 +1,47 @@
+package hcloud
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+)
+
+// defaultMaxRetries matches the default of the hcloud-go client.
+const defaultMaxRetries = 5
+
+const (
+	maxRetriesDescription            = "The maximum number of times a failed request, including a rate limited request, is retried. Default `5`."
+	retryOnRateLimitDescription      = "Whether requests are delayed while the rate limit is exhausted, as indicated by the `RateLimit-Reset` header, so rate limited requests are only retried once the rate limit allows it. When disabled, rate limited requests are retried using an exponential backoff only. Default `true`."
+	maxConcurrentRequestsDescription = "The maximum number of requests sent to the API at the same time. Default `0`, which means no limit. Decrease this value if you run into rate limiting errors."
+)
+
+const (
+	projectsDescription        = "Additional projects the resources and data sources can be managed in, using their `project` attribute."
+	projectNameDescription     = "Name of the project, referenced by the `project` attribute of the resources and data sources."
+	projectTokenDescription    = "The API token of the project."
+	projectEndpointDescription = "The Hetzner Cloud API endpoint of the project, can be used to override the `endpoint` of the provider."
+)
+
+// clientTransportOpts returns the client options used to retry, budget and route
+// the requests sent to the API. The options are shared by the SDKv2 and the
+// plugin framework providers.
+//
+// Only the hcloud client retries the failed requests, the transport delays the
+// requests while the rate limit is exhausted.
+func clientTransportOpts(opts hcloudutil.TransportOpts, endpoint string, projects []hcloudutil.Project) []hcloud.ClientOption {
+	if endpoint == "" {
+		endpoint = hcloud.Endpoint
+	}
+	endpoint = strings.TrimRight(endpoint, "/")
+
+	return []hcloud.ClientOption{
+		hcloud.WithRetryOpts(hcloud.RetryOpts{MaxRetries: opts.MaxRetries}),
+		hcloud.WithHTTPClient(&http.Client{
+			Transport: hcloudutil.NewProjectTransport(endpoint, projects, func() http.RoundTripper {
+				return hcloudutil.NewTransport(http.DefaultTransport, opts)
+			}),
+		}),
+	}
+}
@@ -0,0 +1,55 @@
+package hcloud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+)
+
+func TestClientTransportOptsRateLimitRetries(t *testing.T) {
+	for _, retryOnRateLimit := range []bool{true, false} {
+		t.Run("retry_on_rate_limit="+strconv.FormatBool(retryOnRateLimit), func(t *testing.T) {
+			var requests atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				requests.Add(1)
+
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("RateLimit-Limit", "3600")
+				w.Header().Set("RateLimit-Remaining", "0")
+				w.Header().Set("RateLimit-Reset", strconv.FormatInt(time.Now().Unix(), 10))
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(`{"error":{"code":"rate_limit_exceeded","message":"limit of 3600 requests per hour reached"}}`))
+			}))
+			defer server.Close()
+
+			opts := []hcloud.ClientOption{
+				hcloud.WithEndpoint(server.URL),
+				// Speed up the retries, the max retries are set below.
+				hcloud.WithRetryOpts(hcloud.RetryOpts{BackoffFunc: hcloud.ConstantBackoff(0)}),
+			}
+			opts = append(opts, clientTransportOpts(hcloudutil.TransportOpts{
+				MaxRetries:       3,
+				RetryOnRateLimit: retryOnRateLimit,
+			}, server.URL, nil)...)
+
+			client := hcloud.NewClient(opts...)
+
+			_, _, err := client.Server.GetByID(t.Context(), 1)
+			require.Error(t, err)
+			assert.True(t, hcloud.IsError(err, hcloud.ErrorCodeRateLimitExceeded))
+
+			// Only the hcloud client retries the rate limited requests.
+			assert.Equal(t, int32(3+1), requests.Load())
+		})
+	}
+}
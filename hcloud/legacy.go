@@ -0,0 +1,52 @@
+package hcloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/server"
+)
+
+// legacyTypeSystemResources are the resources that store a value in the state
+// that differs from the configuration, like their SDKv2 implementation did.
+//
+// The hcloud_server resource only stores a hash of the `user_data` in the state,
+// Terraform only tolerates this for providers using the legacy type system.
+var legacyTypeSystemResources = map[string]bool{
+	server.ResourceType: true,
+}
+
+// legacyTypeSystemServer marks the plan and apply responses of the
+// [legacyTypeSystemResources] as using the legacy type system.
+type legacyTypeSystemServer struct {
+	providerServer
+}
+
+func newLegacyTypeSystemServer(server tfprotov6.ProviderServer) tfprotov6.ProviderServer {
+	s, ok := server.(providerServer)
+	if !ok {
+		return server
+	}
+	return &legacyTypeSystemServer{providerServer: s}
+}
+
+func (s *legacyTypeSystemServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	resp, err := s.providerServer.PlanResourceChange(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	resp.UnsafeToUseLegacyTypeSystem = resp.UnsafeToUseLegacyTypeSystem || legacyTypeSystemResources[req.TypeName]
+	return resp, nil
+}
+
+func (s *legacyTypeSystemServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	resp, err := s.providerServer.ApplyResourceChange(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	resp.UnsafeToUseLegacyTypeSystem = resp.UnsafeToUseLegacyTypeSystem || legacyTypeSystemResources[req.TypeName]
+	return resp, nil
+}
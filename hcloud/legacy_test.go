@@ -0,0 +1,83 @@
+package hcloud
+
+import (
+	"crypto/sha1" // nolint: gosec
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMuxedProviderServerUserDataHash(t *testing.T) {
+	ctx := t.Context()
+
+	provider, schemaResp := configuredMuxedProvider(t, http.NewServeMux())
+	serverType := schemaResp.ResourceSchemas["hcloud_server"].ValueType()
+
+	sum := sha1.Sum([]byte("#cloud-config\n")) // nolint: gosec
+	hash := base64.StdEncoding.EncodeToString(sum[:])
+
+	// values returns the values of the Server, with the given user data.
+	values := func(userData string, state bool) map[string]tftypes.Value {
+		result := map[string]tftypes.Value{
+			"name":        tftypes.NewValue(tftypes.String, "server"),
+			"server_type": tftypes.NewValue(tftypes.String, "cx23"),
+			"image":       tftypes.NewValue(tftypes.String, "ubuntu-24.04"),
+			"user_data":   tftypes.NewValue(tftypes.String, userData),
+		}
+		if state {
+			result["id"] = tftypes.NewValue(tftypes.Number, 42)
+		}
+		return result
+	}
+
+	for _, tc := range []struct {
+		name            string
+		userData        string
+		wantPlanned     string
+		wantReplacement bool
+	}{
+		{
+			name:        "unchanged",
+			userData:    "#cloud-config\n",
+			wantPlanned: hash,
+		},
+		{
+			name:            "changed",
+			userData:        "#cloud-config\nruncmd: []\n",
+			wantPlanned:     "#cloud-config\nruncmd: []\n",
+			wantReplacement: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// The state migrated from the SDKv2 resource holds a hash of the user data.
+			resp, err := provider.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+				TypeName:         "hcloud_server",
+				PriorState:       dynamicValue(t, serverType, values(hash, true)),
+				ProposedNewState: dynamicValue(t, serverType, values(tc.userData, true)),
+				Config:           dynamicValue(t, serverType, values(tc.userData, false)),
+			})
+			require.NoError(t, err)
+			require.Empty(t, resp.Diagnostics)
+
+			// Terraform only tolerates a state differing from the configuration
+			// for providers using the legacy type system.
+			assert.True(t, resp.UnsafeToUseLegacyTypeSystem)
+
+			planned, err := resp.PlannedState.Unmarshal(serverType)
+			require.NoError(t, err)
+			attributes := map[string]tftypes.Value{}
+			require.NoError(t, planned.As(&attributes))
+
+			var userData string
+			require.NoError(t, attributes["user_data"].As(&userData))
+			assert.Equal(t, tc.wantPlanned, userData)
+
+			assert.Equal(t, tc.wantReplacement, len(resp.RequiresReplace) > 0)
+		})
+	}
+}
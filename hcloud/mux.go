@@ -30,5 +30,7 @@ func GetMuxedProvider(ctx context.Context) (func() tfprotov6.ProviderServer, err
 		return nil, err
 	}
 
-	return muxServer.ProviderServer, nil
+	return func() tfprotov6.ProviderServer {
+		return newProjectServer(newLegacyTypeSystemServer(muxServer.ProviderServer()))
+	}, nil
 }
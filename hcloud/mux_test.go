@@ -2,10 +2,17 @@ package hcloud
 
 import (
 	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMuxedProviderSchema(t *testing.T) {
@@ -21,3 +28,571 @@ func TestMuxedProviderSchema(t *testing.T) {
 
 	assert.Len(t, resp.Diagnostics, 0)
 }
+
+// dynamicValue returns a value for the given object type, with all the
+// attributes not present in values set to null.
+func dynamicValue(t *testing.T, ty tftypes.Type, values map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range ty.(tftypes.Object).AttributeTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		} else {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+
+	value, err := tfprotov6.NewDynamicValue(ty, tftypes.NewValue(ty, attributes))
+	require.NoError(t, err)
+	return &value
+}
+
+// configuredMuxedProvider returns the muxed provider configured to send the API
+// requests to the given handler.
+func configuredMuxedProvider(t *testing.T, handler http.Handler) (tfprotov6.ProviderServer, *tfprotov6.GetProviderSchemaResponse) {
+	t.Helper()
+
+	return configuredMuxedProviderWithConfig(t, handler, nil)
+}
+
+// configuredMuxedProviderWithConfig returns the muxed provider configured to send
+// the API requests to the given handler, using the additional provider config.
+func configuredMuxedProviderWithConfig(
+	t *testing.T,
+	handler http.Handler,
+	config func(schema *tfprotov6.Schema) map[string]tftypes.Value,
+) (tfprotov6.ProviderServer, *tfprotov6.GetProviderSchemaResponse) {
+	t.Helper()
+
+	ctx := t.Context()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	providerFactory, err := GetMuxedProvider(ctx)
+	require.NoError(t, err)
+	provider := providerFactory()
+
+	schemaResp, err := provider.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, schemaResp.Diagnostics)
+
+	values := map[string]tftypes.Value{
+		"token":    tftypes.NewValue(tftypes.String, "token"),
+		"endpoint": tftypes.NewValue(tftypes.String, server.URL),
+	}
+	if config != nil {
+		maps.Copy(values, config(schemaResp.Provider))
+	}
+
+	configureResp, err := provider.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: dynamicValue(t, schemaResp.Provider.ValueType(), values),
+	})
+	require.NoError(t, err)
+	require.Empty(t, configureResp.Diagnostics)
+
+	return provider, schemaResp
+}
+
+func TestMuxedProviderListResource(t *testing.T) {
+	ctx := t.Context()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /volumes", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "env=prod", r.URL.Query().Get("label_selector"))
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"volumes": []map[string]any{
+				{
+					"id":           1,
+					"name":         "volume-1",
+					"size":         10,
+					"location":     map[string]any{"name": "fsn1"},
+					"labels":       map[string]string{"env": "prod"},
+					"linux_device": "/dev/disk/by-id/scsi-0HC_Volume_1",
+					"protection":   map[string]any{"delete": true},
+					"server":       42,
+				},
+			},
+		})
+	})
+	mux.HandleFunc("GET /primary_ips", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"primary_ips": []map[string]any{
+				{
+					"id":            2,
+					"name":          "primary-ip-1",
+					"ip":            "131.232.99.1",
+					"type":          "ipv4",
+					"assignee_id":   42,
+					"assignee_type": "server",
+					"auto_delete":   false,
+					"location":      map[string]any{"name": "fsn1"},
+					"labels":        map[string]string{},
+					"protection":    map[string]any{"delete": false},
+				},
+			},
+		})
+	})
+	server, schemaResp := configuredMuxedProvider(t, mux)
+	provider, ok := server.(tfprotov6.ProviderServerWithListResource)
+	require.True(t, ok)
+
+	for _, tc := range []struct {
+		typeName  string
+		config    map[string]tftypes.Value
+		name      string
+		id        int64
+		attribute string
+		expected  tftypes.Value
+	}{
+		{
+			typeName:  "hcloud_volume",
+			config:    map[string]tftypes.Value{"with_selector": tftypes.NewValue(tftypes.String, "env=prod")},
+			name:      "volume-1",
+			id:        1,
+			attribute: "linux_device",
+			expected:  tftypes.NewValue(tftypes.String, "/dev/disk/by-id/scsi-0HC_Volume_1"),
+		},
+		{
+			typeName:  "hcloud_primary_ip",
+			name:      "primary-ip-1",
+			id:        2,
+			attribute: "ip_address",
+			expected:  tftypes.NewValue(tftypes.String, "131.232.99.1"),
+		},
+	} {
+		t.Run(tc.typeName, func(t *testing.T) {
+			resourceType := schemaResp.ResourceSchemas[tc.typeName].ValueType()
+
+			resp, err := provider.ListResource(ctx, &tfprotov6.ListResourceRequest{
+				TypeName:        tc.typeName,
+				Config:          dynamicValue(t, schemaResp.ListResourceSchemas[tc.typeName].ValueType(), tc.config),
+				IncludeResource: true,
+				Limit:           10,
+			})
+			require.NoError(t, err)
+
+			results := []tfprotov6.ListResourceResult{}
+			for result := range resp.Results {
+				results = append(results, result)
+			}
+			require.Len(t, results, 1)
+			result := results[0]
+
+			require.Empty(t, result.Diagnostics)
+			assert.Equal(t, tc.name, result.DisplayName)
+
+			identityType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.Number, "project": tftypes.String}}
+			identity, err := result.Identity.IdentityData.Unmarshal(identityType)
+			require.NoError(t, err)
+			assert.True(t, identity.Equal(tftypes.NewValue(identityType, map[string]tftypes.Value{
+				"id":      tftypes.NewValue(tftypes.Number, tc.id),
+				"project": tftypes.NewValue(tftypes.String, nil),
+			})))
+
+			value, err := result.Resource.Unmarshal(resourceType)
+			require.NoError(t, err)
+
+			attributes := map[string]tftypes.Value{}
+			require.NoError(t, value.As(&attributes))
+			assert.True(t, attributes[tc.attribute].Equal(tc.expected), attributes[tc.attribute].String())
+		})
+	}
+}
+
+func TestMuxedProviderEphemeralResource(t *testing.T) {
+	ctx := t.Context()
+
+	action := map[string]any{
+		"id":       1,
+		"status":   "success",
+		"progress": 100,
+		"started":  "2025-01-01T00:00:00Z",
+		"finished": "2025-01-01T00:00:01Z",
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /servers/42/actions/reset_password", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"action":        action,
+			"root_password": "root-password",
+		})
+	})
+	mux.HandleFunc("POST /servers/42/actions/enable_rescue", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "linux64", body["type"])
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"action":        action,
+			"root_password": "rescue-password",
+		})
+	})
+
+	provider, schemaResp := configuredMuxedProvider(t, mux)
+
+	for _, tc := range []struct {
+		typeName string
+		expected string
+	}{
+		{typeName: "hcloud_server_root_password", expected: "root-password"},
+		{typeName: "hcloud_server_rescue", expected: "rescue-password"},
+	} {
+		t.Run(tc.typeName, func(t *testing.T) {
+			ty := schemaResp.EphemeralResourceSchemas[tc.typeName].ValueType()
+
+			resp, err := provider.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+				TypeName: tc.typeName,
+				Config: dynamicValue(t, ty, map[string]tftypes.Value{
+					"server_id": tftypes.NewValue(tftypes.Number, 42),
+				}),
+			})
+			require.NoError(t, err)
+			require.Empty(t, resp.Diagnostics)
+
+			value, err := resp.Result.Unmarshal(ty)
+			require.NoError(t, err)
+
+			attributes := map[string]tftypes.Value{}
+			require.NoError(t, value.As(&attributes))
+			assert.True(t, attributes["root_password"].Equal(tftypes.NewValue(tftypes.String, tc.expected)), attributes["root_password"].String())
+		})
+	}
+}
+
+// projectsConfig returns the provider config with the projects "shared", using
+// the default endpoint, and "other", using the given endpoint.
+func projectsConfig(otherEndpoint string) func(schema *tfprotov6.Schema) map[string]tftypes.Value {
+	return func(schema *tfprotov6.Schema) map[string]tftypes.Value {
+		projectsType := schema.ValueType().(tftypes.Object).AttributeTypes["projects"].(tftypes.Set)
+		projectType := projectsType.ElementType.(tftypes.Object)
+
+		return map[string]tftypes.Value{
+			"projects": tftypes.NewValue(projectsType, []tftypes.Value{
+				tftypes.NewValue(projectType, map[string]tftypes.Value{
+					"name":     tftypes.NewValue(tftypes.String, "shared"),
+					"token":    tftypes.NewValue(tftypes.String, "shared-token"),
+					"endpoint": tftypes.NewValue(tftypes.String, nil),
+				}),
+				tftypes.NewValue(projectType, map[string]tftypes.Value{
+					"name":     tftypes.NewValue(tftypes.String, "other"),
+					"token":    tftypes.NewValue(tftypes.String, "other-token"),
+					"endpoint": tftypes.NewValue(tftypes.String, otherEndpoint),
+				}),
+			}),
+		}
+	}
+}
+
+func TestMuxedProviderProjects(t *testing.T) {
+	ctx := t.Context()
+
+	var mu sync.Mutex
+	authorizations := map[string]string{}
+
+	handler := func(host string) http.Handler {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /ssh_keys/1", func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			authorizations["hcloud_ssh_key@"+host] = r.Header.Get("Authorization")
+			mu.Unlock()
+
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"ssh_key": map[string]any{
+					"id":          1,
+					"name":        "ssh-key-1",
+					"fingerprint": "b7:2f:30:a0:2f:6c:58:6c:21:04:58:61:ba:06:3b:2f",
+					"public_key":  "ssh-ed25519 AAAA",
+					"labels":      map[string]string{},
+				},
+			})
+		})
+		mux.HandleFunc("GET /networks/1", func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			authorizations["hcloud_network@"+host] = r.Header.Get("Authorization")
+			mu.Unlock()
+
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"network": map[string]any{
+					"id":         1,
+					"name":       "network-1",
+					"ip_range":   "10.0.0.0/16",
+					"subnets":    []any{},
+					"routes":     []any{},
+					"servers":    []any{},
+					"labels":     map[string]string{},
+					"protection": map[string]any{"delete": false},
+				},
+			})
+		})
+		return mux
+	}
+
+	other := httptest.NewServer(handler("other"))
+	t.Cleanup(other.Close)
+
+	provider, schemaResp := configuredMuxedProviderWithConfig(t, handler("default"), projectsConfig(other.URL))
+
+	for _, tc := range []struct {
+		project       string
+		host          string
+		authorization string
+	}{
+		{project: "", host: "default", authorization: "Bearer token"},
+		{project: "shared", host: "default", authorization: "Bearer shared-token"},
+		{project: "other", host: "other", authorization: "Bearer other-token"},
+	} {
+		for _, typeName := range []string{"hcloud_ssh_key", "hcloud_network"} {
+			t.Run(typeName+"/"+tc.project, func(t *testing.T) {
+				clear(authorizations)
+
+				config := map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.Number, 1),
+				}
+				if tc.project != "" {
+					config["project"] = tftypes.NewValue(tftypes.String, tc.project)
+				}
+
+				resp, err := provider.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{
+					TypeName: typeName,
+					Config:   dynamicValue(t, schemaResp.DataSourceSchemas[typeName].ValueType(), config),
+				})
+				require.NoError(t, err)
+				require.Empty(t, resp.Diagnostics)
+
+				assert.Equal(t, map[string]string{typeName + "@" + tc.host: tc.authorization}, authorizations)
+			})
+		}
+	}
+
+	t.Run("unknown project", func(t *testing.T) {
+		resp, err := provider.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{
+			TypeName: "hcloud_ssh_key",
+			Config: dynamicValue(t, schemaResp.DataSourceSchemas["hcloud_ssh_key"].ValueType(), map[string]tftypes.Value{
+				"id":      tftypes.NewValue(tftypes.Number, 1),
+				"project": tftypes.NewValue(tftypes.String, "unknown"),
+			}),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Diagnostics, 1)
+		assert.Contains(t, resp.Diagnostics[0].Detail, `project "unknown" is not configured in the provider projects`)
+	})
+}
+
+func TestMuxedProviderProjectsRouting(t *testing.T) {
+	ctx := t.Context()
+
+	var mu sync.Mutex
+	requests := []string{}
+
+	handler := func(host string) http.Handler {
+		record := func(r *http.Request) {
+			mu.Lock()
+			requests = append(requests, r.Method+" "+r.URL.Path+"@"+host)
+			mu.Unlock()
+		}
+
+		action := map[string]any{
+			"id":       1,
+			"status":   "success",
+			"progress": 100,
+			"started":  "2025-01-01T00:00:00Z",
+			"finished": "2025-01-01T00:00:01Z",
+		}
+		network := map[string]any{
+			"id":         1,
+			"name":       "network-1",
+			"ip_range":   "10.0.0.0/16",
+			"subnets":    []any{},
+			"routes":     []any{},
+			"servers":    []any{},
+			"labels":     map[string]string{},
+			"protection": map[string]any{"delete": false},
+		}
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /networks", func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			_ = json.NewEncoder(w).Encode(map[string]any{"networks": []any{network}})
+		})
+		mux.HandleFunc("GET /networks/1", func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			_ = json.NewEncoder(w).Encode(map[string]any{"network": network})
+		})
+		mux.HandleFunc("POST /servers/42/actions/poweron", func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			_ = json.NewEncoder(w).Encode(map[string]any{"action": action})
+		})
+		mux.HandleFunc("GET /actions", func(w http.ResponseWriter, _ *http.Request) {
+			_ = json.NewEncoder(w).Encode(map[string]any{"actions": []any{action}})
+		})
+		mux.HandleFunc("POST /servers/42/actions/reset_password", func(w http.ResponseWriter, r *http.Request) {
+			record(r)
+			_ = json.NewEncoder(w).Encode(map[string]any{"action": action, "root_password": "root-password"})
+		})
+		return mux
+	}
+
+	other := httptest.NewServer(handler("other"))
+	t.Cleanup(other.Close)
+
+	server, schemaResp := configuredMuxedProviderWithConfig(t, handler("default"), projectsConfig(other.URL))
+	provider, ok := server.(providerServer)
+	require.True(t, ok)
+
+	resourceType := schemaResp.ResourceSchemas["hcloud_network"].ValueType()
+
+	identityResp, err := provider.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	require.NoError(t, err)
+	identityType := identityResp.IdentitySchemas["hcloud_network"].ValueType()
+
+	// attribute returns the attribute of an object value.
+	attribute := func(t *testing.T, value *tfprotov6.DynamicValue, ty tftypes.Type, name string) tftypes.Value {
+		t.Helper()
+
+		raw, err := value.Unmarshal(ty)
+		require.NoError(t, err)
+
+		attributes := map[string]tftypes.Value{}
+		require.NoError(t, raw.As(&attributes))
+		return attributes[name]
+	}
+
+	for _, tc := range []struct {
+		name     string
+		id       string
+		identity map[string]tftypes.Value
+		project  tftypes.Value
+	}{
+		{
+			name:    "id",
+			id:      "1",
+			project: tftypes.NewValue(tftypes.String, nil),
+		},
+		{
+			name:    "id with project",
+			id:      "other:1",
+			project: tftypes.NewValue(tftypes.String, "other"),
+		},
+		{
+			name: "identity with project",
+			identity: map[string]tftypes.Value{
+				"id":      tftypes.NewValue(tftypes.Number, 1),
+				"project": tftypes.NewValue(tftypes.String, "other"),
+			},
+			project: tftypes.NewValue(tftypes.String, "other"),
+		},
+	} {
+		t.Run("import/"+tc.name, func(t *testing.T) {
+			req := &tfprotov6.ImportResourceStateRequest{TypeName: "hcloud_network", ID: tc.id}
+			if tc.identity != nil {
+				req.Identity = &tfprotov6.ResourceIdentityData{IdentityData: dynamicValue(t, identityType, tc.identity)}
+			}
+
+			resp, err := provider.ImportResourceState(ctx, req)
+			require.NoError(t, err)
+			require.Empty(t, resp.Diagnostics)
+			require.Len(t, resp.ImportedResources, 1)
+
+			state := resp.ImportedResources[0].State
+			assert.True(t, attribute(t, state, resourceType, "id").Equal(tftypes.NewValue(tftypes.Number, 1)))
+			assert.True(t, attribute(t, state, resourceType, "project").Equal(tc.project))
+		})
+	}
+
+	for _, tc := range []struct {
+		project string
+		host    string
+	}{
+		{project: "", host: "default"},
+		{project: "other", host: "other"},
+	} {
+		project := projectValue(tc.project)
+
+		t.Run("read/"+tc.project, func(t *testing.T) {
+			requests = nil
+
+			resp, err := provider.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+				TypeName: "hcloud_network",
+				CurrentState: dynamicValue(t, resourceType, map[string]tftypes.Value{
+					"id":      tftypes.NewValue(tftypes.Number, 1),
+					"project": project,
+				}),
+				CurrentIdentity: &tfprotov6.ResourceIdentityData{
+					IdentityData: dynamicValue(t, identityType, map[string]tftypes.Value{
+						"id":      tftypes.NewValue(tftypes.Number, 1),
+						"project": project,
+					}),
+				},
+			})
+			require.NoError(t, err)
+			require.Empty(t, resp.Diagnostics)
+
+			assert.Equal(t, []string{"GET /networks/1@" + tc.host}, requests)
+			assert.True(t, attribute(t, resp.NewIdentity.IdentityData, identityType, "project").Equal(project))
+		})
+
+		t.Run("list/"+tc.project, func(t *testing.T) {
+			requests = nil
+
+			stream, err := provider.ListResource(ctx, &tfprotov6.ListResourceRequest{
+				TypeName: "hcloud_network",
+				Config: dynamicValue(t, schemaResp.ListResourceSchemas["hcloud_network"].ValueType(), map[string]tftypes.Value{
+					"project": project,
+				}),
+				IncludeResource: true,
+				Limit:           10,
+			})
+			require.NoError(t, err)
+
+			results := []tfprotov6.ListResourceResult{}
+			for result := range stream.Results {
+				results = append(results, result)
+			}
+			require.Len(t, results, 1)
+			require.Empty(t, results[0].Diagnostics)
+
+			assert.Equal(t, []string{"GET /networks@" + tc.host}, requests)
+			assert.True(t, attribute(t, results[0].Identity.IdentityData, identityType, "project").Equal(project))
+			assert.True(t, attribute(t, results[0].Resource, resourceType, "project").Equal(project))
+		})
+
+		t.Run("action/"+tc.project, func(t *testing.T) {
+			requests = nil
+
+			stream, err := provider.InvokeAction(ctx, &tfprotov6.InvokeActionRequest{
+				ActionType: "hcloud_server_poweron",
+				Config: dynamicValue(t, schemaResp.ActionSchemas["hcloud_server_poweron"].Schema.ValueType(), map[string]tftypes.Value{
+					"server_id": tftypes.NewValue(tftypes.Number, 42),
+					"project":   project,
+				}),
+			})
+			require.NoError(t, err)
+
+			for event := range stream.Events {
+				if completed, ok := event.Type.(tfprotov6.CompletedInvokeActionEventType); ok {
+					require.Empty(t, completed.Diagnostics)
+				}
+			}
+
+			assert.Equal(t, []string{"POST /servers/42/actions/poweron@" + tc.host}, requests)
+		})
+
+		t.Run("ephemeral/"+tc.project, func(t *testing.T) {
+			requests = nil
+
+			resp, err := provider.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+				TypeName: "hcloud_server_root_password",
+				Config: dynamicValue(t, schemaResp.EphemeralResourceSchemas["hcloud_server_root_password"].ValueType(), map[string]tftypes.Value{
+					"server_id": tftypes.NewValue(tftypes.Number, 42),
+					"project":   project,
+				}),
+			})
+			require.NoError(t, err)
+			require.Empty(t, resp.Diagnostics)
+
+			assert.Equal(t, []string{"POST /servers/42/actions/reset_password@" + tc.host}, requests)
+		})
+	}
+}
@@ -7,10 +7,13 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -20,11 +23,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/certificate"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/datacenter"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/firewall"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/floatingip"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/image"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/iso"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/loadbalancer"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/loadbalancertype"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/location"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/network"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/pricing"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/primaryip"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/rdns"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/server"
@@ -34,7 +43,9 @@ import (
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/storageboxsnapshot"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/storageboxsubaccount"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/storageboxtype"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/tflogutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/volume"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/zone"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/zonerecord"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/zonerrset"
@@ -44,7 +55,9 @@ type PluginProvider struct{}
 
 var _ provider.Provider = &PluginProvider{}
 var _ provider.ProviderWithActions = &PluginProvider{}
+var _ provider.ProviderWithEphemeralResources = &PluginProvider{}
 var _ provider.ProviderWithFunctions = &PluginProvider{}
+var _ provider.ProviderWithListResources = &PluginProvider{}
 
 func NewPluginProvider() provider.Provider {
 	return &PluginProvider{}
@@ -89,6 +102,46 @@ func (p *PluginProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 					stringvalidator.OneOf([]string{"constant", "exponential"}...),
 				},
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: maxRetriesDescription,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_on_rate_limit": schema.BoolAttribute{
+				Description: retryOnRateLimitDescription,
+				Optional:    true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: maxConcurrentRequestsDescription,
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"projects": schema.SetNestedBlock{
+				Description: projectsDescription,
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: projectNameDescription,
+							Required:    true,
+						},
+						"token": schema.StringAttribute{
+							Description: projectTokenDescription,
+							Required:    true,
+							Sensitive:   true,
+						},
+						"endpoint": schema.StringAttribute{
+							Description: projectEndpointDescription,
+							Optional:    true,
+						},
+					},
+				},
+			},
 		},
 		// TODO: Uncomment once we get rid of the SDK v2 Provider
 		// MarkdownDescription: `The Hetzner Cloud (hcloud) provider is used to interact with the resources supported by
@@ -104,6 +157,19 @@ type PluginProviderModel struct {
 	EndpointHetzner types.String `tfsdk:"endpoint_hetzner"`
 	PollInterval    types.String `tfsdk:"poll_interval"`
 	PollFunction    types.String `tfsdk:"poll_function"`
+
+	MaxRetries            types.Int64 `tfsdk:"max_retries"`
+	RetryOnRateLimit      types.Bool  `tfsdk:"retry_on_rate_limit"`
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
+
+	Projects []PluginProviderProjectModel `tfsdk:"projects"`
+}
+
+// PluginProviderProjectModel describes an additional project of the provider.
+type PluginProviderProjectModel struct {
+	Name     types.String `tfsdk:"name"`
+	Token    types.String `tfsdk:"token"`
+	Endpoint types.String `tfsdk:"endpoint"`
 }
 
 // Configure is called at the beginning of the provider lifecycle, when
@@ -179,6 +245,28 @@ func (p *PluginProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	}
 	opts = append(opts, hcloud.WithPollOpts(pollOpts))
 
+	transportOpts := hcloudutil.TransportOpts{
+		MaxRetries:            defaultMaxRetries,
+		RetryOnRateLimit:      true,
+		MaxConcurrentRequests: int(data.MaxConcurrentRequests.ValueInt64()),
+	}
+	if !data.MaxRetries.IsNull() {
+		transportOpts.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+	if !data.RetryOnRateLimit.IsNull() {
+		transportOpts.RetryOnRateLimit = data.RetryOnRateLimit.ValueBool()
+	}
+
+	projects := make([]hcloudutil.Project, 0, len(data.Projects))
+	for _, project := range data.Projects {
+		projects = append(projects, hcloudutil.Project{
+			Name:     project.Name.ValueString(),
+			Token:    project.Token.ValueString(),
+			Endpoint: project.Endpoint.ValueString(),
+		})
+	}
+	opts = append(opts, clientTransportOpts(transportOpts, endpoint, projects)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -196,6 +284,8 @@ func (p *PluginProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	resp.DataSourceData = client
 	resp.ResourceData = client
 	resp.ActionData = client
+	resp.EphemeralResourceData = client
+	resp.ListResourceData = client
 
 	tflog.Info(ctx, "terraform-provider-hcloud info", map[string]any{"version": Version, "commit": Commit})
 	tflog.Info(ctx, "hcloud-go info", map[string]any{"version": hcloud.Version})
@@ -208,16 +298,22 @@ func (p *PluginProvider) Configure(ctx context.Context, req provider.ConfigureRe
 // the Metadata method. All data sources must have unique names.
 func (p *PluginProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		certificate.NewDNSValidationDataSource,
 		datacenter.NewDataSource,
 		datacenter.NewDataSourceList,
 		image.NewDataSource,
 		image.NewDataSourceList,
+		iso.NewDataSource,
+		iso.NewDataSourceList,
+		loadbalancer.NewMetricsDataSource,
 		loadbalancertype.NewDataSource,
 		loadbalancertype.NewDataSourceList,
 		location.NewDataSource,
 		location.NewDataSourceList,
+		pricing.NewDataSource,
 		primaryip.NewDataSource,
 		primaryip.NewDataSourceList,
+		server.NewMetricsDataSource,
 		servertype.NewDataSource,
 		servertype.NewDataSourceList,
 		sshkey.NewDataSource,
@@ -232,6 +328,7 @@ func (p *PluginProvider) DataSources(_ context.Context) []func() datasource.Data
 		storageboxtype.NewDataSourceList,
 		zone.NewDataSource,
 		zone.NewDataSourceList,
+		zone.NewFileDataSource,
 		zonerrset.NewDataSource,
 		zonerrset.NewDataSourceList,
 	}
@@ -244,26 +341,79 @@ func (p *PluginProvider) DataSources(_ context.Context) []func() datasource.Data
 // the Metadata method. All resources must have unique names.
 func (p *PluginProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		firewall.NewResource,
+		firewall.NewAttachmentResource,
+		floatingip.NewResource,
+		floatingip.NewAssignmentResource,
+		image.NewResource,
 		loadbalancer.NewNetworkResource,
+		network.NewResource,
+		network.NewSubnetResource,
+		network.NewRouteResource,
 		primaryip.NewResource,
 		rdns.NewResource,
+		server.NewResource,
 		server.NewNetworkResource,
 		sshkey.NewResource,
 		storagebox.NewResource,
 		storageboxsnapshot.NewResource,
 		storageboxsubaccount.NewResource,
+		volume.NewResource,
+		volume.NewAttachmentResource,
 		zone.NewResource,
+		zone.NewTSIGKeyResource,
 		zonerecord.NewResource,
 		zonerrset.NewResource,
+		zonerrset.NewBulkResource,
+	}
+}
+
+// EphemeralResources returns a slice of functions to instantiate each
+// EphemeralResource implementation.
+//
+// The ephemeral resource type name is determined by the EphemeralResource
+// implementing the Metadata method. All ephemeral resources must have unique
+// names.
+func (p *PluginProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		server.NewRootPasswordEphemeralResource,
+		server.NewRescueEphemeralResource,
 	}
 }
 
 func (p *PluginProvider) Actions(_ context.Context) []func() action.Action {
 	return []func() action.Action{
+		certificate.NewRetryAction,
 		server.NewPoweronAction,
 		server.NewPoweroffAction,
 		server.NewRebootAction,
 		server.NewResetAction,
+		server.NewRebuildAction,
+		server.NewEnableRescueAction,
+		server.NewDisableRescueAction,
+		server.NewAttachISOAction,
+		server.NewDetachISOAction,
+		server.NewCreateImageAction,
+		server.NewChangeTypeAction,
+		server.NewRequestConsoleAction,
+		storagebox.NewResetPasswordAction,
+		storagebox.NewRollbackSnapshotAction,
+		storagebox.NewChangeTypeAction,
+		storageboxsubaccount.NewResetPasswordAction,
+	}
+}
+
+func (p *PluginProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		firewall.NewListResource,
+		loadbalancer.NewListResource,
+		network.NewListResource,
+		primaryip.NewListResource,
+		server.NewListResource,
+		storagebox.NewListResource,
+		volume.NewListResource,
+		zone.NewListResource,
+		zonerrset.NewListResource,
 	}
 }
 
@@ -271,5 +421,17 @@ func (p *PluginProvider) Functions(_ context.Context) []func() function.Function
 	return []func() function.Function{
 		zone.NewIDNAFunction,
 		zonerrset.NewTXTRecordFunction,
+		zonerrset.NewParseTXTRecordFunction,
+		zonerrset.NewSPFRecordFunction,
+		zonerrset.NewDKIMRecordFunction,
+		zonerrset.NewDMARCRecordFunction,
+		zonerrset.NewCAARecordFunction,
+		zonerrset.NewSRVRecordFunction,
+		zonerrset.NewMXRecordFunction,
+		zonerrset.NewFQDNFunction,
+		zonerrset.NewRelativeNameFunction,
+		network.NewSubnetAllocateFunction,
+		network.NewNextFreeIPFunction,
+		network.NewIsPrivateRangeFunction,
 	}
 }
@@ -0,0 +1,617 @@
+package hcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+)
+
+// projectIdentityDescription is the description of the identity attribute
+// holding the project of a resource.
+const projectIdentityDescription = "Name of the project, as configured in the `projects` block of the provider, the resource is managed in."
+
+// projectImportSeparator separates the project from the ID of the resource in
+// the import ID, for example `other:42`.
+const projectImportSeparator = ":"
+
+// projectServer routes the API requests of the resources, data sources, list
+// resources, actions and ephemeral resources to the project selected by their
+// `project` attribute.
+//
+// The project is read from the request values and stored in the context, the
+// transport of the client then uses the token of the project, see
+// [hcloudutil.NewProjectTransport]. This works the same for the SDKv2 and the
+// plugin framework resources, without passing the project around.
+//
+// The identity of the resources having a `project` attribute is extended with a
+// `project` attribute, which is removed from the identities sent to the
+// resources and added to the identities returned by them.
+type projectServer struct {
+	providerServer
+
+	schemasOnce       sync.Once
+	providerType      tftypes.Type
+	resourceTypes     map[string]tftypes.Type
+	dataSourceTypes   map[string]tftypes.Type
+	listResourceTypes map[string]tftypes.Type
+	actionTypes       map[string]tftypes.Type
+	ephemeralTypes    map[string]tftypes.Type
+	// identityTypes are the identity types of the resources with a `project`
+	// attribute, without the `project` identity attribute.
+	identityTypes map[string]tftypes.Object
+
+	// projects are the names of the projects configured in the provider.
+	projects map[string]bool
+}
+
+// providerServer is the interface of the muxed server, including the RPCs that
+// are not part of [tfprotov6.ProviderServer] yet.
+type providerServer interface {
+	tfprotov6.ProviderServer
+	tfprotov6.ListResourceServer
+	tfprotov6.ActionServer
+}
+
+func newProjectServer(server tfprotov6.ProviderServer) tfprotov6.ProviderServer {
+	s, ok := server.(providerServer)
+	if !ok {
+		return server
+	}
+	return &projectServer{providerServer: s}
+}
+
+func (s *projectServer) loadSchemas(ctx context.Context) {
+	s.schemasOnce.Do(func() {
+		s.resourceTypes = map[string]tftypes.Type{}
+		s.dataSourceTypes = map[string]tftypes.Type{}
+		s.listResourceTypes = map[string]tftypes.Type{}
+		s.actionTypes = map[string]tftypes.Type{}
+		s.ephemeralTypes = map[string]tftypes.Type{}
+		s.identityTypes = map[string]tftypes.Object{}
+
+		resp, err := s.providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+		if err != nil {
+			tflog.Error(ctx, "Unable to get the provider schema", map[string]any{"error": err})
+			return
+		}
+
+		if resp.Provider != nil {
+			s.providerType = resp.Provider.ValueType()
+		}
+		for name, schema := range resp.ResourceSchemas {
+			s.resourceTypes[name] = schema.ValueType()
+		}
+		for name, schema := range resp.DataSourceSchemas {
+			s.dataSourceTypes[name] = schema.ValueType()
+		}
+		for name, schema := range resp.ListResourceSchemas {
+			s.listResourceTypes[name] = schema.ValueType()
+		}
+		for name, schema := range resp.ActionSchemas {
+			if schema != nil {
+				s.actionTypes[name] = schema.Schema.ValueType()
+			}
+		}
+		for name, schema := range resp.EphemeralResourceSchemas {
+			s.ephemeralTypes[name] = schema.ValueType()
+		}
+
+		identityResp, err := s.providerServer.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+		if err != nil {
+			tflog.Error(ctx, "Unable to get the resource identity schemas", map[string]any{"error": err})
+			return
+		}
+
+		for name, schema := range identityResp.IdentitySchemas {
+			if !hasProjectAttribute(s.resourceTypes[name]) {
+				continue
+			}
+			if ty, ok := schema.ValueType().(tftypes.Object); ok {
+				s.identityTypes[name] = ty
+			}
+		}
+	})
+}
+
+// hasProjectAttribute returns whether the object type has a `project` attribute.
+func hasProjectAttribute(ty tftypes.Type) bool {
+	object, ok := ty.(tftypes.Object)
+	if !ok {
+		return false
+	}
+	_, ok = object.AttributeTypes[hcloudutil.ProjectAttribute]
+	return ok
+}
+
+// withProject returns a copy of the context using the project of the first
+// known value.
+func (s *projectServer) withProject(ctx context.Context, ty tftypes.Type, values ...*tfprotov6.DynamicValue) context.Context {
+	if ty == nil {
+		return ctx
+	}
+
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+
+		project, ok := projectFromValue(value, ty)
+		if !ok {
+			continue
+		}
+
+		return contextWithProject(ctx, project)
+	}
+
+	return ctx
+}
+
+// contextWithProject returns a copy of the context using the project, or the
+// context itself for the default project.
+func contextWithProject(ctx context.Context, project string) context.Context {
+	if project == "" {
+		return ctx
+	}
+	return hcloudutil.ContextWithProject(ctx, project)
+}
+
+// projectFromValue returns the project of a value, and whether the value is known.
+func projectFromValue(value *tfprotov6.DynamicValue, ty tftypes.Type) (string, bool) {
+	raw, err := value.Unmarshal(ty)
+	if err != nil || raw.IsNull() || !raw.IsKnown() {
+		return "", false
+	}
+
+	attributes := map[string]tftypes.Value{}
+	if err := raw.As(&attributes); err != nil {
+		return "", false
+	}
+
+	attribute, ok := attributes[hcloudutil.ProjectAttribute]
+	if !ok || attribute.IsNull() || !attribute.IsKnown() {
+		return "", true
+	}
+
+	var project string
+	if err := attribute.As(&project); err != nil {
+		return "", true
+	}
+	return project, true
+}
+
+// withProjectAttribute returns a copy of the value, with the `project` attribute
+// set to the project, or null for the default project.
+func withProjectAttribute(value *tfprotov6.DynamicValue, ty tftypes.Object, project string) (*tfprotov6.DynamicValue, error) {
+	raw, err := value.Unmarshal(ty)
+	if err != nil {
+		return nil, err
+	}
+	if raw.IsNull() || !raw.IsKnown() {
+		return value, nil
+	}
+
+	attributes := map[string]tftypes.Value{}
+	if err := raw.As(&attributes); err != nil {
+		return nil, err
+	}
+	attributes[hcloudutil.ProjectAttribute] = projectValue(project)
+
+	result, err := tfprotov6.NewDynamicValue(ty, tftypes.NewValue(ty, attributes))
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func projectValue(project string) tftypes.Value {
+	if project == "" {
+		return tftypes.NewValue(tftypes.String, nil)
+	}
+	return tftypes.NewValue(tftypes.String, project)
+}
+
+func (s *projectServer) resourceType(ctx context.Context, typeName string) tftypes.Type {
+	s.loadSchemas(ctx)
+	return s.resourceTypes[typeName]
+}
+
+func (s *projectServer) dataSourceType(ctx context.Context, typeName string) tftypes.Type {
+	s.loadSchemas(ctx)
+	return s.dataSourceTypes[typeName]
+}
+
+func (s *projectServer) listResourceType(ctx context.Context, typeName string) tftypes.Type {
+	s.loadSchemas(ctx)
+	return s.listResourceTypes[typeName]
+}
+
+func (s *projectServer) actionType(ctx context.Context, typeName string) tftypes.Type {
+	s.loadSchemas(ctx)
+	return s.actionTypes[typeName]
+}
+
+func (s *projectServer) ephemeralType(ctx context.Context, typeName string) tftypes.Type {
+	s.loadSchemas(ctx)
+	return s.ephemeralTypes[typeName]
+}
+
+// identityType returns the identity type of the resource, without the `project`
+// attribute, and whether the identity of the resource includes the project.
+func (s *projectServer) identityType(ctx context.Context, typeName string) (tftypes.Object, bool) {
+	s.loadSchemas(ctx)
+	ty, ok := s.identityTypes[typeName]
+	return ty, ok
+}
+
+// projectIdentityType returns the identity type including the `project` attribute.
+func projectIdentityType(ty tftypes.Object) tftypes.Object {
+	attributeTypes := make(map[string]tftypes.Type, len(ty.AttributeTypes)+1)
+	for name, attributeType := range ty.AttributeTypes {
+		attributeTypes[name] = attributeType
+	}
+	attributeTypes[hcloudutil.ProjectAttribute] = tftypes.String
+	return tftypes.Object{AttributeTypes: attributeTypes}
+}
+
+// stripIdentityProject returns the identity without the `project` attribute,
+// and the project of the identity.
+func (s *projectServer) stripIdentityProject(ctx context.Context, typeName string, identity *tfprotov6.ResourceIdentityData) (*tfprotov6.ResourceIdentityData, string, error) {
+	ty, ok := s.identityType(ctx, typeName)
+	if !ok || identity == nil || identity.IdentityData == nil {
+		return identity, "", nil
+	}
+
+	raw, err := identity.IdentityData.Unmarshal(projectIdentityType(ty))
+	if err != nil {
+		return nil, "", err
+	}
+	if raw.IsNull() || !raw.IsKnown() {
+		return identity, "", nil
+	}
+
+	attributes := map[string]tftypes.Value{}
+	if err := raw.As(&attributes); err != nil {
+		return nil, "", err
+	}
+
+	var project string
+	if attribute := attributes[hcloudutil.ProjectAttribute]; attribute.IsKnown() && !attribute.IsNull() {
+		if err := attribute.As(&project); err != nil {
+			return nil, "", err
+		}
+	}
+	delete(attributes, hcloudutil.ProjectAttribute)
+
+	data, err := tfprotov6.NewDynamicValue(ty, tftypes.NewValue(ty, attributes))
+	if err != nil {
+		return nil, "", err
+	}
+	return &tfprotov6.ResourceIdentityData{IdentityData: &data}, project, nil
+}
+
+// addIdentityProject returns the identity with the `project` attribute set to
+// the project.
+func (s *projectServer) addIdentityProject(ctx context.Context, typeName string, identity *tfprotov6.ResourceIdentityData, project string) (*tfprotov6.ResourceIdentityData, error) {
+	ty, ok := s.identityType(ctx, typeName)
+	if !ok || identity == nil || identity.IdentityData == nil {
+		return identity, nil
+	}
+
+	raw, err := identity.IdentityData.Unmarshal(ty)
+	if err != nil {
+		return nil, err
+	}
+	if raw.IsNull() || !raw.IsKnown() {
+		return identity, nil
+	}
+
+	attributes := map[string]tftypes.Value{}
+	if err := raw.As(&attributes); err != nil {
+		return nil, err
+	}
+	attributes[hcloudutil.ProjectAttribute] = projectValue(project)
+
+	projectType := projectIdentityType(ty)
+	data, err := tfprotov6.NewDynamicValue(projectType, tftypes.NewValue(projectType, attributes))
+	if err != nil {
+		return nil, err
+	}
+	return &tfprotov6.ResourceIdentityData{IdentityData: &data}, nil
+}
+
+// identityDiagnostic returns the diagnostic reported when the project of a
+// resource identity could not be read or written.
+func identityDiagnostic(err error) *tfprotov6.Diagnostic {
+	return &tfprotov6.Diagnostic{
+		Severity: tfprotov6.DiagnosticSeverityError,
+		Summary:  "Invalid resource identity",
+		Detail:   fmt.Sprintf("Unable to read or write the project of the resource identity: %s", err),
+	}
+}
+
+func (s *projectServer) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	s.loadSchemas(ctx)
+	s.projects = projectNames(req.Config, s.providerType)
+	return s.providerServer.ConfigureProvider(ctx, req)
+}
+
+// projectNames returns the names of the projects configured in the provider.
+func projectNames(config *tfprotov6.DynamicValue, ty tftypes.Type) map[string]bool {
+	names := map[string]bool{}
+	if config == nil || ty == nil {
+		return names
+	}
+
+	raw, err := config.Unmarshal(ty)
+	if err != nil || raw.IsNull() || !raw.IsKnown() {
+		return names
+	}
+
+	attributes := map[string]tftypes.Value{}
+	if err := raw.As(&attributes); err != nil {
+		return names
+	}
+
+	projects := []tftypes.Value{}
+	if err := attributes["projects"].As(&projects); err != nil {
+		return names
+	}
+
+	for _, project := range projects {
+		projectAttributes := map[string]tftypes.Value{}
+		if err := project.As(&projectAttributes); err != nil {
+			continue
+		}
+		var name string
+		if err := projectAttributes["name"].As(&name); err == nil && name != "" {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+func (s *projectServer) GetResourceIdentitySchemas(ctx context.Context, req *tfprotov6.GetResourceIdentitySchemasRequest) (*tfprotov6.GetResourceIdentitySchemasResponse, error) {
+	resp, err := s.providerServer.GetResourceIdentitySchemas(ctx, req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	s.loadSchemas(ctx)
+	for name, schema := range resp.IdentitySchemas {
+		if _, ok := s.identityTypes[name]; !ok || schema == nil {
+			continue
+		}
+
+		identitySchema := *schema
+		identitySchema.IdentityAttributes = append(append([]*tfprotov6.ResourceIdentitySchemaAttribute{}, schema.IdentityAttributes...),
+			&tfprotov6.ResourceIdentitySchemaAttribute{
+				Name:              hcloudutil.ProjectAttribute,
+				Type:              tftypes.String,
+				OptionalForImport: true,
+				Description:       projectIdentityDescription,
+			},
+		)
+		resp.IdentitySchemas[name] = &identitySchema
+	}
+	return resp, nil
+}
+
+func (s *projectServer) UpgradeResourceIdentity(ctx context.Context, req *tfprotov6.UpgradeResourceIdentityRequest) (*tfprotov6.UpgradeResourceIdentityResponse, error) {
+	if _, ok := s.identityType(ctx, req.TypeName); !ok || req.RawIdentity == nil || req.RawIdentity.JSON == nil {
+		return s.providerServer.UpgradeResourceIdentity(ctx, req)
+	}
+
+	attributes := map[string]json.RawMessage{}
+	if err := json.Unmarshal(req.RawIdentity.JSON, &attributes); err != nil {
+		return &tfprotov6.UpgradeResourceIdentityResponse{Diagnostics: []*tfprotov6.Diagnostic{identityDiagnostic(err)}}, nil
+	}
+
+	var project string
+	if raw, ok := attributes[hcloudutil.ProjectAttribute]; ok {
+		// A null project is decoded to an empty string.
+		_ = json.Unmarshal(raw, &project)
+		delete(attributes, hcloudutil.ProjectAttribute)
+	}
+
+	rawIdentity, err := json.Marshal(attributes)
+	if err != nil {
+		return &tfprotov6.UpgradeResourceIdentityResponse{Diagnostics: []*tfprotov6.Diagnostic{identityDiagnostic(err)}}, nil
+	}
+
+	upgradeReq := *req
+	upgradeReq.RawIdentity = &tfprotov6.RawState{JSON: rawIdentity, Flatmap: req.RawIdentity.Flatmap}
+
+	resp, err := s.providerServer.UpgradeResourceIdentity(ctx, &upgradeReq)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	resp.UpgradedIdentity, err = s.addIdentityProject(ctx, req.TypeName, resp.UpgradedIdentity, project)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, identityDiagnostic(err))
+	}
+	return resp, nil
+}
+
+func (s *projectServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	ctx = s.withProject(ctx, s.resourceType(ctx, req.TypeName), req.CurrentState)
+
+	readReq := *req
+	identity, _, err := s.stripIdentityProject(ctx, req.TypeName, req.CurrentIdentity)
+	if err != nil {
+		return &tfprotov6.ReadResourceResponse{Diagnostics: []*tfprotov6.Diagnostic{identityDiagnostic(err)}}, nil
+	}
+	readReq.CurrentIdentity = identity
+
+	resp, err := s.providerServer.ReadResource(ctx, &readReq)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	resp.NewIdentity, err = s.addIdentityProject(ctx, req.TypeName, resp.NewIdentity, hcloudutil.ProjectFromContext(ctx))
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, identityDiagnostic(err))
+	}
+	return resp, nil
+}
+
+func (s *projectServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	ctx = s.withProject(ctx, s.resourceType(ctx, req.TypeName), req.ProposedNewState, req.PriorState)
+
+	planReq := *req
+	identity, _, err := s.stripIdentityProject(ctx, req.TypeName, req.PriorIdentity)
+	if err != nil {
+		return &tfprotov6.PlanResourceChangeResponse{Diagnostics: []*tfprotov6.Diagnostic{identityDiagnostic(err)}}, nil
+	}
+	planReq.PriorIdentity = identity
+
+	resp, err := s.providerServer.PlanResourceChange(ctx, &planReq)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	resp.PlannedIdentity, err = s.addIdentityProject(ctx, req.TypeName, resp.PlannedIdentity, hcloudutil.ProjectFromContext(ctx))
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, identityDiagnostic(err))
+	}
+	return resp, nil
+}
+
+func (s *projectServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	ctx = s.withProject(ctx, s.resourceType(ctx, req.TypeName), req.PlannedState, req.PriorState)
+
+	applyReq := *req
+	identity, _, err := s.stripIdentityProject(ctx, req.TypeName, req.PlannedIdentity)
+	if err != nil {
+		return &tfprotov6.ApplyResourceChangeResponse{Diagnostics: []*tfprotov6.Diagnostic{identityDiagnostic(err)}}, nil
+	}
+	applyReq.PlannedIdentity = identity
+
+	resp, err := s.providerServer.ApplyResourceChange(ctx, &applyReq)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	resp.NewIdentity, err = s.addIdentityProject(ctx, req.TypeName, resp.NewIdentity, hcloudutil.ProjectFromContext(ctx))
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, identityDiagnostic(err))
+	}
+	return resp, nil
+}
+
+// ImportResourceState imports the resource from the project of the identity,
+// or from the project prefixing the import ID, for example `other:42`. The
+// prefix is only removed when it is the name of a configured project.
+func (s *projectServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	ty, ok := s.resourceType(ctx, req.TypeName).(tftypes.Object)
+	if !ok || !hasProjectAttribute(ty) {
+		return s.providerServer.ImportResourceState(ctx, req)
+	}
+
+	importReq := *req
+
+	identity, project, err := s.stripIdentityProject(ctx, req.TypeName, req.Identity)
+	if err != nil {
+		return &tfprotov6.ImportResourceStateResponse{Diagnostics: []*tfprotov6.Diagnostic{identityDiagnostic(err)}}, nil
+	}
+	importReq.Identity = identity
+
+	if prefix, id, found := strings.Cut(req.ID, projectImportSeparator); found && s.projects[prefix] {
+		project = prefix
+		importReq.ID = id
+	}
+
+	ctx = contextWithProject(ctx, project)
+
+	resp, err := s.providerServer.ImportResourceState(ctx, &importReq)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	for _, imported := range resp.ImportedResources {
+		if imported == nil || imported.TypeName != req.TypeName {
+			continue
+		}
+
+		if imported.State != nil {
+			imported.State, err = withProjectAttribute(imported.State, ty, project)
+			if err != nil {
+				resp.Diagnostics = append(resp.Diagnostics, identityDiagnostic(err))
+				continue
+			}
+		}
+
+		imported.Identity, err = s.addIdentityProject(ctx, req.TypeName, imported.Identity, project)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, identityDiagnostic(err))
+		}
+	}
+	return resp, nil
+}
+
+func (s *projectServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	ctx = s.withProject(ctx, s.dataSourceType(ctx, req.TypeName), req.Config)
+	return s.providerServer.ReadDataSource(ctx, req)
+}
+
+// ListResource lists the resources of the project selected in the list
+// configuration, the project is added to the identity and the state of the
+// listed resources.
+func (s *projectServer) ListResource(ctx context.Context, req *tfprotov6.ListResourceRequest) (*tfprotov6.ListResourceServerStream, error) {
+	ctx = s.withProject(ctx, s.listResourceType(ctx, req.TypeName), req.Config)
+
+	stream, err := s.providerServer.ListResource(ctx, req)
+	if err != nil || stream == nil || stream.Results == nil {
+		return stream, err
+	}
+
+	project := hcloudutil.ProjectFromContext(ctx)
+	resourceType, _ := s.resourceType(ctx, req.TypeName).(tftypes.Object)
+	results := stream.Results
+
+	stream.Results = func(yield func(tfprotov6.ListResourceResult) bool) {
+		for result := range results {
+			var err error
+
+			result.Identity, err = s.addIdentityProject(ctx, req.TypeName, result.Identity, project)
+			if err != nil {
+				result.Diagnostics = append(result.Diagnostics, identityDiagnostic(err))
+			}
+
+			if result.Resource != nil && hasProjectAttribute(resourceType) {
+				result.Resource, err = withProjectAttribute(result.Resource, resourceType, project)
+				if err != nil {
+					result.Diagnostics = append(result.Diagnostics, identityDiagnostic(err))
+				}
+			}
+
+			if !yield(result) {
+				return
+			}
+		}
+	}
+	return stream, nil
+}
+
+func (s *projectServer) PlanAction(ctx context.Context, req *tfprotov6.PlanActionRequest) (*tfprotov6.PlanActionResponse, error) {
+	ctx = s.withProject(ctx, s.actionType(ctx, req.ActionType), req.Config)
+	return s.providerServer.PlanAction(ctx, req)
+}
+
+func (s *projectServer) InvokeAction(ctx context.Context, req *tfprotov6.InvokeActionRequest) (*tfprotov6.InvokeActionServerStream, error) {
+	ctx = s.withProject(ctx, s.actionType(ctx, req.ActionType), req.Config)
+	return s.providerServer.InvokeAction(ctx, req)
+}
+
+func (s *projectServer) OpenEphemeralResource(ctx context.Context, req *tfprotov6.OpenEphemeralResourceRequest) (*tfprotov6.OpenEphemeralResourceResponse, error) {
+	ctx = s.withProject(ctx, s.ephemeralType(ctx, req.TypeName), req.Config)
+	return s.providerServer.OpenEphemeralResource(ctx, req)
+}
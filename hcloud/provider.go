@@ -32,7 +32,7 @@ var (
 
 // Provider returns the hcloud terraform provider.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"token": {
 				Type:        schema.TypeString,
@@ -73,26 +73,61 @@ func Provider() *schema.Provider {
 				Description:  "The type of function to be used during the polling.",
 				ValidateFunc: validation.StringInSlice([]string{"constant", "exponential"}, false),
 			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultMaxRetries,
+				Description:  maxRetriesDescription,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_on_rate_limit": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: retryOnRateLimitDescription,
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  maxConcurrentRequestsDescription,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"projects": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: projectsDescription,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: projectNameDescription,
+						},
+						"token": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: projectTokenDescription,
+						},
+						"endpoint": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: projectEndpointDescription,
+						},
+					},
+				},
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			certificate.UploadedResourceType:  certificate.UploadedResource(),
-			certificate.ResourceType:          certificate.UploadedResource(), // Alias for backwards compatibility.
-			certificate.ManagedResourceType:   certificate.ManagedResource(),
-			firewall.ResourceType:             firewall.Resource(),
-			firewall.AttachmentResourceType:   firewall.AttachmentResource(),
-			floatingip.AssignmentResourceType: floatingip.AssignmentResource(),
-			floatingip.ResourceType:           floatingip.Resource(),
-			loadbalancer.ResourceType:         loadbalancer.Resource(),
-			loadbalancer.ServiceResourceType:  loadbalancer.ServiceResource(),
-			loadbalancer.TargetResourceType:   loadbalancer.TargetResource(),
-			network.ResourceType:              network.Resource(),
-			network.RouteResourceType:         network.RouteResource(),
-			network.SubnetResourceType:        network.SubnetResource(),
-			server.ResourceType:               server.Resource(),
-			snapshot.ResourceType:             snapshot.Resource(),
-			volume.AttachmentResourceType:     volume.AttachmentResource(),
-			volume.ResourceType:               volume.Resource(),
-			placementgroup.ResourceType:       placementgroup.Resource(),
+			certificate.UploadedResourceType: certificate.UploadedResource(),
+			certificate.ResourceType:         certificate.UploadedResource(), // Alias for backwards compatibility.
+			certificate.ManagedResourceType:  certificate.ManagedResource(),
+			loadbalancer.ResourceType:        loadbalancer.Resource(),
+			loadbalancer.ServiceResourceType: loadbalancer.ServiceResource(),
+			loadbalancer.TargetResourceType:  loadbalancer.TargetResource(),
+			snapshot.ResourceType:            snapshot.Resource(),
+			placementgroup.ResourceType:      placementgroup.Resource(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			certificate.DataSourceType:        certificate.DataSource(),
@@ -114,6 +149,15 @@ func Provider() *schema.Provider {
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
+
+	for _, r := range p.ResourcesMap {
+		hcloudutil.WithProjectSchema(r, true)
+	}
+	for _, r := range p.DataSourcesMap {
+		hcloudutil.WithProjectSchema(r, false)
+	}
+
+	return p
 }
 
 func providerConfigure(_ context.Context, d *schema.ResourceData) (any, diag.Diagnostics) {
@@ -139,6 +183,20 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (any, diag.Dia
 			opts = append(opts, hcloud.WithPollOpts(hcloud.PollOpts{BackoffFunc: hcloud.ExponentialBackoff(2, pollInterval)}))
 		}
 	}
+	var projects []hcloudutil.Project
+	for _, item := range d.Get("projects").(*schema.Set).List() {
+		project := item.(map[string]any)
+		projects = append(projects, hcloudutil.Project{
+			Name:     project["name"].(string),
+			Token:    project["token"].(string),
+			Endpoint: project["endpoint"].(string),
+		})
+	}
+	opts = append(opts, clientTransportOpts(hcloudutil.TransportOpts{
+		MaxRetries:            d.Get("max_retries").(int),
+		RetryOnRateLimit:      d.Get("retry_on_rate_limit").(bool),
+		MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+	}, d.Get("endpoint").(string), projects)...)
 	if logging.LogLevel() != "" {
 		opts = append(opts, hcloud.WithDebugWriter(log.Writer()))
 	}
@@ -26,22 +26,12 @@ func TestProvider_Resources(t *testing.T) {
 	var provider = Provider()
 	expectedResources := []string{
 		certificate.ResourceType,
-		firewall.ResourceType,
-		firewall.AttachmentResourceType,
 		certificate.UploadedResourceType,
 		certificate.ManagedResourceType,
-		floatingip.AssignmentResourceType,
-		floatingip.ResourceType,
 		loadbalancer.ResourceType,
 		loadbalancer.ServiceResourceType,
 		loadbalancer.TargetResourceType,
-		network.ResourceType,
-		network.RouteResourceType,
-		network.SubnetResourceType,
-		server.ResourceType,
 		snapshot.ResourceType,
-		volume.AttachmentResourceType,
-		volume.ResourceType,
 		placementgroup.ResourceType,
 	}
 
@@ -0,0 +1,91 @@
+package certificate
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/actionutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+)
+
+// RetryActionType is the type name of the action retrying the issuance of a
+// managed certificate.
+const RetryActionType = "hcloud_managed_certificate_retry"
+
+var _ action.Action = (*RetryAction)(nil)
+var _ action.ActionWithConfigure = (*RetryAction)(nil)
+
+type RetryAction struct {
+	client *hcloud.Client
+}
+
+func NewRetryAction() action.Action {
+	return &RetryAction{}
+}
+
+func (a *RetryAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = RetryActionType
+}
+
+func (a *RetryAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	a.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+}
+
+func (a *RetryAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = actionschema.Schema{
+		MarkdownDescription: util.MarkdownDescription(`
+Retry the issuance of a failed managed certificate in Hetzner Cloud.
+
+Only managed certificates with a failed issuance may be retried.
+
+See the [Retry Issuance or Renewal documentation](https://docs.hetzner.cloud/reference/cloud#tag/certificate-actions/retry_certificate) for more details.
+`),
+		Attributes: map[string]actionschema.Attribute{
+			"certificate_id": actionschema.Int64Attribute{
+				MarkdownDescription: "ID of the managed certificate to apply the action to.",
+				Required:            true,
+			},
+			"project": actionutil.ProjectAttribute(),
+		},
+	}
+}
+
+type retryActionData struct {
+	CertificateID types.Int64  `tfsdk:"certificate_id"`
+	Project       types.String `tfsdk:"project"`
+}
+
+func (a *RetryAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if a.client == nil {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider client is not configured. This is an issue in the provider. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	var data retryActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cert := &hcloud.Certificate{ID: data.CertificateID.ValueInt64()}
+
+	apiAction, _, err := a.client.Certificate.RetryIssuance(ctx, cert)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &a.client.Action, apiAction)...)
+}
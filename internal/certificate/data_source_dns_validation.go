@@ -0,0 +1,299 @@
+package certificate
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/datasourceutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+)
+
+// DNSValidationDataSourceType is the type name of the data source validating
+// the domain names of a managed certificate against the Hetzner Cloud Zones.
+const DNSValidationDataSourceType = "hcloud_managed_certificate_dns_validation"
+
+var _ datasource.DataSource = (*DNSValidationDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*DNSValidationDataSource)(nil)
+
+type DNSValidationDataSource struct {
+	client *hcloud.Client
+}
+
+func NewDNSValidationDataSource() datasource.DataSource {
+	return &DNSValidationDataSource{}
+}
+
+func (d *DNSValidationDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = DNSValidationDataSourceType
+}
+
+func (d *DNSValidationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	d.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *DNSValidationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = util.MarkdownDescription(`
+Checks that the domain names of a managed certificate can be validated using the Hetzner Cloud Zones of the project.
+
+Each domain name must belong to a Zone of the project, and the Zone must be delegated to its assigned authoritative nameservers. A warning is reported during the plan for each domain name failing the checks.
+
+The ''valid'' attribute may be used in a precondition of the ''hcloud_managed_certificate'' resource, to prevent the creation of a certificate that cannot be issued.
+
+See the [Zones API documentation](https://docs.hetzner.cloud/reference/cloud#zones) for more details.
+`)
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"project": datasourceutil.ProjectAttribute(),
+		"domain_names": schema.SetAttribute{
+			MarkdownDescription: "Domain names of the managed certificate to validate.",
+			ElementType:         types.StringType,
+			Required:            true,
+			Validators: []validator.Set{
+				setvalidator.SizeAtLeast(1),
+			},
+		},
+		"valid": schema.BoolAttribute{
+			MarkdownDescription: "Whether all the domain names can be validated.",
+			Computed:            true,
+		},
+		"domains": schema.ListNestedAttribute{
+			MarkdownDescription: "Validation results of the domain names, sorted by domain name.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"domain_name": schema.StringAttribute{
+						MarkdownDescription: "Domain name of the managed certificate.",
+						Computed:            true,
+					},
+					"zone_id": schema.Int64Attribute{
+						MarkdownDescription: "ID of the Zone the domain name belongs to. Null if no Zone was found.",
+						Computed:            true,
+					},
+					"zone_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the Zone the domain name belongs to. Null if no Zone was found.",
+						Computed:            true,
+					},
+					"delegation_status": schema.StringAttribute{
+						MarkdownDescription: "Status of the delegation of the Zone to its assigned authoritative nameservers. Null if no Zone was found.",
+						Computed:            true,
+					},
+					"assigned_nameservers": schema.ListAttribute{
+						MarkdownDescription: "Authoritative Hetzner nameservers assigned to the Zone.",
+						ElementType:         types.StringType,
+						Computed:            true,
+					},
+					"valid": schema.BoolAttribute{
+						MarkdownDescription: "Whether the domain name belongs to a Zone that is delegated to its assigned authoritative nameservers.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// dnsValidationResult is the validation result of a single domain name.
+type dnsValidationResult struct {
+	DomainName string
+	Zone       *hcloud.Zone
+}
+
+// Valid returns whether the domain name belongs to a delegated zone.
+func (r dnsValidationResult) Valid() bool {
+	return r.Zone != nil && r.Zone.AuthoritativeNameservers.DelegationStatus == hcloud.ZoneDelegationStatusValid
+}
+
+// Warning returns the warning reported for the domain name, if it is not valid.
+func (r dnsValidationResult) Warning() (string, string, bool) {
+	switch {
+	case r.Zone == nil:
+		return "Zone not found",
+			fmt.Sprintf("No Zone found for the domain %q in the project, the domain cannot be validated by the managed certificate.", r.DomainName),
+			true
+	case !r.Valid():
+		return "Zone not delegated",
+			fmt.Sprintf(
+				"The Zone %q of the domain %q is not delegated to its assigned authoritative nameservers (%s), the delegation status is %q.",
+				r.Zone.Name, r.DomainName,
+				strings.Join(r.Zone.AuthoritativeNameservers.Assigned, ", "),
+				r.Zone.AuthoritativeNameservers.DelegationStatus,
+			),
+			true
+	}
+	return "", "", false
+}
+
+// validateDNSDomainNames matches each domain name with the zone it belongs to.
+// A domain name belongs to the zone with the longest matching name, wildcard
+// domain names belong to the zone of their parent domain.
+func validateDNSDomainNames(domainNames []string, zones []*hcloud.Zone) []dnsValidationResult {
+	results := make([]dnsValidationResult, 0, len(domainNames))
+	for _, domainName := range domainNames {
+		name := strings.TrimPrefix(normalizeDomainName(domainName), "*.")
+
+		result := dnsValidationResult{DomainName: domainName}
+		for _, zone := range zones {
+			zoneName := normalizeDomainName(zone.Name)
+			if name != zoneName && !strings.HasSuffix(name, "."+zoneName) {
+				continue
+			}
+			if result.Zone == nil || len(zoneName) > len(normalizeDomainName(result.Zone.Name)) {
+				result.Zone = zone
+			}
+		}
+		results = append(results, result)
+	}
+
+	slices.SortFunc(results, func(a, b dnsValidationResult) int {
+		return strings.Compare(a.DomainName, b.DomainName)
+	})
+	return results
+}
+
+func normalizeDomainName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
+
+type dnsValidationDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Project     types.String `tfsdk:"project"`
+	DomainNames types.Set    `tfsdk:"domain_names"`
+	Valid       types.Bool   `tfsdk:"valid"`
+	Domains     types.List   `tfsdk:"domains"`
+}
+
+type dnsValidationDomainModel struct {
+	DomainName          types.String `tfsdk:"domain_name"`
+	ZoneID              types.Int64  `tfsdk:"zone_id"`
+	ZoneName            types.String `tfsdk:"zone_name"`
+	DelegationStatus    types.String `tfsdk:"delegation_status"`
+	AssignedNameservers types.List   `tfsdk:"assigned_nameservers"`
+	Valid               types.Bool   `tfsdk:"valid"`
+}
+
+var _ util.ModelFromAPI[dnsValidationResult] = &dnsValidationDomainModel{}
+var _ util.ModelToTerraform[types.Object] = &dnsValidationDomainModel{}
+
+func (m *dnsValidationDomainModel) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"domain_name":          types.StringType,
+		"zone_id":              types.Int64Type,
+		"zone_name":            types.StringType,
+		"delegation_status":    types.StringType,
+		"assigned_nameservers": types.ListType{ElemType: types.StringType},
+		"valid":                types.BoolType,
+	}
+}
+
+func (m *dnsValidationDomainModel) tfType() attr.Type {
+	return basetypes.ObjectType{AttrTypes: m.tfAttributesTypes()}
+}
+
+func (m *dnsValidationDomainModel) FromAPI(ctx context.Context, hc dnsValidationResult) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	m.DomainName = types.StringValue(hc.DomainName)
+	m.Valid = types.BoolValue(hc.Valid())
+
+	if hc.Zone == nil {
+		m.ZoneID = types.Int64Null()
+		m.ZoneName = types.StringNull()
+		m.DelegationStatus = types.StringNull()
+		m.AssignedNameservers = types.ListValueMust(types.StringType, []attr.Value{})
+		return diags
+	}
+
+	m.ZoneID = types.Int64Value(hc.Zone.ID)
+	m.ZoneName = types.StringValue(hc.Zone.Name)
+	m.DelegationStatus = types.StringValue(string(hc.Zone.AuthoritativeNameservers.DelegationStatus))
+
+	m.AssignedNameservers, newDiags = types.ListValueFrom(ctx, types.StringType, append([]string{}, hc.Zone.AuthoritativeNameservers.Assigned...))
+	diags.Append(newDiags...)
+
+	return diags
+}
+
+func (m *dnsValidationDomainModel) ToTerraform(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
+}
+
+func (d *DNSValidationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dnsValidationDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var domainNames []string
+	resp.Diagnostics.Append(data.DomainNames.ElementsAs(ctx, &domainNames, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zones, err := d.client.Zone.All(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	results := validateDNSDomainNames(domainNames, zones)
+
+	valid := true
+	domains := make([]attr.Value, 0, len(results))
+	for _, result := range results {
+		if summary, detail, ok := result.Warning(); ok {
+			valid = false
+			resp.Diagnostics.AddAttributeWarning(path.Root("domain_names"), summary, detail)
+		}
+
+		var domain dnsValidationDomainModel
+		resp.Diagnostics.Append(domain.FromAPI(ctx, result)...)
+
+		value, newDiags := domain.ToTerraform(ctx)
+		resp.Diagnostics.Append(newDiags...)
+
+		domains = append(domains, value)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var newDiags diag.Diagnostics
+	data.Domains, newDiags = types.ListValue((&dnsValidationDomainModel{}).tfType(), domains)
+	resp.Diagnostics.Append(newDiags...)
+
+	data.Valid = types.BoolValue(valid)
+
+	names := make([]string, 0, len(results))
+	for _, result := range results {
+		names = append(names, result.DomainName)
+	}
+	data.ID = types.StringValue(strings.Join(names, ","))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
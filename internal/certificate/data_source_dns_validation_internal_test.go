@@ -0,0 +1,114 @@
+package certificate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func TestValidateDNSDomainNames(t *testing.T) {
+	zoneCom := &hcloud.Zone{
+		ID:   1,
+		Name: "example.com",
+		AuthoritativeNameservers: hcloud.ZoneAuthoritativeNameservers{
+			Assigned:         []string{"hydrogen.ns.hetzner.com.", "oxygen.ns.hetzner.com."},
+			DelegationStatus: hcloud.ZoneDelegationStatusValid,
+		},
+	}
+	zoneSub := &hcloud.Zone{
+		ID:   2,
+		Name: "sub.example.com",
+		AuthoritativeNameservers: hcloud.ZoneAuthoritativeNameservers{
+			Assigned:         []string{"hydrogen.ns.hetzner.com.", "oxygen.ns.hetzner.com."},
+			DelegationStatus: hcloud.ZoneDelegationStatusInvalid,
+		},
+	}
+	zones := []*hcloud.Zone{zoneCom, zoneSub}
+
+	results := validateDNSDomainNames([]string{
+		"www.example.com",
+		"*.example.com",
+		"Example.com.",
+		"api.sub.example.com",
+		"example.org",
+		"notexample.com",
+	}, zones)
+
+	assert.Equal(t, []dnsValidationResult{
+		{DomainName: "*.example.com", Zone: zoneCom},
+		{DomainName: "Example.com.", Zone: zoneCom},
+		{DomainName: "api.sub.example.com", Zone: zoneSub},
+		{DomainName: "example.org", Zone: nil},
+		{DomainName: "notexample.com", Zone: nil},
+		{DomainName: "www.example.com", Zone: zoneCom},
+	}, results)
+
+	t.Run("valid", func(t *testing.T) {
+		result := dnsValidationResult{DomainName: "www.example.com", Zone: zoneCom}
+		assert.True(t, result.Valid())
+
+		_, _, ok := result.Warning()
+		assert.False(t, ok)
+	})
+
+	t.Run("zone not found", func(t *testing.T) {
+		result := dnsValidationResult{DomainName: "example.org"}
+		assert.False(t, result.Valid())
+
+		summary, detail, ok := result.Warning()
+		assert.True(t, ok)
+		assert.Equal(t, "Zone not found", summary)
+		assert.Equal(t, `No Zone found for the domain "example.org" in the project, the domain cannot be validated by the managed certificate.`, detail)
+	})
+
+	t.Run("zone not delegated", func(t *testing.T) {
+		result := dnsValidationResult{DomainName: "api.sub.example.com", Zone: zoneSub}
+		assert.False(t, result.Valid())
+
+		summary, detail, ok := result.Warning()
+		assert.True(t, ok)
+		assert.Equal(t, "Zone not delegated", summary)
+		assert.Equal(t, `The Zone "sub.example.com" of the domain "api.sub.example.com" is not delegated to its assigned authoritative nameservers (hydrogen.ns.hetzner.com., oxygen.ns.hetzner.com.), the delegation status is "invalid".`, detail)
+	})
+}
+
+func TestDNSValidationDomainModel(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("zone not found", func(t *testing.T) {
+		o := &dnsValidationDomainModel{}
+		assert.Nil(t, o.FromAPI(ctx, dnsValidationResult{DomainName: "example.org"}))
+		assert.Equal(t, "example.org", o.DomainName.ValueString())
+		assert.True(t, o.ZoneID.IsNull())
+		assert.True(t, o.ZoneName.IsNull())
+		assert.True(t, o.DelegationStatus.IsNull())
+		assert.Empty(t, o.AssignedNameservers.Elements())
+		assert.False(t, o.Valid.ValueBool())
+	})
+
+	t.Run("zone found", func(t *testing.T) {
+		o := &dnsValidationDomainModel{}
+		assert.Nil(t, o.FromAPI(ctx, dnsValidationResult{
+			DomainName: "www.example.com",
+			Zone: &hcloud.Zone{
+				ID:   1,
+				Name: "example.com",
+				AuthoritativeNameservers: hcloud.ZoneAuthoritativeNameservers{
+					Assigned:         []string{"hydrogen.ns.hetzner.com."},
+					DelegationStatus: hcloud.ZoneDelegationStatusValid,
+				},
+			},
+		}))
+		assert.Equal(t, "www.example.com", o.DomainName.ValueString())
+		assert.Equal(t, int64(1), o.ZoneID.ValueInt64())
+		assert.Equal(t, "example.com", o.ZoneName.ValueString())
+		assert.Equal(t, "valid", o.DelegationStatus.ValueString())
+
+		nameservers := []string{}
+		assert.Nil(t, o.AssignedNameservers.ElementsAs(ctx, &nameservers, false))
+		assert.Equal(t, []string{"hydrogen.ns.hetzner.com."}, nameservers)
+		assert.True(t, o.Valid.ValueBool())
+	})
+}
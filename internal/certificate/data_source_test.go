@@ -6,11 +6,14 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/kit/randutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/certificate"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/teste2e"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/testmux"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/testsupport"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/testtemplate"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/zone"
 )
 
 func TestAccCertificateDataSource(t *testing.T) {
@@ -114,3 +117,52 @@ func TestAccCertificateDataSourceList(t *testing.T) {
 		},
 	})
 }
+
+func TestAccCertificateDNSValidationDataSource(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	resZone := &zone.RData{
+		Zone: schema.Zone{
+			Name: fmt.Sprintf("example-%s.com", randutil.GenerateID()),
+			Mode: "primary",
+		},
+	}
+	resZone.SetRName("main")
+
+	validation := &certificate.DDataDNSValidation{
+		DomainNameRefs: []string{
+			fmt.Sprintf(`"www.${%s.name}"`, resZone.TFID()),
+			`"www.example.org"`,
+		},
+	}
+	validation.SetRName("main")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		CheckDestroy:             testsupport.CheckAPIResourceAllAbsent(zone.ResourceType, zone.GetAPIResource()),
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_zone", resZone,
+					"testdata/d/hcloud_managed_certificate_dns_validation", validation,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					// The zone is not delegated, as the domain is not registered.
+					resource.TestCheckResourceAttr(validation.TFID(), "valid", "false"),
+					resource.TestCheckResourceAttr(validation.TFID(), "domains.#", "2"),
+
+					resource.TestCheckResourceAttr(validation.TFID(), "domains.0.domain_name", "www."+resZone.Name),
+					resource.TestCheckResourceAttrPair(validation.TFID(), "domains.0.zone_id", resZone.TFID(), "id"),
+					resource.TestCheckResourceAttr(validation.TFID(), "domains.0.zone_name", resZone.Name),
+					resource.TestCheckResourceAttr(validation.TFID(), "domains.0.assigned_nameservers.#", "3"),
+					resource.TestCheckResourceAttr(validation.TFID(), "domains.0.valid", "false"),
+
+					resource.TestCheckResourceAttr(validation.TFID(), "domains.1.domain_name", "www.example.org"),
+					resource.TestCheckNoResourceAttr(validation.TFID(), "domains.1.zone_id"),
+					resource.TestCheckResourceAttr(validation.TFID(), "domains.1.valid", "false"),
+				),
+			},
+		},
+	})
+}
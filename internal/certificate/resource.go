@@ -3,8 +3,12 @@ package certificate
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"slices"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -121,8 +125,8 @@ func UploadedResource() *schema.Resource {
 func ManagedResource() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: createManagedResource,
-		ReadContext:   readResource,
-		UpdateContext: updateResource,
+		ReadContext:   readManagedResource,
+		UpdateContext: updateManagedResource,
 		DeleteContext: deleteResource,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -178,6 +182,36 @@ func ManagedResource() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"status": getManagedStatusSchema(),
+		},
+	}
+}
+
+// getManagedStatusSchema returns the computed schema of the issuance and
+// renewal status of a managed certificate.
+func getManagedStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"issuance": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"renewal": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"error_code": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"error_message": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
 		},
 	}
 }
@@ -231,29 +265,104 @@ func createManagedResource(ctx context.Context, d *schema.ResourceData, m any) d
 		return hcloudutil.ErrorToDiag(err)
 	}
 	d.SetId(util.FormatID(res.Certificate.ID))
-	if err = c.Action.WaitFor(ctx, res.Action); err != nil {
-		return hcloudutil.ErrorToDiag(err)
+	waitErr := c.Action.WaitFor(ctx, res.Action)
+
+	diags := readManagedResource(ctx, d, m)
+	if diags.HasError() {
+		return diags
 	}
 
-	return readResource(ctx, d, m)
+	// The certificate is kept in the state, it will be replaced during the
+	// next apply, or its issuance may be retried using the
+	// "hcloud_managed_certificate_retry" action.
+	var actionErr hcloud.ActionError
+	if errors.As(waitErr, &actionErr) {
+		return managedIssuanceFailedDiag(opts.DomainNames, actionErr.Code, actionErr.Message)
+	}
+	if waitErr != nil {
+		return hcloudutil.ErrorToDiag(waitErr)
+	}
+
+	return nil
+}
+
+// managedIssuanceFailedDiag returns the diagnostic reported when the issuance
+// of a managed certificate failed.
+//
+// The API does not report which domains failed the validation, the failing
+// domains are extracted from the error message and default to all domains of
+// the certificate.
+func managedIssuanceFailedDiag(domainNames []string, code, message string) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "Managed certificate issuance failed",
+		Detail: fmt.Sprintf(
+			"The issuance of the managed certificate failed for the domains: %s\n\n%s (%s)\n\n"+
+				"Make sure the DNS records of the domains point to a Load Balancer or are managed by Hetzner, "+
+				"then retry the issuance using the %q action or replace the certificate.",
+			strings.Join(failingDomainNames(domainNames, message), ", "), message, code, RetryActionType,
+		),
+	}}
+}
+
+// failingDomainNames returns the domain names mentioned in the error message,
+// or all the domain names if none is mentioned. Only the exact domain names are
+// matched, "example.com" does not match "sub.example.com".
+func failingDomainNames(domainNames []string, message string) []string {
+	mentioned := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(message, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("-.*_", r)
+	}) {
+		mentioned[strings.ToLower(strings.Trim(word, "."))] = true
+	}
+
+	result := make([]string, 0, len(domainNames))
+	for _, domainName := range domainNames {
+		if mentioned[strings.ToLower(strings.TrimSuffix(domainName, "."))] {
+			result = append(result, domainName)
+		}
+	}
+	if len(result) == 0 {
+		result = append(result, domainNames...)
+	}
+	slices.Sort(result)
+	return result
 }
 
 func readResource(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	_, diags := readCertificate(ctx, d, m)
+	return diags
+}
+
+func readManagedResource(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	cert, diags := readCertificate(ctx, d, m)
+	if diags.HasError() || cert == nil {
+		return diags
+	}
+	if err := d.Set("status", getManagedStatusAttributes(cert.Status)); err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
+}
+
+// readCertificate reads the certificate into the resource data. The returned
+// certificate is nil if it does not exist anymore.
+func readCertificate(ctx context.Context, d *schema.ResourceData, m any) (*hcloud.Certificate, diag.Diagnostics) {
 	client := m.(*hcloud.Client)
 
 	cert, _, err := client.Certificate.Get(ctx, d.Id())
 	if err != nil {
 		if resourceCertificateNotFound(err, d) {
-			return nil
+			return nil, nil
 		}
-		return hcloudutil.ErrorToDiag(err)
+		return nil, hcloudutil.ErrorToDiag(err)
 	}
 	if cert == nil {
 		d.SetId("")
-		return nil
+		return nil, nil
 	}
 	setCertificateSchema(d, cert)
-	return nil
+	return cert, nil
 }
 
 func resourceCertificateNotFound(err error, d *schema.ResourceData) bool {
@@ -286,7 +395,41 @@ func getCertificateAttributes(cert *hcloud.Certificate) map[string]any {
 	}
 }
 
+// getManagedStatusAttributes returns the status attributes of a managed
+// certificate.
+func getManagedStatusAttributes(status *hcloud.CertificateStatus) []map[string]any {
+	if status == nil {
+		return []map[string]any{}
+	}
+
+	attributes := map[string]any{
+		"issuance":      string(status.Issuance),
+		"renewal":       string(status.Renewal),
+		"error_code":    "",
+		"error_message": "",
+	}
+	if status.Error != nil {
+		attributes["error_code"] = string(status.Error.Code)
+		attributes["error_message"] = status.Error.Message
+	}
+	return []map[string]any{attributes}
+}
+
 func updateResource(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if diags := updateCertificate(ctx, d, m); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+	return readResource(ctx, d, m)
+}
+
+func updateManagedResource(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if diags := updateCertificate(ctx, d, m); diags.HasError() || d.Id() == "" {
+		return diags
+	}
+	return readManagedResource(ctx, d, m)
+}
+
+func updateCertificate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	client := m.(*hcloud.Client)
 
 	cert, _, err := client.Certificate.Get(ctx, d.Id())
@@ -319,7 +462,7 @@ func updateResource(ctx context.Context, d *schema.ResourceData, m any) diag.Dia
 		}
 	}
 	d.Partial(false)
-	return readResource(ctx, d, m)
+	return nil
 }
 
 func deleteResource(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -0,0 +1,101 @@
+package certificate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func TestFailingDomainNames(t *testing.T) {
+	domainNames := []string{"www.example.com", "example.com", "example.org"}
+
+	testCases := []struct {
+		name     string
+		message  string
+		expected []string
+	}{
+		{
+			name:     "mentioned domains",
+			message:  "DNS validation failed for example.org",
+			expected: []string{"example.org"},
+		},
+		{
+			name:     "exact domains only",
+			message:  "DNS validation failed for www.example.com.",
+			expected: []string{"www.example.com"},
+		},
+		{
+			name:     "sub domain of a domain",
+			message:  "DNS validation failed for sub.example.com, example.org",
+			expected: []string{"example.org"},
+		},
+		{
+			name:     "quoted domains",
+			message:  `DNS validation failed for "example.com" and 'EXAMPLE.ORG'`,
+			expected: []string{"example.com", "example.org"},
+		},
+		{
+			name:     "no mentioned domains",
+			message:  "the certificate could not be issued",
+			expected: []string{"example.com", "example.org", "www.example.com"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, failingDomainNames(domainNames, testCase.message))
+		})
+	}
+}
+
+func TestGetManagedStatusAttributes(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   *hcloud.CertificateStatus
+		expected []map[string]any
+	}{
+		{
+			name:     "no status",
+			status:   nil,
+			expected: []map[string]any{},
+		},
+		{
+			name: "completed",
+			status: &hcloud.CertificateStatus{
+				Issuance: hcloud.CertificateStatusTypeCompleted,
+				Renewal:  hcloud.CertificateStatusTypeUnavailable,
+			},
+			expected: []map[string]any{{
+				"issuance":      "completed",
+				"renewal":       "unavailable",
+				"error_code":    "",
+				"error_message": "",
+			}},
+		},
+		{
+			name: "failed",
+			status: &hcloud.CertificateStatus{
+				Issuance: hcloud.CertificateStatusTypeFailed,
+				Renewal:  hcloud.CertificateStatusTypeUnavailable,
+				Error: &hcloud.Error{
+					Code:    "dns_zone_not_found",
+					Message: "DNS zone not found",
+				},
+			},
+			expected: []map[string]any{{
+				"issuance":      "failed",
+				"renewal":       "unavailable",
+				"error_code":    "dns_zone_not_found",
+				"error_message": "DNS zone not found",
+			}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, getManagedStatusAttributes(testCase.status))
+		})
+	}
+}
@@ -140,6 +140,8 @@ func TestAccCertificateResource_Managed(t *testing.T) {
 						fmt.Sprintf("basic-managed-cert--%d", tmplMan.RandInt)),
 					resource.TestCheckResourceAttr(res.TFID(), "type", "managed"),
 					resource.TestCheckResourceAttr(res.TFID(), "domain_names.0", res.DomainNames[0]),
+					resource.TestCheckResourceAttr(res.TFID(), "status.0.issuance", "completed"),
+					resource.TestCheckResourceAttr(res.TFID(), "status.0.error_code", ""),
 				),
 			},
 			{
@@ -57,6 +57,22 @@ func (d *DDataList) TFID() string {
 	return fmt.Sprintf("data.%s.%s", DataSourceListType, d.RName())
 }
 
+// DDataDNSValidation defines the fields for the
+// "testdata/d/hcloud_managed_certificate_dns_validation" template.
+//
+// Fields ending in Ref are meant to contain a string referencing a Terraform
+// value.
+type DDataDNSValidation struct {
+	testtemplate.DataCommon
+
+	DomainNameRefs []string
+}
+
+// TFID returns the data source identifier.
+func (d *DDataDNSValidation) TFID() string {
+	return fmt.Sprintf("data.%s.%s", DNSValidationDataSourceType, d.RName())
+}
+
 // RDataUploaded defines the fields for the "testdata/r/hcloud_uploaded_certificate"
 // template.
 type RDataUploaded struct {
@@ -2,178 +2,344 @@ package firewall
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"log"
 	"slices"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
 )
 
-var errorNoResourcesReferenced = errors.New("no resources referenced")
-
 // AttachmentResourceType is the type of the hcloud_firewall_attachment resource.
 const AttachmentResourceType = "hcloud_firewall_attachment"
 
-// AttachmentResource defines the schema for the hcloud_firewall_attachment
-// resource.
-func AttachmentResource() *schema.Resource {
-	return &schema.Resource{
-		ReadContext:   readAttachment,
-		CreateContext: createAttachment,
-		UpdateContext: updateAttachment,
-		DeleteContext: deleteAttachment,
-		Importer: &schema.ResourceImporter{
-			StateContext: resourceFirewallAttachmentImport,
-		},
+var _ resource.Resource = (*AttachmentResource)(nil)
+var _ resource.ResourceWithConfigure = (*AttachmentResource)(nil)
+var _ resource.ResourceWithConfigValidators = (*AttachmentResource)(nil)
+var _ resource.ResourceWithImportState = (*AttachmentResource)(nil)
+var _ resource.ResourceWithIdentity = (*AttachmentResource)(nil)
+var _ resource.ResourceWithUpgradeState = (*AttachmentResource)(nil)
 
-		Schema: map[string]*schema.Schema{
-			"firewall_id": {
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: true,
-			},
-			"server_ids": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeInt,
-				},
+type AttachmentResource struct {
+	client *hcloud.Client
+}
+
+func NewAttachmentResource() resource.Resource {
+	return &AttachmentResource{}
+}
+
+func (r *AttachmentResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = AttachmentResourceType
+}
+
+func (r *AttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	r.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *AttachmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.Version = 1
+	resp.Schema.MarkdownDescription = util.MarkdownDescription(`
+Attaches resources to a Hetzner Cloud Firewall.
+`)
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Firewall Attachment, equal to the ID of the Firewall.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
 			},
-			"label_selectors": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
+		},
+		"firewall_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Firewall the resources should be attached to.",
+			Required:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
 			},
 		},
+		"server_ids": schema.SetAttribute{
+			MarkdownDescription: "List of Server IDs to attach to the Firewall.",
+			ElementType:         types.Int64Type,
+			Optional:            true,
+		},
+		"label_selectors": schema.SetAttribute{
+			MarkdownDescription: "List of label selectors used to attach Servers to the Firewall.",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
 	}
 }
 
-func readAttachment(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+func (r *AttachmentResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("server_ids"),
+			path.MatchRoot("label_selectors"),
+		),
+	}
+}
+
+type attachmentResourceModel struct {
+	attachmentModel
+
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *AttachmentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceutil.IDIdentitySchema("ID of the Firewall Attachment, equal to the ID of the Firewall.")
+}
+
+func (r *AttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data attachmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
+
 	var att attachment
+	resp.Diagnostics.Append(att.FromModel(ctx, data.attachmentModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err := att.FromResourceData(d); err != nil {
-		// This happens during an import of the resource,
-		// but we really only care about the FirewallID anyway
-		if !errors.Is(err, errorNoResourcesReferenced) {
-			return diag.FromErr(err)
-		}
+	actions, _, err := r.client.Firewall.ApplyResources(ctx, &hcloud.Firewall{ID: att.FirewallID}, att.AllResources())
+	if err != nil && !hcloud.IsError(err, hcloud.ErrorCodeFirewallAlreadyApplied) {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
 
-	client := m.(*hcloud.Client)
-	fw, _, err := client.Firewall.GetByID(ctx, att.FirewallID)
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, actions...)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch fresh data from the API
+	in, _, err := r.client.Firewall.GetByID(ctx, att.FirewallID)
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	if fw == nil {
-		log.Printf("[WARN] firewall (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
+	if in == nil {
+		resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("firewall", "id", att.FirewallID))
+		return
 	}
 
-	if err := att.FromFirewall(fw); err != nil {
-		return diag.FromErr(err)
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	att.ToResourceData(d)
 
-	return nil
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func createAttachment(ctx context.Context, d *schema.ResourceData, m any) (diags diag.Diagnostics) {
-	var att attachment
+func (r *AttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data attachmentResourceModel
 
-	if err := att.FromResourceData(d); err != nil {
-		return diag.FromErr(err)
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	client := m.(*hcloud.Client)
-	actions, _, err := client.Firewall.ApplyResources(ctx, &hcloud.Firewall{ID: att.FirewallID}, att.AllResources())
-	if hcloud.IsError(err, hcloud.ErrorCodeFirewallAlreadyApplied) {
-		return readAttachment(ctx, d, m)
-	}
+	in, _, err := r.client.Firewall.GetByID(ctx, data.FirewallID.ValueInt64())
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	if err = client.Action.WaitFor(ctx, actions...); err != nil {
-		return hcloudutil.ErrorToDiag(err)
+	if in == nil {
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	return readAttachment(ctx, d, m)
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func updateAttachment(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	var (
-		tf, hc  attachment
-		actions []*hcloud.Action
-	)
+func (r *AttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, plan attachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err := tf.FromResourceData(d); err != nil {
-		return diag.FromErr(err)
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "update", updateTimeout)
+	defer cancel()
+
+	var tf, hc attachment
+	resp.Diagnostics.Append(tf.FromModel(ctx, plan.attachmentModel)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	client := m.(*hcloud.Client)
-	fw, _, err := client.Firewall.GetByID(ctx, tf.FirewallID)
+	firewall, _, err := r.client.Firewall.GetByID(ctx, tf.FirewallID)
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	if fw == nil {
-		log.Printf("[WARN] firewall (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
+	if firewall == nil {
+		resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("firewall", "id", tf.FirewallID))
+		return
 	}
-	if err := hc.FromFirewall(fw); err != nil {
-		return diag.FromErr(err)
+	if err := hc.FromFirewall(firewall); err != nil {
+		resp.Diagnostics.AddError("Invalid Firewall Resource", err.Error())
+		return
 	}
 
 	less, more := tf.DiffResources(hc)
-	as, _, err := client.Firewall.RemoveResources(ctx, fw, less)
-	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+
+	if len(less) > 0 {
+		actions, _, err := r.client.Firewall.RemoveResources(ctx, firewall, less)
+		if err != nil {
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
+		}
+		resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, actions...)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
-	actions = append(actions, as...)
 
-	as, _, err = client.Firewall.ApplyResources(ctx, fw, more)
+	if len(more) > 0 {
+		actions, _, err := r.client.Firewall.ApplyResources(ctx, firewall, more)
+		if err != nil {
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
+		}
+		resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, actions...)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Fetch fresh data from the API
+	in, _, err := r.client.Firewall.GetByID(ctx, tf.FirewallID)
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+	if in == nil {
+		resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("firewall", "id", tf.FirewallID))
+		return
 	}
-	actions = append(actions, as...)
 
-	if err = client.Action.WaitFor(ctx, actions...); err != nil {
-		return hcloudutil.ErrorToDiag(err)
+	data.attachmentModel = plan.attachmentModel
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return readAttachment(ctx, d, m)
+	data.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func deleteAttachment(ctx context.Context, d *schema.ResourceData, m any) (diags diag.Diagnostics) {
+func (r *AttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data attachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
+
 	var att attachment
+	resp.Diagnostics.Append(att.FromModel(ctx, data.attachmentModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resources := att.AllResources()
+	if len(resources) == 0 {
+		return
+	}
 
-	defer func() {
-		if diags != nil {
+	actions, _, err := r.client.Firewall.RemoveResources(ctx, &hcloud.Firewall{ID: att.FirewallID}, resources)
+	if err != nil {
+		if hcloudutil.APIErrorIsNotFound(err) || hcloud.IsError(err, hcloud.ErrorCodeFirewallResourceNotFound) {
+			// firewall or resources have already been deleted
 			return
 		}
-		d.SetId("")
-	}()
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
 
-	if err := att.FromResourceData(d); err != nil {
-		return diag.FromErr(err)
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, actions...)...)
+}
+
+func (r *AttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity resourceutil.IDIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("firewall_id"), identity.ID)...)
+		return
 	}
-	client := m.(*hcloud.Client)
-	actions, _, err := client.Firewall.RemoveResources(ctx, &hcloud.Firewall{ID: att.FirewallID}, att.AllResources())
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
-	}
-	if err = client.Action.WaitFor(ctx, actions...); err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(util.InvalidImportID("$FIREWALL_ID", req.ID))
+		return
 	}
-	return nil
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("firewall_id"), id)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(types.Int64Value(id)))...)
 }
 
 type attachment struct {
@@ -182,62 +348,23 @@ type attachment struct {
 	LabelSelectors []string
 }
 
-// FromResourceData copies the contents of d into a
-func (a *attachment) FromResourceData(d *schema.ResourceData) error {
-	// The terraform schema definition above ensures this is always set and
-	// of the correct type. Thus, there is no need to check such things.
-	a.FirewallID = util.CastInt64(d.Get("firewall_id"))
+// FromModel copies the contents of m into a.
+func (a *attachment) FromModel(ctx context.Context, m attachmentModel) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-	srvIDs, ok := d.GetOk("server_ids")
-	if ok {
-		for _, v := range srvIDs.(*schema.Set).List() {
-			a.ServerIDs = append(a.ServerIDs, util.CastInt64(v))
-		}
+	a.FirewallID = m.FirewallID.ValueInt64()
+
+	if !m.ServerIDs.IsNull() && !m.ServerIDs.IsUnknown() {
+		diags.Append(m.ServerIDs.ElementsAs(ctx, &a.ServerIDs, false)...)
 		slices.Sort(a.ServerIDs)
 	}
 
-	lSels, ok := d.GetOk("label_selectors")
-	if ok {
-		for _, v := range lSels.(*schema.Set).List() {
-			a.LabelSelectors = append(a.LabelSelectors, v.(string))
-		}
+	if !m.LabelSelectors.IsNull() && !m.LabelSelectors.IsUnknown() {
+		diags.Append(m.LabelSelectors.ElementsAs(ctx, &a.LabelSelectors, false)...)
 		slices.Sort(a.LabelSelectors)
 	}
 
-	if len(a.ServerIDs) == 0 && len(a.LabelSelectors) == 0 {
-		return errorNoResourcesReferenced
-	}
-	return nil
-}
-
-// ToResourceData copies the contents of a into d.
-//
-// Any previously existing values in d are overwritten or removed.
-func (a *attachment) ToResourceData(d *schema.ResourceData) {
-	var srvIDs, lSels *schema.Set
-
-	if len(a.ServerIDs) > 0 {
-		vals := make([]any, len(a.ServerIDs))
-		for i, id := range a.ServerIDs {
-			vals[i] = util.CastInt(id)
-		}
-		f := d.Get("server_ids").(*schema.Set).F // Returns a default value if server_ids is not present in HCL.
-		srvIDs = schema.NewSet(f, vals)
-	}
-	d.Set("server_ids", srvIDs)
-
-	if len(a.LabelSelectors) > 0 {
-		vals := make([]any, len(a.LabelSelectors))
-		for i, ls := range a.LabelSelectors {
-			vals[i] = ls
-		}
-		f := d.Get("label_selectors").(*schema.Set).F
-		lSels = schema.NewSet(f, vals)
-	}
-	d.Set("label_selectors", lSels)
-
-	d.Set("firewall_id", util.CastInt(a.FirewallID))
-	d.SetId(util.FormatID(a.FirewallID))
+	return diags
 }
 
 // FromFirewall reads the attachment data from fw into a.
@@ -348,13 +475,3 @@ func labelSelectorResource(ls string) hcloud.FirewallResource {
 		LabelSelector: &hcloud.FirewallResourceLabelSelector{Selector: ls},
 	}
 }
-
-func resourceFirewallAttachmentImport(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
-	firewallID, err := util.ParseID(d.Id())
-	if err != nil {
-		return nil, err
-	}
-	d.Set("firewall_id", util.CastInt(firewallID))
-
-	return []*schema.ResourceData{d}, nil
-}
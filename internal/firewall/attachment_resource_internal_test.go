@@ -3,27 +3,28 @@ package firewall
 import (
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
-	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
 )
 
-func TestAttachment_FromResourceData(t *testing.T) {
+func TestAttachment_FromModel(t *testing.T) {
+	ctx := t.Context()
+
 	tests := []struct {
-		name      string
-		rawData   map[string]any
-		att       attachment
-		assertErr assert.ErrorAssertionFunc
+		name  string
+		model attachmentModel
+		att   attachment
 	}{
 		{
 			name: "server_ids and label_selectors present",
-			rawData: map[string]any{
-				"firewall_id":     4711,
-				"server_ids":      []any{1, 2, 3},
-				"label_selectors": []any{"key1=value1", "key2=value2"},
+			model: attachmentModel{
+				FirewallID:     types.Int64Value(4711),
+				ServerIDs:      int64Set(3, 1, 2),
+				LabelSelectors: stringSet("key2=value2", "key1=value1"),
 			},
 			att: attachment{
 				FirewallID:     4711,
@@ -33,9 +34,10 @@ func TestAttachment_FromResourceData(t *testing.T) {
 		},
 		{
 			name: "only server_ids present",
-			rawData: map[string]any{
-				"firewall_id": 4712,
-				"server_ids":  []any{4, 5, 6},
+			model: attachmentModel{
+				FirewallID:     types.Int64Value(4712),
+				ServerIDs:      int64Set(4, 5, 6),
+				LabelSelectors: types.SetNull(types.StringType),
 			},
 			att: attachment{
 				FirewallID: 4712,
@@ -44,9 +46,10 @@ func TestAttachment_FromResourceData(t *testing.T) {
 		},
 		{
 			name: "only label_selectors present",
-			rawData: map[string]any{
-				"firewall_id":     4713,
-				"label_selectors": []any{"key3=value3", "key4=value4"},
+			model: attachmentModel{
+				FirewallID:     types.Int64Value(4713),
+				ServerIDs:      types.SetNull(types.Int64Type),
+				LabelSelectors: stringSet("key3=value3", "key4=value4"),
 			},
 			att: attachment{
 				FirewallID:     4713,
@@ -55,15 +58,14 @@ func TestAttachment_FromResourceData(t *testing.T) {
 		},
 		{
 			name: "only firewall id present",
-			rawData: map[string]any{
-				"firewall_id": 4714,
+			model: attachmentModel{
+				FirewallID:     types.Int64Value(4714),
+				ServerIDs:      types.SetNull(types.Int64Type),
+				LabelSelectors: types.SetNull(types.StringType),
 			},
 			att: attachment{
 				FirewallID: 4714,
 			},
-			assertErr: func(t assert.TestingT, err error, args ...any) bool {
-				return assert.EqualError(t, err, "no resources referenced", args...)
-			},
 		},
 	}
 
@@ -71,101 +73,90 @@ func TestAttachment_FromResourceData(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var actual attachment
 
-			data := schema.TestResourceDataRaw(t, AttachmentResource().Schema, tt.rawData)
-			err := actual.FromResourceData(data)
-			if tt.assertErr != nil {
-				tt.assertErr(t, err)
-				return
-			}
-			require.NoError(t, err)
+			diags := actual.FromModel(ctx, tt.model)
+			require.False(t, diags.HasError(), diags)
 			assert.Equal(t, tt.att, actual)
 		})
 	}
 }
 
-func TestAttachment_ToResourceData(t *testing.T) {
+func TestAttachmentModel_FromAPI(t *testing.T) {
+	ctx := t.Context()
+
 	tests := []struct {
-		name    string
-		rawData map[string]any
-		att     attachment
+		name  string
+		prior attachmentModel
+		fw    *hcloud.Firewall
+		want  attachmentModel
 	}{
 		{
 			name: "server_ids and label_selectors present",
-			att: attachment{
-				FirewallID:     4711,
-				ServerIDs:      []int64{1, 2, 3},
-				LabelSelectors: []string{"key1=value1", "key2=value2"},
+			prior: attachmentModel{
+				ServerIDs:      types.SetNull(types.Int64Type),
+				LabelSelectors: types.SetNull(types.StringType),
 			},
-		},
-		{
-			name: "only server_ids present",
-			att: attachment{
-				FirewallID: 4712,
-				ServerIDs:  []int64{4, 5, 6},
+			fw: &hcloud.Firewall{
+				ID: 4711,
+				AppliedTo: []hcloud.FirewallResource{
+					serverResource(1),
+					serverResource(2),
+					labelSelectorResource("key1=value1"),
+				},
 			},
-		},
-		{
-			name: "only label_selectors present",
-			att: attachment{
-				FirewallID:     4713,
-				LabelSelectors: []string{"key3=value3", "key4=value4"},
+			want: attachmentModel{
+				ID:             types.Int64Value(4711),
+				FirewallID:     types.Int64Value(4711),
+				ServerIDs:      int64Set(1, 2),
+				LabelSelectors: stringSet("key1=value1"),
 			},
 		},
 		{
 			name: "remove pre-existing server_ids",
-			rawData: map[string]any{
-				"firewall_id": 4714,
-				"server_ids":  []any{1, 2, 3},
+			prior: attachmentModel{
+				ServerIDs:      int64Set(1, 2, 3),
+				LabelSelectors: types.SetNull(types.StringType),
 			},
-			att: attachment{
-				FirewallID:     4714,
-				LabelSelectors: []string{"key1=value1", "key2=value2"},
+			fw: &hcloud.Firewall{
+				ID: 4712,
+				AppliedTo: []hcloud.FirewallResource{
+					labelSelectorResource("key1=value1"),
+				},
+			},
+			want: attachmentModel{
+				ID:             types.Int64Value(4712),
+				FirewallID:     types.Int64Value(4712),
+				ServerIDs:      types.SetNull(types.Int64Type),
+				LabelSelectors: stringSet("key1=value1"),
 			},
 		},
 		{
-			name: "remove pre-existing label_selectors",
-			rawData: map[string]any{
-				"firewall_id":     4714,
-				"label_selectors": []any{"key1=value1", "key2=value2"},
+			name: "preserve empty label_selectors",
+			prior: attachmentModel{
+				ServerIDs:      int64Set(1),
+				LabelSelectors: stringSet(),
 			},
-			att: attachment{
-				FirewallID: 4714,
-				ServerIDs:  []int64{1, 2, 3},
+			fw: &hcloud.Firewall{
+				ID: 4713,
+				AppliedTo: []hcloud.FirewallResource{
+					serverResource(1),
+				},
+			},
+			want: attachmentModel{
+				ID:             types.Int64Value(4713),
+				FirewallID:     types.Int64Value(4713),
+				ServerIDs:      int64Set(1),
+				LabelSelectors: stringSet(),
 			},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			data := schema.TestResourceDataRaw(t, AttachmentResource().Schema, tt.rawData)
-
-			tt.att.ToResourceData(data)
-
-			assert.Equal(t, data.Id(), util.FormatID(tt.att.FirewallID))
-			assert.Equal(t, data.Get("firewall_id"), int(tt.att.FirewallID))
-
-			srvIDdata, ok := data.GetOk("server_ids")
-			if len(tt.att.ServerIDs) > 0 {
-				assert.True(t, ok, "expected data to contain server_ids")
-
-				// Need to iterate as the types of the slices don't match: []int vs []interface{}
-				for _, id := range tt.att.ServerIDs {
-					assert.Contains(t, srvIDdata.(*schema.Set).List(), int(id))
-				}
-			} else {
-				assert.False(t, ok, "expected no server_ids in data")
-			}
-
-			labelSelData, ok := data.GetOk("label_selectors")
-			if len(tt.att.LabelSelectors) > 0 {
-				assert.True(t, ok, "expected data to contain label_selectors")
+			actual := tt.prior
 
-				for _, ls := range tt.att.LabelSelectors {
-					assert.Contains(t, labelSelData.(*schema.Set).List(), ls)
-				}
-			} else {
-				assert.False(t, ok, "expected no label_selectors in data")
-			}
+			diags := actual.FromAPI(ctx, tt.fw)
+			require.False(t, diags.HasError(), diags)
+			assert.Equal(t, tt.want, actual)
 		})
 	}
 }
@@ -339,3 +330,19 @@ func TestAttachment_DiffResources(t *testing.T) {
 		})
 	}
 }
+
+func int64Set(values ...int64) types.Set {
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elements = append(elements, types.Int64Value(v))
+	}
+	return types.SetValueMust(types.Int64Type, elements)
+}
+
+func stringSet(values ...string) types.Set {
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/firewall"
@@ -61,6 +64,45 @@ func TestAccFirewallAttachmentResource_Servers(t *testing.T) {
 	})
 }
 
+func TestAccFirewallAttachmentResource_Identity(t *testing.T) {
+	var fw hcloud.Firewall
+
+	fwRes := firewall.NewRData(t, "identity_firewall", nil, nil)
+
+	fwAttRes := firewall.NewRDataAttachment("fw_ref", fwRes.TFID()+".id")
+	fwAttRes.LabelSelectors = append(fwAttRes.LabelSelectors, "firewall-attachment=identity")
+
+	tmplMan := testtemplate.Manager{}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		CheckDestroy:             testsupport.CheckResourcesDestroyed(firewall.ResourceType, firewall.ByID(t, &fw)),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_firewall", fwRes,
+					"testdata/r/hcloud_firewall_attachment", fwAttRes,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testsupport.CheckResourceExists(fwRes.TFID(), firewall.ByID(t, &fw)),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState(fwAttRes.TFID(), tfjsonpath.New("id")),
+				},
+			},
+			{
+				// Import the Resource using its identity.
+				ResourceName:    fwAttRes.TFID(),
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func TestAccFirewallAttachmentResource_LabelSelectors(t *testing.T) {
 	var (
 		srv hcloud.Server
@@ -110,3 +152,38 @@ func TestAccFirewallAttachmentResource_LabelSelectors(t *testing.T) {
 		},
 	})
 }
+
+func TestAccFirewallAttachmentResource_UpgradePluginFramework(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	fwRes := firewall.NewRData(t, "firewall-attachment-upgrade", nil, nil)
+
+	fwAttRes := firewall.NewRDataAttachment("fw_ref", fwRes.TFID()+".id")
+	fwAttRes.LabelSelectors = append(fwAttRes.LabelSelectors, "firewall-attachment-upgrade=true")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: teste2e.PreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"hcloud": {
+						VersionConstraint: "1.66.1",
+						Source:            "hetznercloud/hcloud",
+					},
+				},
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_firewall", fwRes,
+					"testdata/r/hcloud_firewall_attachment", fwAttRes,
+				),
+			},
+			{
+				ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_firewall", fwRes,
+					"testdata/r/hcloud_firewall_attachment", fwAttRes,
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
@@ -221,3 +221,57 @@ func sortFirewallListByCreated(firewallList []*hcloud.Firewall) {
 		return firewallList[i].Created.After(firewallList[j].Created)
 	})
 }
+
+func setFirewallSchema(d *schema.ResourceData, f *hcloud.Firewall) {
+	util.SetSchemaFromAttributes(d, getFirewallAttributes(f))
+}
+
+func getFirewallAttributes(f *hcloud.Firewall) map[string]any {
+	rules := make([]map[string]any, len(f.Rules))
+	for i, rule := range f.Rules {
+		rules[i] = toTFRule(rule)
+	}
+
+	var applyTo []map[string]any
+
+	for _, a := range f.AppliedTo {
+		switch a.Type {
+		case hcloud.FirewallResourceTypeLabelSelector:
+			applyTo = append(applyTo, map[string]any{"label_selector": a.LabelSelector.Selector})
+		case hcloud.FirewallResourceTypeServer:
+			applyTo = append(applyTo, map[string]any{"server": a.Server.ID})
+		}
+	}
+
+	return map[string]any{
+		"id":       f.ID,
+		"name":     f.Name,
+		"rule":     rules,
+		"labels":   f.Labels,
+		"apply_to": applyTo,
+	}
+}
+
+func toTFRule(hcloudRule hcloud.FirewallRule) map[string]any {
+	tfRule := make(map[string]any)
+	tfRule["direction"] = string(hcloudRule.Direction)
+	tfRule["protocol"] = string(hcloudRule.Protocol)
+
+	if hcloudRule.Port != nil {
+		tfRule["port"] = hcloudRule.Port
+	}
+	if hcloudRule.Description != nil {
+		tfRule["description"] = hcloudRule.Description
+	}
+	sourceIPs := make([]string, len(hcloudRule.SourceIPs))
+	for i, sourceIP := range hcloudRule.SourceIPs {
+		sourceIPs[i] = sourceIP.String()
+	}
+	tfRule["source_ips"] = sourceIPs
+	destinationIPs := make([]string, len(hcloudRule.DestinationIPs))
+	for i, destinationIP := range hcloudRule.DestinationIPs {
+		destinationIPs[i] = destinationIP.String()
+	}
+	tfRule["destination_ips"] = destinationIPs
+	return tfRule
+}
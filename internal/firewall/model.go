@@ -0,0 +1,508 @@
+package firewall
+
+import (
+	"context"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
+)
+
+type model struct {
+	ID      types.Int64  `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Labels  types.Map    `tfsdk:"labels"`
+	Rules   types.Set    `tfsdk:"rule"`
+	ApplyTo types.Set    `tfsdk:"apply_to"`
+}
+
+var _ util.ModelFromAPI[*hcloud.Firewall] = &model{}
+
+// FromAPI populates the model from the API firewall. The current values of the model
+// are used as prior values, to preserve the notation of the IPs and the empty values
+// in the "rule" attribute, and to only write the "apply_to" attribute when it is used.
+func (m *model) FromAPI(ctx context.Context, hc *hcloud.Firewall) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	m.ID = types.Int64Value(hc.ID)
+	m.Name = types.StringValue(hc.Name)
+
+	m.Labels, newDiags = resourceutil.LabelsMapValueFrom(ctx, hc.Labels)
+	diags.Append(newDiags...)
+
+	{
+		// The API normalizes the IPs of the rules, we keep the notation chosen by
+		// the user if it matches the value returned by the API.
+		// The API also returns missing and empty values the same way, we keep the
+		// empty values of the prior rule matching the rule returned by the API.
+		notations := make(map[string]string)
+		priorByKey := make(map[string]ruleModel)
+		if !m.Rules.IsNull() && !m.Rules.IsUnknown() {
+			prior := ruleModels{}
+			diags.Append(prior.FromTerraform(ctx, m.Rules)...)
+
+			for _, item := range prior {
+				diags.Append(item.collectIPNotations(ctx, notations)...)
+
+				hcItem, newDiags := item.ToAPI(ctx)
+				diags.Append(newDiags...)
+				priorByKey[ruleKey(hcItem)] = item
+			}
+		}
+
+		value := ruleModels{}
+		diags.Append(value.FromAPI(ctx, hc.Rules)...)
+
+		for i := range value {
+			if prior, ok := priorByKey[ruleKey(hc.Rules[i])]; ok {
+				value[i].applyEmptyValues(prior)
+			}
+			diags.Append(value[i].applyIPNotations(ctx, notations)...)
+		}
+
+		m.Rules, newDiags = value.ToTerraform(ctx)
+		diags.Append(newDiags...)
+	}
+
+	// Only write the resources the firewall is applied to if the model already contains
+	// such an entry. This avoids conflicts with the "hcloud_firewall_attachment" resource.
+	if m.ApplyTo.IsNull() || (!m.ApplyTo.IsUnknown() && len(m.ApplyTo.Elements()) == 0) {
+		m.ApplyTo = types.SetValueMust((&applyToModel{}).tfType(), []attr.Value{})
+	} else {
+		value := applyToModels{}
+		diags.Append(value.FromAPI(ctx, hc.AppliedTo)...)
+
+		m.ApplyTo, newDiags = value.ToTerraform(ctx)
+		diags.Append(newDiags...)
+	}
+
+	return diags
+}
+
+type ruleModel struct {
+	Direction      types.String `tfsdk:"direction"`
+	Protocol       types.String `tfsdk:"protocol"`
+	Port           types.String `tfsdk:"port"`
+	SourceIPs      types.Set    `tfsdk:"source_ips"`
+	DestinationIPs types.Set    `tfsdk:"destination_ips"`
+	Description    types.String `tfsdk:"description"`
+}
+
+var _ util.ModelFromAPI[hcloud.FirewallRule] = &ruleModel{}
+var _ util.ModelFromTerraform[types.Object] = &ruleModel{}
+var _ util.ModelToTerraform[types.Object] = &ruleModel{}
+
+func (m *ruleModel) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"direction":       types.StringType,
+		"protocol":        types.StringType,
+		"port":            types.StringType,
+		"source_ips":      types.SetType{ElemType: types.StringType},
+		"destination_ips": types.SetType{ElemType: types.StringType},
+		"description":     types.StringType,
+	}
+}
+
+func (m *ruleModel) tfType() attr.Type {
+	return basetypes.ObjectType{AttrTypes: m.tfAttributesTypes()}
+}
+
+func (m *ruleModel) FromAPI(ctx context.Context, hc hcloud.FirewallRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	m.Direction = types.StringValue(string(hc.Direction))
+	m.Protocol = types.StringValue(string(hc.Protocol))
+
+	m.Port = types.StringNull()
+	if hc.Port != nil && *hc.Port != "" {
+		m.Port = types.StringValue(*hc.Port)
+	}
+
+	m.Description = types.StringNull()
+	if hc.Description != nil && *hc.Description != "" {
+		m.Description = types.StringValue(*hc.Description)
+	}
+
+	m.SourceIPs, newDiags = ipsFromAPI(ctx, hc.SourceIPs)
+	diags.Append(newDiags...)
+
+	m.DestinationIPs, newDiags = ipsFromAPI(ctx, hc.DestinationIPs)
+	diags.Append(newDiags...)
+
+	return diags
+}
+
+func (m *ruleModel) FromTerraform(ctx context.Context, tf types.Object) diag.Diagnostics {
+	return tf.As(ctx, m, basetypes.ObjectAsOptions{})
+}
+
+func (m *ruleModel) ToTerraform(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
+}
+
+// ToAPI returns the API firewall rule for the model.
+func (m *ruleModel) ToAPI(ctx context.Context) (hcloud.FirewallRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	hc := hcloud.FirewallRule{
+		Direction:   hcloud.FirewallRuleDirection(m.Direction.ValueString()),
+		Protocol:    hcloud.FirewallRuleProtocol(m.Protocol.ValueString()),
+		Port:        m.Port.ValueStringPointer(),
+		Description: m.Description.ValueStringPointer(),
+	}
+
+	hc.SourceIPs, newDiags = ipsToAPI(ctx, m.SourceIPs)
+	diags.Append(newDiags...)
+
+	hc.DestinationIPs, newDiags = ipsToAPI(ctx, m.DestinationIPs)
+	diags.Append(newDiags...)
+
+	return hc, diags
+}
+
+// collectIPNotations adds the IPs of the rule to the notations map, using their
+// normalized form as key.
+func (m *ruleModel) collectIPNotations(ctx context.Context, notations map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, set := range []types.Set{m.SourceIPs, m.DestinationIPs} {
+		if set.IsNull() || set.IsUnknown() {
+			continue
+		}
+
+		var ips []string
+		diags.Append(set.ElementsAs(ctx, &ips, false)...)
+
+		for _, ip := range ips {
+			notations[normalizeIP(ip)] = ip
+		}
+	}
+
+	return diags
+}
+
+// applyIPNotations replaces the IPs of the rule by the notation found in the
+// notations map.
+func (m *ruleModel) applyIPNotations(ctx context.Context, notations map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	for _, set := range []*types.Set{&m.SourceIPs, &m.DestinationIPs} {
+		if set.IsNull() || set.IsUnknown() {
+			continue
+		}
+
+		var ips []string
+		diags.Append(set.ElementsAs(ctx, &ips, false)...)
+
+		for i, ip := range ips {
+			if notation, ok := notations[ip]; ok {
+				ips[i] = notation
+			}
+		}
+
+		*set, newDiags = types.SetValueFrom(ctx, types.StringType, ips)
+		diags.Append(newDiags...)
+	}
+
+	return diags
+}
+
+// applyEmptyValues keeps the empty values of the prior rule, which the API
+// returns as missing values.
+func (m *ruleModel) applyEmptyValues(prior ruleModel) {
+	if m.Port.IsNull() && prior.Port.ValueString() == "" && !prior.Port.IsNull() && !prior.Port.IsUnknown() {
+		m.Port = prior.Port
+	}
+	if m.Description.IsNull() && prior.Description.ValueString() == "" && !prior.Description.IsNull() && !prior.Description.IsUnknown() {
+		m.Description = prior.Description
+	}
+	if m.SourceIPs.IsNull() && isEmptySet(prior.SourceIPs) {
+		m.SourceIPs = prior.SourceIPs
+	}
+	if m.DestinationIPs.IsNull() && isEmptySet(prior.DestinationIPs) {
+		m.DestinationIPs = prior.DestinationIPs
+	}
+}
+
+// ruleKey returns a key identifying the API firewall rule, where missing and
+// empty values are equal.
+func ruleKey(hc hcloud.FirewallRule) string {
+	ips := func(values []net.IPNet) string {
+		result := make([]string, 0, len(values))
+		for _, value := range values {
+			result = append(result, value.String())
+		}
+		slices.Sort(result)
+		return strings.Join(result, ",")
+	}
+
+	return strings.Join([]string{
+		string(hc.Direction),
+		string(hc.Protocol),
+		ptrValue(hc.Port),
+		ptrValue(hc.Description),
+		ips(hc.SourceIPs),
+		ips(hc.DestinationIPs),
+	}, "|")
+}
+
+func ptrValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+type ruleModels []ruleModel
+
+var _ util.ModelFromAPI[[]hcloud.FirewallRule] = &ruleModels{}
+var _ util.ModelFromTerraform[types.Set] = &ruleModels{}
+var _ util.ModelToTerraform[types.Set] = &ruleModels{}
+
+func (m *ruleModels) FromAPI(ctx context.Context, hcItems []hcloud.FirewallRule) (diags diag.Diagnostics) {
+	values := make([]ruleModel, 0, len(hcItems))
+	for _, hcItem := range hcItems {
+		value := ruleModel{}
+		diags.Append(value.FromAPI(ctx, hcItem)...)
+
+		values = append(values, value)
+	}
+
+	*m = values
+
+	return diags
+}
+
+func (m *ruleModels) FromTerraform(ctx context.Context, tf types.Set) diag.Diagnostics {
+	*m = make(ruleModels, 0, len(tf.Elements()))
+	return tf.ElementsAs(ctx, m, false)
+}
+
+func (m *ruleModels) ToTerraform(ctx context.Context) (tf types.Set, diags diag.Diagnostics) {
+	tfItems := make([]attr.Value, 0, len(*m))
+	for _, value := range *m {
+		tfItem, newDiags := value.ToTerraform(ctx)
+		diags.Append(newDiags...)
+
+		tfItems = append(tfItems, tfItem)
+	}
+
+	tf, newDiags := types.SetValue((&ruleModel{}).tfType(), tfItems)
+	diags.Append(newDiags...)
+
+	return tf, diags
+}
+
+// ToAPI returns the API firewall rules for the models.
+func (m *ruleModels) ToAPI(ctx context.Context) ([]hcloud.FirewallRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hcItems := make([]hcloud.FirewallRule, 0, len(*m))
+	for _, value := range *m {
+		hcItem, newDiags := value.ToAPI(ctx)
+		diags.Append(newDiags...)
+
+		hcItems = append(hcItems, hcItem)
+	}
+
+	return hcItems, diags
+}
+
+type applyToModel struct {
+	LabelSelector types.String `tfsdk:"label_selector"`
+	Server        types.Int64  `tfsdk:"server"`
+}
+
+var _ util.ModelFromAPI[hcloud.FirewallResource] = &applyToModel{}
+var _ util.ModelFromTerraform[types.Object] = &applyToModel{}
+var _ util.ModelToTerraform[types.Object] = &applyToModel{}
+
+func (m *applyToModel) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"label_selector": types.StringType,
+		"server":         types.Int64Type,
+	}
+}
+
+func (m *applyToModel) tfType() attr.Type {
+	return basetypes.ObjectType{AttrTypes: m.tfAttributesTypes()}
+}
+
+func (m *applyToModel) FromAPI(_ context.Context, hc hcloud.FirewallResource) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.LabelSelector = types.StringNull()
+	m.Server = types.Int64Null()
+
+	switch hc.Type {
+	case hcloud.FirewallResourceTypeLabelSelector:
+		m.LabelSelector = types.StringValue(hc.LabelSelector.Selector)
+	case hcloud.FirewallResourceTypeServer:
+		m.Server = types.Int64Value(hc.Server.ID)
+	}
+
+	return diags
+}
+
+func (m *applyToModel) FromTerraform(ctx context.Context, tf types.Object) diag.Diagnostics {
+	return tf.As(ctx, m, basetypes.ObjectAsOptions{})
+}
+
+func (m *applyToModel) ToTerraform(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
+}
+
+type applyToModels []applyToModel
+
+var _ util.ModelFromAPI[[]hcloud.FirewallResource] = &applyToModels{}
+var _ util.ModelFromTerraform[types.Set] = &applyToModels{}
+var _ util.ModelToTerraform[types.Set] = &applyToModels{}
+
+func (m *applyToModels) FromAPI(ctx context.Context, hcItems []hcloud.FirewallResource) (diags diag.Diagnostics) {
+	values := make([]applyToModel, 0, len(hcItems))
+	for _, hcItem := range hcItems {
+		if hcItem.Type != hcloud.FirewallResourceTypeLabelSelector && hcItem.Type != hcloud.FirewallResourceTypeServer {
+			continue
+		}
+
+		value := applyToModel{}
+		diags.Append(value.FromAPI(ctx, hcItem)...)
+
+		values = append(values, value)
+	}
+
+	*m = values
+
+	return diags
+}
+
+func (m *applyToModels) FromTerraform(ctx context.Context, tf types.Set) diag.Diagnostics {
+	*m = make(applyToModels, 0, len(tf.Elements()))
+	return tf.ElementsAs(ctx, m, false)
+}
+
+func (m *applyToModels) ToTerraform(ctx context.Context) (tf types.Set, diags diag.Diagnostics) {
+	tfItems := make([]attr.Value, 0, len(*m))
+	for _, value := range *m {
+		tfItem, newDiags := value.ToTerraform(ctx)
+		diags.Append(newDiags...)
+
+		tfItems = append(tfItems, tfItem)
+	}
+
+	tf, newDiags := types.SetValue((&applyToModel{}).tfType(), tfItems)
+	diags.Append(newDiags...)
+
+	return tf, diags
+}
+
+// toAttachment returns the resources of the models as an [attachment], to
+// compare them using [attachment.DiffResources].
+func (m *applyToModels) toAttachment() attachment {
+	var a attachment
+	for _, value := range *m {
+		switch {
+		case !value.LabelSelector.IsNull() && value.LabelSelector.ValueString() != "":
+			a.LabelSelectors = append(a.LabelSelectors, value.LabelSelector.ValueString())
+		case !value.Server.IsNull() && value.Server.ValueInt64() != 0:
+			a.ServerIDs = append(a.ServerIDs, value.Server.ValueInt64())
+		}
+	}
+	slices.Sort(a.ServerIDs)
+	slices.Sort(a.LabelSelectors)
+	return a
+}
+
+type attachmentModel struct {
+	ID             types.Int64 `tfsdk:"id"`
+	FirewallID     types.Int64 `tfsdk:"firewall_id"`
+	ServerIDs      types.Set   `tfsdk:"server_ids"`
+	LabelSelectors types.Set   `tfsdk:"label_selectors"`
+}
+
+var _ util.ModelFromAPI[*hcloud.Firewall] = &attachmentModel{}
+
+// FromAPI populates the model from the API firewall. The current values of the
+// model are used as prior values, to preserve empty sets defined by the user.
+func (m *attachmentModel) FromAPI(ctx context.Context, hc *hcloud.Firewall) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	att := attachment{FirewallID: hc.ID}
+	if err := att.FromFirewall(hc); err != nil {
+		diags.AddError("Invalid Firewall Resource", err.Error())
+		return diags
+	}
+
+	m.ID = types.Int64Value(hc.ID)
+	m.FirewallID = types.Int64Value(hc.ID)
+
+	switch {
+	case len(att.ServerIDs) > 0:
+		m.ServerIDs, newDiags = types.SetValueFrom(ctx, types.Int64Type, att.ServerIDs)
+		diags.Append(newDiags...)
+	case !isEmptySet(m.ServerIDs):
+		m.ServerIDs = types.SetNull(types.Int64Type)
+	}
+
+	switch {
+	case len(att.LabelSelectors) > 0:
+		m.LabelSelectors, newDiags = types.SetValueFrom(ctx, types.StringType, att.LabelSelectors)
+		diags.Append(newDiags...)
+	case !isEmptySet(m.LabelSelectors):
+		m.LabelSelectors = types.SetNull(types.StringType)
+	}
+
+	return diags
+}
+
+func ipsFromAPI(ctx context.Context, ips []net.IPNet) (types.Set, diag.Diagnostics) {
+	if len(ips) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+
+	values := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		values = append(values, ip.String())
+	}
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
+
+func ipsToAPI(ctx context.Context, set types.Set) ([]net.IPNet, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if set.IsNull() || set.IsUnknown() {
+		return nil, diags
+	}
+
+	var values []string
+	diags.Append(set.ElementsAs(ctx, &values, false)...)
+
+	ips := make([]net.IPNet, 0, len(values))
+	for _, value := range values {
+		// The value was already validated by the schema.
+		_, ip, err := net.ParseCIDR(normalizeIP(value))
+		if err != nil {
+			diags.AddError("Invalid IP", err.Error())
+			continue
+		}
+		ips = append(ips, *ip)
+	}
+	return ips, diags
+}
+
+func isEmptySet(set types.Set) bool {
+	return !set.IsNull() && !set.IsUnknown() && len(set.Elements()) == 0
+}
@@ -0,0 +1,230 @@
+package firewall
+
+import (
+	"net"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func mustParseCIDR(t *testing.T, s string) net.IPNet {
+	t.Helper()
+	_, ipNet, err := net.ParseCIDR(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *ipNet
+}
+
+func TestModel(t *testing.T) {
+	ctx := t.Context()
+
+	in := &hcloud.Firewall{
+		ID:     42,
+		Name:   "firewall",
+		Labels: map[string]string{"key": "value"},
+		Rules: []hcloud.FirewallRule{
+			{
+				Direction: hcloud.FirewallRuleDirectionIn,
+				Protocol:  hcloud.FirewallRuleProtocolTCP,
+				Port:      hcloud.Ptr("80"),
+				SourceIPs: []net.IPNet{
+					mustParseCIDR(t, "10.0.0.0/8"),
+					mustParseCIDR(t, "::/0"),
+				},
+			},
+			{
+				Direction:      hcloud.FirewallRuleDirectionOut,
+				Protocol:       hcloud.FirewallRuleProtocolICMP,
+				Port:           hcloud.Ptr(""),
+				DestinationIPs: []net.IPNet{mustParseCIDR(t, "0.0.0.0/0")},
+				Description:    hcloud.Ptr("allow icmp"),
+			},
+		},
+		AppliedTo: []hcloud.FirewallResource{
+			{Type: hcloud.FirewallResourceTypeServer, Server: &hcloud.FirewallResourceServer{ID: 1}},
+			{Type: hcloud.FirewallResourceTypeLabelSelector, LabelSelector: &hcloud.FirewallResourceLabelSelector{Selector: "key=value"}},
+		},
+	}
+
+	t.Run("from api", func(t *testing.T) {
+		o := &model{}
+		assert.Nil(t, o.FromAPI(ctx, in))
+		assert.Equal(t, int64(42), o.ID.ValueInt64())
+		assert.Equal(t, "firewall", o.Name.ValueString())
+
+		labels := map[string]string{}
+		assert.Nil(t, o.Labels.ElementsAs(ctx, &labels, false))
+		assert.Equal(t, map[string]string{"key": "value"}, labels)
+
+		rules := ruleModels{}
+		assert.Nil(t, rules.FromTerraform(ctx, o.Rules))
+		require.Len(t, rules, 2)
+
+		for _, rule := range rules {
+			switch rule.Direction.ValueString() {
+			case "in":
+				assert.Equal(t, "tcp", rule.Protocol.ValueString())
+				assert.Equal(t, "80", rule.Port.ValueString())
+				assert.Equal(t, stringSet("10.0.0.0/8", "::/0"), rule.SourceIPs)
+				assert.True(t, rule.DestinationIPs.IsNull())
+				assert.True(t, rule.Description.IsNull())
+			case "out":
+				assert.Equal(t, "icmp", rule.Protocol.ValueString())
+				assert.True(t, rule.Port.IsNull())
+				assert.True(t, rule.SourceIPs.IsNull())
+				assert.Equal(t, stringSet("0.0.0.0/0"), rule.DestinationIPs)
+				assert.Equal(t, "allow icmp", rule.Description.ValueString())
+			default:
+				t.Fatalf("unexpected direction: %s", rule.Direction)
+			}
+		}
+
+		// The prior "apply_to" is null, it must not be populated.
+		assert.False(t, o.ApplyTo.IsNull())
+		assert.Empty(t, o.ApplyTo.Elements())
+	})
+
+	t.Run("preserve ip notation", func(t *testing.T) {
+		prior := ruleModels{
+			{
+				Direction:      types.StringValue("in"),
+				Protocol:       types.StringValue("tcp"),
+				Port:           types.StringValue("80"),
+				SourceIPs:      stringSet("10.0.0.0/8", "::0/0"),
+				DestinationIPs: types.SetNull(types.StringType),
+				Description:    types.StringNull(),
+			},
+		}
+
+		o := &model{}
+		var diags diag.Diagnostics
+		o.Rules, diags = prior.ToTerraform(ctx)
+		assert.Nil(t, diags)
+
+		assert.Nil(t, o.FromAPI(ctx, in))
+
+		rules := ruleModels{}
+		assert.Nil(t, rules.FromTerraform(ctx, o.Rules))
+		require.Len(t, rules, 2)
+
+		for _, rule := range rules {
+			if rule.Direction.ValueString() == "in" {
+				assert.Equal(t, stringSet("10.0.0.0/8", "::0/0"), rule.SourceIPs)
+			}
+		}
+	})
+
+	t.Run("preserve empty values", func(t *testing.T) {
+		prior := ruleModels{
+			{
+				Direction:      types.StringValue("in"),
+				Protocol:       types.StringValue("tcp"),
+				Port:           types.StringValue("80"),
+				SourceIPs:      stringSet("10.0.0.0/8", "::0/0"),
+				DestinationIPs: stringSet(),
+				Description:    types.StringValue(""),
+			},
+			{
+				Direction:      types.StringValue("out"),
+				Protocol:       types.StringValue("icmp"),
+				Port:           types.StringValue(""),
+				SourceIPs:      stringSet(),
+				DestinationIPs: stringSet("0.0.0.0/0"),
+				Description:    types.StringValue("allow icmp"),
+			},
+		}
+
+		o := &model{}
+		var diags diag.Diagnostics
+		o.Rules, diags = prior.ToTerraform(ctx)
+		assert.Nil(t, diags)
+
+		assert.Nil(t, o.FromAPI(ctx, in))
+
+		rules := ruleModels{}
+		assert.Nil(t, rules.FromTerraform(ctx, o.Rules))
+		assert.ElementsMatch(t, prior, rules)
+	})
+
+	t.Run("empty values of other rules", func(t *testing.T) {
+		prior := ruleModels{
+			{
+				Direction:      types.StringValue("in"),
+				Protocol:       types.StringValue("tcp"),
+				Port:           types.StringValue("443"),
+				SourceIPs:      stringSet("10.0.0.0/8", "::/0"),
+				DestinationIPs: stringSet(),
+				Description:    types.StringValue(""),
+			},
+		}
+
+		o := &model{}
+		var diags diag.Diagnostics
+		o.Rules, diags = prior.ToTerraform(ctx)
+		assert.Nil(t, diags)
+
+		assert.Nil(t, o.FromAPI(ctx, in))
+
+		rules := ruleModels{}
+		assert.Nil(t, rules.FromTerraform(ctx, o.Rules))
+		for _, rule := range rules {
+			assert.True(t, rule.DestinationIPs.IsNull() || len(rule.DestinationIPs.Elements()) > 0)
+			assert.True(t, rule.Description.IsNull() || rule.Description.ValueString() != "")
+		}
+	})
+
+	t.Run("apply to", func(t *testing.T) {
+		prior := applyToModels{{LabelSelector: types.StringNull(), Server: types.Int64Value(1)}}
+
+		o := &model{}
+		var diags diag.Diagnostics
+		o.ApplyTo, diags = prior.ToTerraform(ctx)
+		assert.Nil(t, diags)
+
+		assert.Nil(t, o.FromAPI(ctx, in))
+
+		applyTo := applyToModels{}
+		assert.Nil(t, applyTo.FromTerraform(ctx, o.ApplyTo))
+		assert.ElementsMatch(t, applyToModels{
+			{LabelSelector: types.StringNull(), Server: types.Int64Value(1)},
+			{LabelSelector: types.StringValue("key=value"), Server: types.Int64Null()},
+		}, applyTo)
+
+		a := applyTo.toAttachment()
+		assert.Equal(t, []int64{1}, a.ServerIDs)
+		assert.Equal(t, []string{"key=value"}, a.LabelSelectors)
+	})
+}
+
+func TestRuleModel_ToAPI(t *testing.T) {
+	ctx := t.Context()
+
+	o := ruleModel{
+		Direction:      types.StringValue("in"),
+		Protocol:       types.StringValue("udp"),
+		Port:           types.StringValue("53"),
+		SourceIPs:      stringSet("::0/0", "10.0.0.1"),
+		DestinationIPs: types.SetNull(types.StringType),
+		Description:    types.StringNull(),
+	}
+
+	hc, diags := o.ToAPI(ctx)
+	assert.Nil(t, diags)
+	assert.Equal(t, hcloud.FirewallRuleDirectionIn, hc.Direction)
+	assert.Equal(t, hcloud.FirewallRuleProtocolUDP, hc.Protocol)
+	assert.Equal(t, hcloud.Ptr("53"), hc.Port)
+	assert.Nil(t, hc.Description)
+	assert.Nil(t, hc.DestinationIPs)
+
+	ips := make([]string, 0, len(hc.SourceIPs))
+	for _, ip := range hc.SourceIPs {
+		ips = append(ips, ip.String())
+	}
+	assert.ElementsMatch(t, []string{"::/0", "10.0.0.1/32"}, ips)
+}
@@ -2,514 +2,496 @@ package firewall
 
 import (
 	"context"
-	"errors"
-	"fmt"
-	"log"
-	"net"
-
-	"github.com/hashicorp/go-cty/cty"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/control"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
 )
 
 // ResourceType is the type name of the Hetzner Cloud Firewall resource.
 const ResourceType = "hcloud_firewall"
 
-func Resource() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceFirewallCreate,
-		ReadContext:   resourceFirewallRead,
-		UpdateContext: resourceFirewallUpdate,
-		DeleteContext: resourceFirewallDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+var _ resource.Resource = (*Resource)(nil)
+var _ resource.ResourceWithConfigure = (*Resource)(nil)
+var _ resource.ResourceWithImportState = (*Resource)(nil)
+var _ resource.ResourceWithIdentity = (*Resource)(nil)
+var _ resource.ResourceWithUpgradeState = (*Resource)(nil)
 
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"labels": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				Computed: true,
-				ValidateDiagFunc: func(i any, path cty.Path) diag.Diagnostics { // nolint:revive
-					if ok, err := hcloud.ValidateResourceLabels(i.(map[string]any)); !ok {
-						return diag.FromErr(err)
-					}
-					return nil
-				},
+type Resource struct {
+	client *hcloud.Client
+}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+func (r *Resource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = ResourceType
+}
+
+func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	r.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.Version = 1
+	resp.Schema.MarkdownDescription = util.MarkdownDescription(`
+Provides a Hetzner Cloud Firewall to represent a Firewall in the Hetzner Cloud.
+
+See the [Firewalls API documentation](https://docs.hetzner.cloud/reference/cloud#tag/firewalls) for more details.
+`)
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Firewall.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
 			},
-			"apply_to": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Computed: true,
-				DiffSuppressFunc: func(_, _, _ string, d *schema.ResourceData) bool {
-					// Diff is only valid if "apply_to" argument is set in
-					// terraform configuration.
-					_, ok := d.GetOk("apply_to")
-					return !ok // Negate because we do **not** want to suppress the diff.
-				},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"label_selector": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the Firewall.",
+			Required:            true,
+		},
+		"labels":  resourceutil.LabelsSchema(),
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	ipsValidators := []validator.Set{
+		setvalidator.ValueStringsAre(ipValidator{}),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
+		"rule": schema.SetNestedBlock{
+			MarkdownDescription: "Configuration of a Rule from this Firewall.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"direction": schema.StringAttribute{
+						MarkdownDescription: "Direction of the Firewall Rule. `in` or `out`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(hcloud.FirewallRuleDirectionIn),
+								string(hcloud.FirewallRuleDirectionOut),
+							),
 						},
-						"server": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Computed: true,
+					},
+					"protocol": schema.StringAttribute{
+						MarkdownDescription: "Protocol of the Firewall Rule. `tcp`, `icmp`, `udp`, `gre` or `esp`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(hcloud.FirewallRuleProtocolTCP),
+								string(hcloud.FirewallRuleProtocolICMP),
+								string(hcloud.FirewallRuleProtocolUDP),
+								string(hcloud.FirewallRuleProtocolGRE),
+								string(hcloud.FirewallRuleProtocolESP),
+							),
 						},
 					},
+					"port": schema.StringAttribute{
+						MarkdownDescription: "Port of the Firewall Rule. Required when `protocol` is `tcp` or `udp`. You can use `any` to allow all ports for the specific protocol. Port ranges are also possible: `80-85` allows all ports between 80 and 85.",
+						Optional:            true,
+					},
+					"source_ips": schema.SetAttribute{
+						MarkdownDescription: "List of IPs or CIDRs that are allowed within this Firewall Rule (when `direction` is `in`).",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators:          ipsValidators,
+					},
+					"destination_ips": schema.SetAttribute{
+						MarkdownDescription: "List of IPs or CIDRs that are allowed within this Firewall Rule (when `direction` is `out`).",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators:          ipsValidators,
+					},
+					"description": schema.StringAttribute{
+						MarkdownDescription: "Description of the Firewall Rule.",
+						Optional:            true,
+					},
 				},
 			},
-			"rule": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"direction": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateDiagFunc: func(i any, path cty.Path) diag.Diagnostics { // nolint:revive
-								direction := i.(string)
-								switch hcloud.FirewallRuleDirection(direction) {
-								case hcloud.FirewallRuleDirectionIn:
-								case hcloud.FirewallRuleDirectionOut:
-								default:
-									return diag.Errorf("%s is not a valid direction", direction)
-								}
-								return nil
-							},
-						},
-						"protocol": {
-							Type:     schema.TypeString,
-							Required: true,
-							ValidateDiagFunc: func(i any, path cty.Path) diag.Diagnostics { // nolint:revive
-								protocol := i.(string)
-								switch hcloud.FirewallRuleProtocol(protocol) {
-								case hcloud.FirewallRuleProtocolICMP:
-								case hcloud.FirewallRuleProtocolTCP:
-								case hcloud.FirewallRuleProtocolUDP:
-								case hcloud.FirewallRuleProtocolESP:
-								case hcloud.FirewallRuleProtocolGRE:
-								default:
-									return diag.Errorf("%s is not a valid protocol", protocol)
-								}
-								return nil
-							},
-						},
-						"port": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"source_ips": {
-							Type: schema.TypeSet,
-							Elem: &schema.Schema{
-								Type:             schema.TypeString,
-								ValidateDiagFunc: validateIPDiag,
-								StateFunc:        normalizeIP,
-							},
-							Optional: true,
-						},
-						"destination_ips": {
-							Type: schema.TypeSet,
-							Elem: &schema.Schema{
-								Type:             schema.TypeString,
-								ValidateDiagFunc: validateIPDiag,
-								StateFunc:        normalizeIP,
-							},
-							Optional: true,
-						},
-						"description": {
-							Type:     schema.TypeString,
-							Optional: true,
+		},
+		"apply_to": schema.SetNestedBlock{
+			MarkdownDescription: "Resources the Firewall should be assigned to.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"label_selector": schema.StringAttribute{
+						MarkdownDescription: "Label Selector to select servers the firewall should be applied to.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(
+								path.MatchRelative().AtParent().AtName("server"),
+							),
 						},
 					},
+					"server": schema.Int64Attribute{
+						MarkdownDescription: "ID of the server you want to apply the firewall to.",
+						Optional:            true,
+					},
 				},
 			},
 		},
 	}
 }
 
-func resourceFirewallCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+func (r *Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceutil.IDIdentitySchema("ID of the Firewall.")
+}
+
+type resourceModel struct {
+	model
+
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
 
 	opts := hcloud.FirewallCreateOpts{
-		Name: d.Get("name").(string),
+		Name: data.Name.ValueString(),
 	}
-	if rules, ok := d.GetOk("rule"); ok {
-		for _, tfRawRule := range rules.(*schema.Set).List() {
-			if rule, ok := toHcloudRule(tfRawRule); ok {
-				opts.Rules = append(opts.Rules, rule)
-			}
-		}
+
+	resp.Diagnostics.Append(hcloudutil.TerraformLabelsToHCloud(ctx, data.Labels, &opts.Labels)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if labels, ok := d.GetOk("labels"); ok {
-		tmpLabels := make(map[string]string)
-		for k, v := range labels.(map[string]any) {
-			tmpLabels[k] = v.(string)
+
+	{
+		rules := ruleModels{}
+		resp.Diagnostics.Append(rules.FromTerraform(ctx, data.Rules)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		opts.Rules, diags = rules.ToAPI(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		opts.Labels = tmpLabels
 	}
-	if applyTo, ok := d.GetOk("apply_to"); ok {
-		for _, tfApplyToRaw := range applyTo.(*schema.Set).List() {
-			tfApplyTo := tfApplyToRaw.(map[string]any)
 
-			r, _ := toHcloudFirewallResource(tfApplyTo)
-			opts.ApplyTo = append(opts.ApplyTo, r)
+	{
+		applyTo := applyToModels{}
+		resp.Diagnostics.Append(applyTo.FromTerraform(ctx, data.ApplyTo)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
+
+		att := applyTo.toAttachment()
+		opts.ApplyTo = att.AllResources()
 	}
 
-	res, _, err := client.Firewall.Create(ctx, opts)
+	result, _, err := r.client.Firewall.Create(ctx, opts)
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
 
-	if err = client.Action.WaitFor(ctx, res.Actions...); err != nil {
-		return hcloudutil.ErrorToDiag(err)
+	// Make sure to save the ID immediately so we can recover if the process stops after
+	// this call. Terraform marks the resource as "tainted", so it can be deleted and no
+	// surprise "duplicate resource" errors happen.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(result.Firewall.ID))...)
+
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, result.Actions...)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	d.SetId(util.FormatID(res.Firewall.ID))
+	// Fetch fresh data from the API
+	in, _, err := r.client.Firewall.GetByID(ctx, result.Firewall.ID)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+	if in == nil {
+		resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("firewall", "id", result.Firewall.ID))
+		return
+	}
 
-	return resourceFirewallRead(ctx, d, m)
-}
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-func toHcloudRule(tfRawRule any) (hcloud.FirewallRule, bool) {
-	tfRule := tfRawRule.(map[string]any)
-	direction := tfRule["direction"].(string)
-	protocol := tfRule["protocol"].(string)
-
-	if direction == "" || protocol == "" {
-		// We need to use state funcs in the schema above in order to normalize
-		// the values for source and destination IPs. However, this triggers
-		// Terraform SDK bug #160
-		// (https://github.com/hashicorp/terraform-plugin-sdk/issues/160):
-		// we get a defunct entry in the rules set. Since we always require
-		// protocol and direction to be set, we can just check if they are
-		// empty. In this case we can ignore the entry when talking to our API
-		// (https://github.com/hashicorp/terraform-plugin-sdk/issues/160#issuecomment-522935697).
-		return hcloud.FirewallRule{}, false
-	}
-
-	rule := hcloud.FirewallRule{
-		Direction: hcloud.FirewallRuleDirection(direction),
-		Protocol:  hcloud.FirewallRuleProtocol(protocol),
-	}
-	rawPort := tfRule["port"].(string)
-	if rawPort != "" {
-		rule.Port = new(rawPort)
-	}
-	rawDescription := tfRule["description"].(string)
-	if rawDescription != "" {
-		rule.Description = new(rawDescription)
-	}
-	for _, sourceIP := range tfRule["source_ips"].(*schema.Set).List() {
-		// We ignore the error here, because it was already validated before
-		_, source, _ := net.ParseCIDR(sourceIP.(string))
-		rule.SourceIPs = append(rule.SourceIPs, *source)
-	}
-	for _, destinationIP := range tfRule["destination_ips"].(*schema.Set).List() {
-		// We ignore the error here, because it was already validated before
-		_, destination, _ := net.ParseCIDR(destinationIP.(string))
-		rule.DestinationIPs = append(rule.DestinationIPs, *destination)
-	}
-	return rule, true
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func toTFRule(hcloudRule hcloud.FirewallRule) map[string]any {
-	tfRule := make(map[string]any)
-	tfRule["direction"] = string(hcloudRule.Direction)
-	tfRule["protocol"] = string(hcloudRule.Protocol)
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resourceModel
 
-	if hcloudRule.Port != nil {
-		tfRule["port"] = hcloudRule.Port
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if hcloudRule.Description != nil {
-		tfRule["description"] = hcloudRule.Description
+
+	in, _, err := r.client.Firewall.GetByID(ctx, data.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	sourceIPs := make([]string, len(hcloudRule.SourceIPs))
-	for i, sourceIP := range hcloudRule.SourceIPs {
-		sourceIPs[i] = sourceIP.String()
+	if in == nil {
+		resp.State.RemoveResource(ctx)
+		return
 	}
-	tfRule["source_ips"] = sourceIPs
-	destinationIPs := make([]string, len(hcloudRule.DestinationIPs))
-	for i, destinationIP := range hcloudRule.DestinationIPs {
-		destinationIPs[i] = destinationIP.String()
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	tfRule["destination_ips"] = destinationIPs
-	return tfRule
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func resourceFirewallRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, plan resourceModel
 
-	id, err := util.ParseID(d.Id())
-	if err != nil {
-		log.Printf("[WARN] invalid firewall id (%s), removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	firewall, _, err := client.Firewall.GetByID(ctx, id)
-	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
-	}
-	if firewall == nil {
-		log.Printf("[WARN] firewall (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
+	updateTimeout, diags := plan.Timeouts.Update(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	setFirewallSchema(d, firewall)
-	return nil
-}
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "update", updateTimeout)
+	defer cancel()
 
-func resourceFirewallUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+	firewall := &hcloud.Firewall{ID: data.ID.ValueInt64()}
 
-	id, err := util.ParseID(d.Id())
-	if err != nil {
-		log.Printf("[WARN] invalid firewall id (%s), removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
-	}
-	firewall, _, err := client.Firewall.GetByID(ctx, id)
-	if err != nil {
-		if resourceFirewallIsNotFound(err, d) {
-			return nil
+	// Action: Set Rules
+	if !plan.Rules.IsUnknown() && !plan.Rules.Equal(data.Rules) {
+		rules := ruleModels{}
+		resp.Diagnostics.Append(rules.FromTerraform(ctx, plan.Rules)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		return hcloudutil.ErrorToDiag(err)
-	}
 
-	d.Partial(true)
+		opts := hcloud.FirewallSetRulesOpts{}
+		opts.Rules, diags = rules.ToAPI(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-	if d.HasChange("name") {
-		description := d.Get("name").(string)
-		_, _, err := client.Firewall.Update(ctx, firewall, hcloud.FirewallUpdateOpts{
-			Name: description,
-		})
+		actions, _, err := r.client.Firewall.SetRules(ctx, firewall, opts)
 		if err != nil {
-			if resourceFirewallIsNotFound(err, d) {
-				return nil
-			}
-			return hcloudutil.ErrorToDiag(err)
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
 		}
-	}
 
-	if d.HasChange("rule") {
-		if tfRules, ok := d.GetOk("rule"); ok {
-			var rules []hcloud.FirewallRule
-			for _, tfRawRule := range tfRules.(*schema.Set).List() {
-				if rule, ok := toHcloudRule(tfRawRule); ok {
-					rules = append(rules, rule)
-				}
-			}
-			actions, _, err := client.Firewall.SetRules(ctx, firewall, hcloud.FirewallSetRulesOpts{Rules: rules})
-			if err != nil {
-				if resourceFirewallIsNotFound(err, d) {
-					return nil
-				}
-				return hcloudutil.ErrorToDiag(err)
-			}
-			if err := waitForFirewallActions(ctx, client, actions, firewall); err != nil {
-				return hcloudutil.ErrorToDiag(err)
-			}
+		resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, actions...)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	if d.HasChange("labels") {
-		labels := d.Get("labels")
-		tmpLabels := make(map[string]string)
-		for k, v := range labels.(map[string]any) {
-			tmpLabels[k] = v.(string)
-		}
-		_, _, err := client.Firewall.Update(ctx, firewall, hcloud.FirewallUpdateOpts{
-			Labels: tmpLabels,
-		})
-		if err != nil {
-			if resourceFirewallIsNotFound(err, d) {
-				return nil
-			}
-			return hcloudutil.ErrorToDiag(err)
+	// Action: Apply to resources
+	//
+	// The resources are only synced when "apply_to" is used in the configuration, to avoid
+	// conflicts with the "hcloud_firewall_attachment" resource.
+	if !plan.ApplyTo.IsUnknown() && len(plan.ApplyTo.Elements()) > 0 && !plan.ApplyTo.Equal(data.ApplyTo) {
+		resp.Diagnostics.Append(r.syncApplyTo(ctx, firewall, data.ApplyTo, plan.ApplyTo)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	if d.HasChange("apply_to") {
-		err := syncApplyTo(ctx, d, client, firewall)
-		if err != nil {
-			return err
+	// Update fields on resource
+	opts := hcloud.FirewallUpdateOpts{}
+
+	if !plan.Name.IsUnknown() && !plan.Name.Equal(data.Name) {
+		opts.Name = plan.Name.ValueString()
+	}
+	if !plan.Labels.IsUnknown() && !plan.Labels.Equal(data.Labels) {
+		resp.Diagnostics.Append(hcloudutil.TerraformLabelsToHCloud(ctx, plan.Labels, &opts.Labels)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
-	d.Partial(false)
 
-	return resourceFirewallRead(ctx, d, m)
-}
+	// Always perform the update call last, even when empty, to populate the state with fresh data returned by
+	// the update.
+	in, _, err := r.client.Firewall.Update(ctx, firewall, opts)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
 
-func syncApplyTo(ctx context.Context, d *schema.ResourceData, client *hcloud.Client, firewall *hcloud.Firewall) diag.Diagnostics {
-	o, n := d.GetChange("apply_to")
+	data.Rules = plan.Rules
+	data.ApplyTo = plan.ApplyTo
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	diffToRemove := o.(*schema.Set).Difference(n.(*schema.Set))
-	diffToAdd := n.(*schema.Set).Difference(o.(*schema.Set))
+	data.Timeouts = plan.Timeouts
 
-	removeResources := []hcloud.FirewallResource{}
-	addResources := []hcloud.FirewallResource{}
-	// We first prepare all changes to then simply apply them
-	for _, d := range diffToRemove.List() {
-		field := d.(map[string]any)
-		r, _ := toHcloudFirewallResource(field) // we ignore the error here, as it can not happen because of the validation before
-		removeResources = append(removeResources, r)
-	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
+}
 
-	for _, d := range diffToAdd.List() {
-		field := d.(map[string]any)
+func (r *Resource) syncApplyTo(ctx context.Context, firewall *hcloud.Firewall, state, plan types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-		r, _ := toHcloudFirewallResource(field) // we ignore the error here, as it can not happen because of the validation before
-		addResources = append(addResources, r)
+	var stateModels, planModels applyToModels
+	diags.Append(stateModels.FromTerraform(ctx, state)...)
+	diags.Append(planModels.FromTerraform(ctx, plan)...)
+	if diags.HasError() {
+		return diags
 	}
 
-	if len(removeResources) > 0 {
-		actions, _, err := client.Firewall.RemoveResources(ctx, firewall, removeResources)
+	stateAtt := stateModels.toAttachment()
+	planAtt := planModels.toAttachment()
+	less, more := planAtt.DiffResources(stateAtt)
+
+	if len(less) > 0 {
+		actions, _, err := r.client.Firewall.RemoveResources(ctx, firewall, less)
 		if err != nil {
 			if !hcloud.IsError(err, hcloud.ErrorCodeFirewallResourceNotFound) {
-				return hcloudutil.ErrorToDiag(err)
+				diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+				return diags
+			}
+			tflog.Warn(ctx, "Resource the firewall was applied to not found, skipping remove", map[string]any{"firewall_id": firewall.ID})
+		} else {
+			diags.Append(hcloudutil.SettleActions(ctx, &r.client.Action, actions...)...)
+			if diags.HasError() {
+				return diags
 			}
-			log.Printf("[WARN] resource that firewall (%s) was applied to not found, skipping remove: %v", d.Id(), err)
-		} else if err := waitForFirewallActions(ctx, client, actions, firewall); err != nil {
-			return hcloudutil.ErrorToDiag(err)
 		}
 	}
 
-	if len(addResources) > 0 {
-		actions, _, err := client.Firewall.ApplyResources(ctx, firewall, addResources)
+	if len(more) > 0 {
+		actions, _, err := r.client.Firewall.ApplyResources(ctx, firewall, more)
 		if err != nil {
-			return hcloudutil.ErrorToDiag(err)
-		}
-		if err := waitForFirewallActions(ctx, client, actions, firewall); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+			diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return diags
 		}
+		diags.Append(hcloudutil.SettleActions(ctx, &r.client.Action, actions...)...)
 	}
-	return nil
+
+	return diags
 }
 
-func toHcloudFirewallResource(field map[string]any) (hcloud.FirewallResource, error) {
-	var op = "toHcloudFirewallResource"
-	if labelSelector, ok := field["label_selector"].(string); ok && labelSelector != "" {
-		return hcloud.FirewallResource{Type: hcloud.FirewallResourceTypeLabelSelector, LabelSelector: &hcloud.FirewallResourceLabelSelector{Selector: labelSelector}}, nil
-	} else if server, ok := field["server"].(int); ok && server != 0 {
-		return hcloud.FirewallResource{Type: hcloud.FirewallResourceTypeServer, Server: &hcloud.FirewallResourceServer{ID: util.CastInt64(server)}}, nil
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	return hcloud.FirewallResource{}, fmt.Errorf("%s: unknown apply to resource", op)
-}
 
-func resourceFirewallDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
 
-	firewallID, err := util.ParseID(d.Id())
+	firewall, _, err := r.client.Firewall.GetByID(ctx, data.ID.ValueInt64())
 	if err != nil {
-		log.Printf("[WARN] invalid firewall id (%s), removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	firewall, _, err := client.Firewall.GetByID(ctx, firewallID)
-	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+	if firewall == nil {
+		// firewall has already been deleted
+		return
 	}
+
 	// Detach all Resources of the firewall before trying to delete it.
 	if len(firewall.AppliedTo) > 0 {
-		if err := removeFromResources(ctx, client, d, firewall); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+		actions, _, err := r.client.Firewall.RemoveResources(ctx, firewall, firewall.AppliedTo)
+		if err != nil {
+			if !hcloudutil.APIErrorIsNotFound(err) && !hcloud.IsError(err, hcloud.ErrorCodeFirewallResourceNotFound) {
+				resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+				return
+			}
+		} else {
+			resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, actions...)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
 		}
 	}
+
 	// Removing resources from the firewall can sometimes take longer. We
 	// thus retry two times the number of DefaultRetries.
-	err = control.Retry(2*control.DefaultRetries, func() error {
-		var hcerr hcloud.Error
-		_, err := client.Firewall.Delete(ctx, firewall)
-		if errors.As(err, &hcerr) {
-			switch hcerr.Code {
-			case hcloud.ErrorCodeNotFound:
-				// firewall has already been deleted
-				return nil
-			case hcloud.ErrorCodeConflict, hcloud.ErrorCodeResourceInUse:
-				return err
-			default:
-				return control.AbortRetry(err)
-			}
+	err = control.Retry(ctx, 2*control.DefaultRetries, func() error {
+		_, err := r.client.Firewall.Delete(ctx, firewall)
+		if hcloud.IsError(err, hcloud.ErrorCodeConflict) || hcloud.IsError(err, hcloud.ErrorCodeResourceInUse) {
+			return err
 		}
-		return nil
+		return control.AbortRetry(err)
 	})
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
-	}
-
-	return nil
-}
-
-func removeFromResources(ctx context.Context, client *hcloud.Client, d *schema.ResourceData, fw *hcloud.Firewall) error {
-	actions, _, err := client.Firewall.RemoveResources(ctx, fw, fw.AppliedTo)
-	if err != nil {
-		if hcloud.IsError(err, hcloud.ErrorCodeFirewallResourceNotFound) || resourceFirewallIsNotFound(err, d) {
-			return nil
+		if hcloudutil.APIErrorIsNotFound(err) {
+			// firewall has already been deleted
+			return
 		}
-		return err
-	}
-
-	return waitForFirewallActions(ctx, client, actions, fw)
-}
-
-func resourceFirewallIsNotFound(err error, d *schema.ResourceData) bool {
-	if hcloud.IsError(err, hcloud.ErrorCodeNotFound) {
-		log.Printf("[WARN] firewall (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return true
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	return false
-}
-
-func setFirewallSchema(d *schema.ResourceData, f *hcloud.Firewall) {
-	util.SetSchemaFromAttributes(d, getFirewallAttributes(f))
 }
 
-func getFirewallAttributes(f *hcloud.Firewall) map[string]any {
-	rules := make([]map[string]any, len(f.Rules))
-	for i, rule := range f.Rules {
-		rules[i] = toTFRule(rule)
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity resourceutil.IDIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		return
 	}
 
-	var applyTo []map[string]any
-
-	for _, a := range f.AppliedTo {
-		switch a.Type {
-		case hcloud.FirewallResourceTypeLabelSelector:
-			applyTo = append(applyTo, map[string]any{"label_selector": a.LabelSelector.Selector})
-		case hcloud.FirewallResourceTypeServer:
-			applyTo = append(applyTo, map[string]any{"server": a.Server.ID})
-		}
-	}
-
-	return map[string]any{
-		"id":       f.ID,
-		"name":     f.Name,
-		"rule":     rules,
-		"labels":   f.Labels,
-		"apply_to": applyTo,
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.Append(util.InvalidImportID("$FIREWALL_ID", req.ID))
+		return
 	}
-}
 
-func waitForFirewallActions(ctx context.Context, client *hcloud.Client, actions []*hcloud.Action, firewall *hcloud.Firewall) error {
-	log.Printf("[INFO] firewall (%d) waiting for %v actions to complete...", firewall.ID, len(actions))
-	if err := client.Action.WaitFor(ctx, actions...); err != nil {
-		return err
-	}
-	log.Printf("[INFO] firewall (%d) %v actions succeeded", firewall.ID, len(actions))
-	return nil
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stretchr/testify/assert"
 
@@ -289,6 +290,50 @@ func TestAccFirewallResource_Normalization(t *testing.T) {
 	})
 }
 
+func TestAccFirewallResource_EmptyValues(t *testing.T) {
+	var f hcloud.Firewall
+
+	config := fmt.Sprintf(`
+resource "hcloud_firewall" "empty-values" {
+  name = "empty-values--%d"
+
+  rule {
+    direction       = "in"
+    protocol        = "tcp"
+    port            = "22"
+    source_ips      = ["0.0.0.0/0"]
+    destination_ips = []
+    description     = ""
+  }
+
+  rule {
+    direction       = "out"
+    protocol        = "icmp"
+    source_ips      = []
+    destination_ips = ["0.0.0.0/0"]
+  }
+}
+`, acctest.RandInt())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		CheckDestroy:             testsupport.CheckResourcesDestroyed(firewall.ResourceType, firewall.ByID(t, &f)),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testsupport.CheckResourceExists("hcloud_firewall.empty-values", firewall.ByID(t, &f)),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func hasFirewallRule(
 	t *testing.T,
 	f *hcloud.Firewall,
@@ -357,3 +402,48 @@ func hasServerResource(t *testing.T, fw *hcloud.Firewall, srv *hcloud.Server) fu
 		return nil
 	}
 }
+
+func TestAccFirewallResource_UpgradePluginFramework(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	res := firewall.NewRData(t, "firewall-upgrade", []firewall.RDataRule{
+		{
+			Direction:   "in",
+			Protocol:    "tcp",
+			SourceIPs:   []string{"0.0.0.0/0", "::/0"},
+			Port:        "80",
+			Description: "allow http in",
+		},
+		{
+			Direction:      "out",
+			Protocol:       "icmp",
+			DestinationIPs: []string{"10.0.0.0/8"},
+		},
+	}, []firewall.RDataApplyTo{
+		{LabelSelector: "firewall-upgrade=true"},
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: teste2e.PreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"hcloud": {
+						VersionConstraint: "1.66.1",
+						Source:            "hetznercloud/hcloud",
+					},
+				},
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_firewall", res,
+				),
+			},
+			{
+				ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_firewall", res,
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
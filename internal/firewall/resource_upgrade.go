@@ -0,0 +1,220 @@
+package firewall
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
+)
+
+// modelV0 is the state of the firewall resource, as stored by the SDKv2
+// implementation of the resource.
+type modelV0 struct {
+	ID      types.String `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Labels  types.Map    `tfsdk:"labels"`
+	Rules   types.Set    `tfsdk:"rule"`
+	ApplyTo types.Set    `tfsdk:"apply_to"`
+	Project types.String `tfsdk:"project"`
+}
+
+func (r *Resource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":      schema.StringAttribute{Computed: true},
+					"name":    schema.StringAttribute{Required: true},
+					"labels":  schema.MapAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+					"project": schema.StringAttribute{Optional: true},
+				},
+				Blocks: map[string]schema.Block{
+					"rule": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"direction":       schema.StringAttribute{Required: true},
+								"protocol":        schema.StringAttribute{Required: true},
+								"port":            schema.StringAttribute{Optional: true},
+								"source_ips":      schema.SetAttribute{ElementType: types.StringType, Optional: true},
+								"destination_ips": schema.SetAttribute{ElementType: types.StringType, Optional: true},
+								"description":     schema.StringAttribute{Optional: true},
+							},
+						},
+					},
+					"apply_to": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"label_selector": schema.StringAttribute{Optional: true, Computed: true},
+								"server":         schema.Int64Attribute{Optional: true, Computed: true},
+							},
+						},
+					},
+				},
+			},
+			StateUpgrader: upgradeStateV0,
+		},
+	}
+}
+
+// upgradeStateV0 converts the SDKv2 state to the plugin framework state.
+//
+// The SDKv2 stored the IPs of the rules in their normalized form. Users that
+// configured IPs in a different notation will see a single in-place update of
+// the rules, after which the notation from the configuration is preserved.
+func upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior modelV0
+	var newDiags diag.Diagnostics
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := util.ParseID(prior.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Firewall ID", err.Error())
+		return
+	}
+
+	data := resourceModel{
+		model: model{
+			ID:     types.Int64Value(id),
+			Name:   prior.Name,
+			Labels: prior.Labels,
+		},
+		Project:  prior.Project,
+		Timeouts: resourceutil.TimeoutsNull(),
+	}
+
+	// The SDKv2 stored the zero values for unset attributes.
+	if data.Labels.IsNull() {
+		data.Labels = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+
+	{
+		priorRules := ruleModels{}
+		if !prior.Rules.IsNull() {
+			resp.Diagnostics.Append(priorRules.FromTerraform(ctx, prior.Rules)...)
+		}
+
+		rules := make(ruleModels, 0, len(priorRules))
+		for _, item := range priorRules {
+			// The SDKv2 sometimes stored defunct entries in the set, see
+			// https://github.com/hashicorp/terraform-plugin-sdk/issues/160.
+			if item.Direction.ValueString() == "" || item.Protocol.ValueString() == "" {
+				continue
+			}
+			if item.Port.ValueString() == "" {
+				item.Port = types.StringNull()
+			}
+			if item.Description.ValueString() == "" {
+				item.Description = types.StringNull()
+			}
+			if len(item.SourceIPs.Elements()) == 0 {
+				item.SourceIPs = types.SetNull(types.StringType)
+			}
+			if len(item.DestinationIPs.Elements()) == 0 {
+				item.DestinationIPs = types.SetNull(types.StringType)
+			}
+			rules = append(rules, item)
+		}
+
+		data.Rules, newDiags = rules.ToTerraform(ctx)
+		resp.Diagnostics.Append(newDiags...)
+	}
+
+	{
+		priorApplyTo := applyToModels{}
+		if !prior.ApplyTo.IsNull() {
+			resp.Diagnostics.Append(priorApplyTo.FromTerraform(ctx, prior.ApplyTo)...)
+		}
+
+		applyTo := make(applyToModels, 0, len(priorApplyTo))
+		for _, item := range priorApplyTo {
+			if item.LabelSelector.ValueString() == "" {
+				item.LabelSelector = types.StringNull()
+			}
+			if item.Server.ValueInt64() == 0 {
+				item.Server = types.Int64Null()
+			}
+			applyTo = append(applyTo, item)
+		}
+
+		data.ApplyTo, newDiags = applyTo.ToTerraform(ctx)
+		resp.Diagnostics.Append(newDiags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// attachmentModelV0 is the state of the firewall attachment resource, as
+// stored by the SDKv2 implementation of the resource.
+type attachmentModelV0 struct {
+	ID             types.String `tfsdk:"id"`
+	FirewallID     types.Int64  `tfsdk:"firewall_id"`
+	ServerIDs      types.Set    `tfsdk:"server_ids"`
+	LabelSelectors types.Set    `tfsdk:"label_selectors"`
+	Project        types.String `tfsdk:"project"`
+}
+
+func (r *AttachmentResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":              schema.StringAttribute{Computed: true},
+					"firewall_id":     schema.Int64Attribute{Required: true},
+					"server_ids":      schema.SetAttribute{ElementType: types.Int64Type, Optional: true},
+					"label_selectors": schema.SetAttribute{ElementType: types.StringType, Optional: true},
+					"project":         schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: upgradeAttachmentStateV0,
+		},
+	}
+}
+
+func upgradeAttachmentStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior attachmentModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := util.ParseID(prior.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Firewall Attachment ID", err.Error())
+		return
+	}
+
+	data := attachmentResourceModel{
+		attachmentModel: attachmentModel{
+			ID:             types.Int64Value(id),
+			FirewallID:     prior.FirewallID,
+			ServerIDs:      prior.ServerIDs,
+			LabelSelectors: prior.LabelSelectors,
+		},
+		Project:  prior.Project,
+		Timeouts: resourceutil.TimeoutsNull(),
+	}
+
+	// The SDKv2 did not differentiate between empty and unset sets.
+	if isEmptySet(data.ServerIDs) {
+		data.ServerIDs = types.SetNull(types.Int64Type)
+	}
+	if isEmptySet(data.LabelSelectors) {
+		data.LabelSelectors = types.SetNull(types.StringType)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
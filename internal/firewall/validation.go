@@ -1,12 +1,12 @@
 package firewall
 
 import (
+	"context"
+	"fmt"
 	"net"
 
-	"github.com/hashicorp/go-cty/cty"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-
-	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 var (
@@ -15,16 +15,41 @@ var (
 	defaultMaskIPv6 = net.CIDRMask(128, 128)
 )
 
-func validateIPDiag(i any, _ cty.Path) diag.Diagnostics {
+var _ validator.String = ipValidator{}
+
+// ipValidator validates that a string is an IP address or the start of a
+// CIDR block.
+type ipValidator struct{}
+
+func (v ipValidator) Description(_ context.Context) string {
+	return "must be an ip or the start of a cidr block"
+}
+
+func (v ipValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	raw := req.ConfigValue.ValueString()
+	if err := validateIP(raw); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(req.Path, err.Error(), raw))
+	}
+}
+
+func validateIP(i any) error {
 	i = normalizeIP(i)
 
 	ipS := i.(string)
 	ip, n, err := net.ParseCIDR(ipS)
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		return err
 	}
 	if ip.String() != n.IP.String() {
-		return diag.Errorf("%s is not the start of the cidr block %s", ipS, n)
+		return fmt.Errorf("%s is not the start of the cidr block %s", ipS, n)
 	}
 	return nil
 }
@@ -211,3 +211,29 @@ func dataSourceHcloudFloatingIPListRead(ctx context.Context, d *schema.ResourceD
 
 	return nil
 }
+
+func setFloatingIPSchema(d *schema.ResourceData, f *hcloud.FloatingIP) {
+	util.SetSchemaFromAttributes(d, getFloatingIPAttributes(f))
+}
+
+func getFloatingIPAttributes(f *hcloud.FloatingIP) map[string]any {
+	res := map[string]any{
+		"id":                f.ID,
+		"ip_address":        f.IP.String(),
+		"name":              f.Name,
+		"type":              f.Type,
+		"home_location":     f.HomeLocation.Name,
+		"description":       f.Description,
+		"labels":            f.Labels,
+		"delete_protection": f.Protection.Delete,
+	}
+
+	if f.Type == hcloud.FloatingIPTypeIPv6 {
+		res["ip_network"] = f.Network.String()
+	}
+	if f.Server != nil {
+		res["server_id"] = f.Server.ID
+	}
+
+	return res
+}
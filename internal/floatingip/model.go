@@ -0,0 +1,116 @@
+package floatingip
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
+)
+
+type model struct {
+	ID               types.Int64  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	Description      types.String `tfsdk:"description"`
+	HomeLocation     types.String `tfsdk:"home_location"`
+	ServerID         types.Int64  `tfsdk:"server_id"`
+	IPAddress        types.String `tfsdk:"ip_address"`
+	IPNetwork        types.String `tfsdk:"ip_network"`
+	Labels           types.Map    `tfsdk:"labels"`
+	DeleteProtection types.Bool   `tfsdk:"delete_protection"`
+}
+
+var _ util.ModelFromAPI[*hcloud.FloatingIP] = &model{}
+var _ util.ModelToTerraform[types.Object] = &model{}
+
+func (m *model) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                types.Int64Type,
+		"name":              types.StringType,
+		"type":              types.StringType,
+		"description":       types.StringType,
+		"home_location":     types.StringType,
+		"server_id":         types.Int64Type,
+		"ip_address":        types.StringType,
+		"ip_network":        types.StringType,
+		"labels":            types.MapType{ElemType: types.StringType},
+		"delete_protection": types.BoolType,
+	}
+}
+
+func (m *model) FromAPI(ctx context.Context, hc *hcloud.FloatingIP) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	m.ID = types.Int64Value(hc.ID)
+	m.Name = types.StringValue(hc.Name)
+	m.Type = types.StringValue(string(hc.Type))
+	m.Description = types.StringValue(hc.Description)
+	m.HomeLocation = types.StringValue(hc.HomeLocation.Name)
+
+	if hc.Server != nil {
+		m.ServerID = types.Int64Value(hc.Server.ID)
+	} else {
+		m.ServerID = types.Int64Value(0)
+	}
+
+	m.IPAddress = types.StringValue(hc.IP.String())
+	if hc.Type == hcloud.FloatingIPTypeIPv6 {
+		m.IPNetwork = types.StringValue(hc.Network.String())
+	} else {
+		m.IPNetwork = types.StringNull()
+	}
+
+	m.Labels, newDiags = resourceutil.LabelsMapValueFrom(ctx, hc.Labels)
+	diags.Append(newDiags...)
+
+	m.DeleteProtection = types.BoolValue(hc.Protection.Delete)
+
+	return diags
+}
+
+func (m *model) ToTerraform(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
+}
+
+type assignmentModel struct {
+	ID           types.Int64 `tfsdk:"id"`
+	FloatingIPID types.Int64 `tfsdk:"floating_ip_id"`
+	ServerID     types.Int64 `tfsdk:"server_id"`
+}
+
+var _ util.ModelFromAPI[*hcloud.FloatingIP] = &assignmentModel{}
+var _ util.ModelToTerraform[types.Object] = &assignmentModel{}
+
+func (m *assignmentModel) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":             types.Int64Type,
+		"floating_ip_id": types.Int64Type,
+		"server_id":      types.Int64Type,
+	}
+}
+
+// FromAPI populates the model from the API Floating IP, which must be assigned
+// to a server.
+func (m *assignmentModel) FromAPI(_ context.Context, hc *hcloud.FloatingIP) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Since a Floating IP can only be assigned to one server, the Floating IP ID
+	// is used as the assignment ID.
+	m.ID = types.Int64Value(hc.ID)
+	m.FloatingIPID = types.Int64Value(hc.ID)
+	if hc.Server != nil {
+		m.ServerID = types.Int64Value(hc.Server.ID)
+	}
+
+	return diags
+}
+
+func (m *assignmentModel) ToTerraform(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
+}
@@ -0,0 +1,85 @@
+package floatingip
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func TestModel(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("ipv4", func(t *testing.T) {
+		in := &hcloud.FloatingIP{
+			ID:           42,
+			Name:         "floating-ip",
+			Description:  "description",
+			Type:         hcloud.FloatingIPTypeIPv4,
+			IP:           net.ParseIP("131.232.99.42"),
+			HomeLocation: &hcloud.Location{Name: "fsn1"},
+			Server:       &hcloud.Server{ID: 4711},
+			Labels:       map[string]string{"key": "value"},
+			Protection:   hcloud.FloatingIPProtection{Delete: true},
+		}
+
+		o := &model{}
+		assert.Nil(t, o.FromAPI(ctx, in))
+		assert.Equal(t, int64(42), o.ID.ValueInt64())
+		assert.Equal(t, "floating-ip", o.Name.ValueString())
+		assert.Equal(t, "description", o.Description.ValueString())
+		assert.Equal(t, "ipv4", o.Type.ValueString())
+		assert.Equal(t, "131.232.99.42", o.IPAddress.ValueString())
+		assert.True(t, o.IPNetwork.IsNull())
+		assert.Equal(t, "fsn1", o.HomeLocation.ValueString())
+		assert.Equal(t, int64(4711), o.ServerID.ValueInt64())
+
+		labels := map[string]string{}
+		assert.Nil(t, o.Labels.ElementsAs(ctx, &labels, false))
+		assert.Equal(t, map[string]string{"key": "value"}, labels)
+
+		assert.Equal(t, true, o.DeleteProtection.ValueBool())
+	})
+
+	t.Run("ipv6", func(t *testing.T) {
+		ip, network, err := net.ParseCIDR("2001:db8::/64")
+		require.NoError(t, err)
+
+		in := &hcloud.FloatingIP{
+			ID:           42,
+			Name:         "floating-ip",
+			Type:         hcloud.FloatingIPTypeIPv6,
+			IP:           ip,
+			Network:      network,
+			HomeLocation: &hcloud.Location{Name: "fsn1"},
+			Labels:       map[string]string{},
+		}
+
+		o := &model{}
+		assert.Nil(t, o.FromAPI(ctx, in))
+		assert.Equal(t, "ipv6", o.Type.ValueString())
+		assert.Equal(t, "2001:db8::", o.IPAddress.ValueString())
+		assert.Equal(t, "2001:db8::/64", o.IPNetwork.ValueString())
+		assert.Equal(t, "", o.Description.ValueString())
+		assert.Equal(t, int64(0), o.ServerID.ValueInt64())
+		assert.Equal(t, false, o.DeleteProtection.ValueBool())
+	})
+}
+
+func TestAssignmentModel(t *testing.T) {
+	ctx := t.Context()
+
+	in := &hcloud.FloatingIP{
+		ID:     42,
+		Server: &hcloud.Server{ID: 4711},
+	}
+
+	o := &assignmentModel{}
+	assert.Nil(t, o.FromAPI(ctx, in))
+	assert.Equal(t, int64(42), o.ID.ValueInt64())
+	assert.Equal(t, int64(42), o.FloatingIPID.ValueInt64())
+	assert.Equal(t, int64(4711), o.ServerID.ValueInt64())
+}
@@ -2,336 +2,431 @@ package floatingip
 
 import (
 	"context"
-	"log"
-
-	"github.com/hashicorp/go-cty/cty"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
 )
 
 // ResourceType is the type name of the Hetzner Cloud FloatingIP resource.
 const ResourceType = "hcloud_floating_ip"
 
-// Resource creates a new Terraform schema for the hcloud_floating_ip resource.
-func Resource() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceFloatingIPCreate,
-		ReadContext:   resourceFloatingIPRead,
-		UpdateContext: resourceFloatingIPUpdate,
-		DeleteContext: resourceFloatingIPDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: map[string]*schema.Schema{
-			"type": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+var _ resource.Resource = (*Resource)(nil)
+var _ resource.ResourceWithConfigure = (*Resource)(nil)
+var _ resource.ResourceWithImportState = (*Resource)(nil)
+var _ resource.ResourceWithIdentity = (*Resource)(nil)
+var _ resource.ResourceWithUpgradeState = (*Resource)(nil)
+
+type Resource struct {
+	client *hcloud.Client
+}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+func (r *Resource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = ResourceType
+}
+
+func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	r.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.Version = 1
+	resp.Schema.MarkdownDescription = util.MarkdownDescription(`
+Provides a Hetzner Cloud Floating IP to represent a publicly-accessible static IP address that can be mapped to one of your servers.
+
+See the [Floating IPs API documentation](https://docs.hetzner.cloud/reference/cloud#tag/floating-ips) for more details.
+`)
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Floating IP.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
 			},
-			"name": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Type of the Floating IP (`ipv4` or `ipv6`).",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
 			},
-			"description": {
-				Type:     schema.TypeString,
-				Optional: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(hcloud.FloatingIPTypeIPv4),
+					string(hcloud.FloatingIPTypeIPv6),
+				),
 			},
-			"home_location": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the Floating IP.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
-			"server_id": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the Floating IP.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
-			"ip_address": {
-				Type:     schema.TypeString,
-				Computed: true,
+		},
+		"home_location": schema.StringAttribute{
+			MarkdownDescription: "Name of the home Location of the Floating IP (routing is optimized for that Location). Optional if `server_id` is set.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+				stringplanmodifier.UseStateForUnknown(),
 			},
-			"ip_network": {
-				Type:     schema.TypeString,
-				Computed: true,
+		},
+		"server_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Server to assign the Floating IP to. Optional if `home_location` is set.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
 			},
-			"labels": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				ValidateDiagFunc: func(i any, path cty.Path) diag.Diagnostics { // nolint:revive
-					if ok, err := hcloud.ValidateResourceLabels(i.(map[string]any)); !ok {
-						return diag.FromErr(err)
-					}
-					return nil
-				},
+		},
+		"ip_address": schema.StringAttribute{
+			MarkdownDescription: "IP address of the Floating IP.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
-			"delete_protection": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+		},
+		"ip_network": schema.StringAttribute{
+			MarkdownDescription: "IP network of the Floating IP. Only set if `type` is `ipv6`.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"labels": resourceutil.LabelsSchema(),
+		"delete_protection": schema.BoolAttribute{
+			MarkdownDescription: "Whether delete protection is enabled.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"project": resourceutil.ProjectAttribute(),
 	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
+	}
+}
+
+func (r *Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceutil.IDIdentitySchema("ID of the Floating IP.")
 }
 
-func resourceFloatingIPCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+type resourceModel struct {
+	model
+
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
 
 	opts := hcloud.FloatingIPCreateOpts{
-		Type:        hcloud.FloatingIPType(d.Get("type").(string)),
-		Description: new(d.Get("description").(string)),
+		Type: hcloud.FloatingIPType(data.Type.ValueString()),
 	}
-	if name, ok := d.GetOk("name"); ok {
-		opts.Name = new(name.(string))
+
+	if !data.Name.IsUnknown() && !data.Name.IsNull() {
+		opts.Name = data.Name.ValueStringPointer()
 	}
-	if serverID, ok := d.GetOk("server_id"); ok {
-		opts.Server = &hcloud.Server{ID: util.CastInt64(serverID)}
+	if !data.Description.IsUnknown() && !data.Description.IsNull() {
+		opts.Description = data.Description.ValueStringPointer()
 	}
-	if homeLocation, ok := d.GetOk("home_location"); ok {
-		opts.HomeLocation = &hcloud.Location{Name: homeLocation.(string)}
+	if !data.HomeLocation.IsUnknown() && !data.HomeLocation.IsNull() {
+		opts.HomeLocation = &hcloud.Location{Name: data.HomeLocation.ValueString()}
 	}
-	if labels, ok := d.GetOk("labels"); ok {
-		tmpLabels := make(map[string]string)
-		for k, v := range labels.(map[string]any) {
-			tmpLabels[k] = v.(string)
-		}
-		opts.Labels = tmpLabels
+	if data.ServerID.ValueInt64() != 0 {
+		opts.Server = &hcloud.Server{ID: data.ServerID.ValueInt64()}
 	}
 
-	res, _, err := client.FloatingIP.Create(ctx, opts)
+	resp.Diagnostics.Append(hcloudutil.TerraformLabelsToHCloud(ctx, data.Labels, &opts.Labels)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, _, err := r.client.FloatingIP.Create(ctx, opts)
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
 
-	d.SetId(util.FormatID(res.FloatingIP.ID))
-	if err = client.Action.WaitFor(ctx, res.Action); err != nil {
-		return hcloudutil.ErrorToDiag(err)
+	// Make sure to save the ID immediately so we can recover if the process stops after
+	// this call. Terraform marks the resource as "tainted", so it can be deleted and no
+	// surprise "duplicate resource" errors happen.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(result.FloatingIP.ID))...)
+
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, result.Action)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	deleteProtection := d.Get("delete_protection").(bool)
-	if deleteProtection {
-		if err := setProtection(ctx, client, res.FloatingIP, deleteProtection); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+	if data.DeleteProtection.ValueBool() {
+		resp.Diagnostics.Append(setProtection(ctx, r.client, result.FloatingIP, true)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	return resourceFloatingIPRead(ctx, d, m)
+	// Fetch fresh data from the API
+	in, _, err := r.client.FloatingIP.GetByID(ctx, result.FloatingIP.ID)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+	if in == nil {
+		resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("floating ip", "id", result.FloatingIP.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func resourceFloatingIPRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resourceModel
 
-	id, err := util.ParseID(d.Id())
-	if err != nil {
-		log.Printf("[WARN] invalid Floating IP id (%s), removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	floatingIP, _, err := client.FloatingIP.GetByID(ctx, id)
+	in, _, err := r.client.FloatingIP.GetByID(ctx, data.ID.ValueInt64())
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	if floatingIP == nil {
-		log.Printf("[WARN] Floating IP (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
+	if in == nil {
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	setFloatingIPSchema(d, floatingIP)
-	return nil
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func resourceFloatingIPUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, plan resourceModel
 
-	id, err := util.ParseID(d.Id())
-	if err != nil {
-		log.Printf("[WARN] invalid Floating IP id (%s), removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	floatingIP := &hcloud.FloatingIP{ID: id}
 
-	d.Partial(true)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "update", updateTimeout)
+	defer cancel()
+
+	floatingIP := &hcloud.FloatingIP{ID: data.ID.ValueInt64()}
 
-	if d.HasChange("description") {
-		description := d.Get("description").(string)
-		_, _, err := client.FloatingIP.Update(ctx, floatingIP, hcloud.FloatingIPUpdateOpts{
-			Description: description,
-		})
+	// Action: Server
+	if !plan.ServerID.IsUnknown() && !plan.ServerID.Equal(data.ServerID) {
+		var action *hcloud.Action
+		var err error
+
+		if plan.ServerID.ValueInt64() == 0 {
+			action, _, err = r.client.FloatingIP.Unassign(ctx, floatingIP)
+		} else {
+			action, _, err = r.client.FloatingIP.Assign(ctx, floatingIP, &hcloud.Server{ID: plan.ServerID.ValueInt64()})
+		}
 		if err != nil {
-			if resourceFloatingIPIsNotFound(err, d) {
-				return nil
-			}
-			return hcloudutil.ErrorToDiag(err)
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
 		}
-	}
 
-	if d.HasChange("name") {
-		name := d.Get("name").(string)
-		_, _, err := client.FloatingIP.Update(ctx, floatingIP, hcloud.FloatingIPUpdateOpts{
-			Name: name,
-		})
-		if err != nil {
-			if resourceFloatingIPIsNotFound(err, d) {
-				return nil
-			}
-			return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, action)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	if d.HasChange("server_id") {
-		serverID := util.CastInt64(d.Get("server_id"))
-		if serverID == 0 {
-			action, _, err := client.FloatingIP.Unassign(ctx, floatingIP)
-			if err != nil {
-				if resourceFloatingIPIsNotFound(err, d) {
-					return nil
-				}
-				return hcloudutil.ErrorToDiag(err)
-			}
-			if err = client.Action.WaitFor(ctx, action); err != nil {
-				return hcloudutil.ErrorToDiag(err)
-			}
-		} else {
-			action, _, err := client.FloatingIP.Assign(ctx, floatingIP, &hcloud.Server{ID: serverID})
-			if err != nil {
-				if resourceFloatingIPIsNotFound(err, d) {
-					return nil
-				}
-				return hcloudutil.ErrorToDiag(err)
-			}
-			if err = client.Action.WaitFor(ctx, action); err != nil {
-				return hcloudutil.ErrorToDiag(err)
-			}
+	// Action: Delete Protection
+	if !plan.DeleteProtection.IsUnknown() && !plan.DeleteProtection.Equal(data.DeleteProtection) {
+		resp.Diagnostics.Append(setProtection(ctx, r.client, floatingIP, plan.DeleteProtection.ValueBool())...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
-	if d.HasChange("labels") {
-		labels := d.Get("labels")
-		tmpLabels := make(map[string]string)
-		for k, v := range labels.(map[string]any) {
-			tmpLabels[k] = v.(string)
-		}
-		_, _, err := client.FloatingIP.Update(ctx, floatingIP, hcloud.FloatingIPUpdateOpts{
-			Labels: tmpLabels,
-		})
-		if err != nil {
-			if resourceFloatingIPIsNotFound(err, d) {
-				return nil
-			}
-			return hcloudutil.ErrorToDiag(err)
+
+	// Update fields on resource
+	opts := hcloud.FloatingIPUpdateOpts{}
+
+	if !plan.Name.IsUnknown() && !plan.Name.Equal(data.Name) {
+		opts.Name = plan.Name.ValueString()
+	}
+	if !plan.Description.IsUnknown() && !plan.Description.Equal(data.Description) {
+		opts.Description = plan.Description.ValueString()
+	}
+	if !plan.Labels.IsUnknown() && !plan.Labels.Equal(data.Labels) {
+		resp.Diagnostics.Append(hcloudutil.TerraformLabelsToHCloud(ctx, plan.Labels, &opts.Labels)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	if d.HasChange("delete_protection") {
-		deletionProtection := d.Get("delete_protection").(bool)
-		if err := setProtection(ctx, client, floatingIP, deletionProtection); err != nil {
-			return hcloudutil.ErrorToDiag(err)
-		}
+	// Always perform the update call last, even when empty, to populate the state with fresh data returned by
+	// the update.
+	in, _, err := r.client.FloatingIP.Update(ctx, floatingIP, opts)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	d.Partial(false)
+	data.Timeouts = plan.Timeouts
 
-	return resourceFloatingIPRead(ctx, d, m)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func resourceFloatingIPDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resourceModel
 
-	floatingIPID, err := util.ParseID(d.Id())
-	if err != nil {
-		log.Printf("[WARN] invalid Floating IP id (%s), removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	floatingIP, _, err := client.FloatingIP.GetByID(ctx, floatingIPID)
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
+
+	floatingIP, _, err := r.client.FloatingIP.GetByID(ctx, data.ID.ValueInt64())
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
 	if floatingIP == nil {
-		log.Printf("[WARN] Floating IP ID (%v) not found, removing Floating IP from state", floatingIPID)
-		d.SetId("")
-		return nil
+		return
 	}
 
+	// Unassign Floating IP before deletion
 	if floatingIP.Server != nil {
-		action, _, err := client.FloatingIP.Unassign(ctx, floatingIP)
+		action, _, err := r.client.FloatingIP.Unassign(ctx, floatingIP)
 		if err != nil {
-			if resourceFloatingIPIsNotFound(err, d) {
-				// floating ip has already been deleted
-				return nil
+			if hcloudutil.APIErrorIsNotFound(err) {
+				return
 			}
-
-			return hcloudutil.ErrorToDiag(err)
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
 		}
 
-		if err = client.Action.WaitFor(ctx, action); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, action)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	if _, err = client.FloatingIP.Delete(ctx, floatingIP); err != nil {
-		if hcloud.IsError(err, hcloud.ErrorCodeNotFound) {
-			// floating ip has already been deleted
-			return nil
+	if _, err := r.client.FloatingIP.Delete(ctx, floatingIP); err != nil {
+		if hcloudutil.APIErrorIsNotFound(err) {
+			return
 		}
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-
-	return nil
 }
 
-func resourceFloatingIPIsNotFound(err error, d *schema.ResourceData) bool {
-	if hcloud.IsError(err, hcloud.ErrorCodeNotFound) {
-		log.Printf("[WARN] Floating IP (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return true
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity resourceutil.IDIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		return
 	}
-	return false
-}
 
-func setFloatingIPSchema(d *schema.ResourceData, f *hcloud.FloatingIP) {
-	util.SetSchemaFromAttributes(d, getFloatingIPAttributes(f))
-}
-
-func getFloatingIPAttributes(f *hcloud.FloatingIP) map[string]any {
-	res := map[string]any{
-		"id":                f.ID,
-		"ip_address":        f.IP.String(),
-		"name":              f.Name,
-		"type":              f.Type,
-		"home_location":     f.HomeLocation.Name,
-		"description":       f.Description,
-		"labels":            f.Labels,
-		"delete_protection": f.Protection.Delete,
-	}
-
-	if f.Type == hcloud.FloatingIPTypeIPv6 {
-		res["ip_network"] = f.Network.String()
-	}
-	if f.Server != nil {
-		res["server_id"] = f.Server.ID
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.Append(util.InvalidImportID("$FLOATING_IP_ID", req.ID))
+		return
 	}
 
-	return res
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
-func setProtection(ctx context.Context, c *hcloud.Client, f *hcloud.FloatingIP, deleteProtection bool) error {
+func setProtection(ctx context.Context, c *hcloud.Client, f *hcloud.FloatingIP, deleteProtection bool) diag.Diagnostics {
 	action, _, err := c.FloatingIP.ChangeProtection(ctx, f,
 		hcloud.FloatingIPChangeProtectionOpts{
 			Delete: &deleteProtection,
 		},
 	)
 	if err != nil {
-		return err
+		return hcloudutil.APIErrorDiagnostics(err)
 	}
 
-	return c.Action.WaitFor(ctx, action)
+	return hcloudutil.SettleActions(ctx, &c.Action, action)
 }
@@ -2,206 +2,302 @@ package floatingip
 
 import (
 	"context"
-	"log"
+	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
 )
 
 // AssignmentResourceType is the type name of the Hetzner Cloud FloatingIP resource.
 const AssignmentResourceType = "hcloud_floating_ip_assignment"
 
-// AssignmentResource creates a new Terraform schema for the
-// hcloud_floating_ip_assignment resource.
-func AssignmentResource() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceFloatingIPAssignmentCreate,
-		ReadContext:   resourceFloatingIPAssignmentRead,
-		UpdateContext: resourceFloatingIPAssignmentUpdate,
-		DeleteContext: resourceFloatingIPAssignmentDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: map[string]*schema.Schema{
-			"floating_ip_id": {
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: true,
+var _ resource.Resource = (*AssignmentResource)(nil)
+var _ resource.ResourceWithConfigure = (*AssignmentResource)(nil)
+var _ resource.ResourceWithImportState = (*AssignmentResource)(nil)
+var _ resource.ResourceWithIdentity = (*AssignmentResource)(nil)
+var _ resource.ResourceWithUpgradeState = (*AssignmentResource)(nil)
+
+type AssignmentResource struct {
+	client *hcloud.Client
+}
+
+func NewAssignmentResource() resource.Resource {
+	return &AssignmentResource{}
+}
+
+func (r *AssignmentResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = AssignmentResourceType
+}
+
+func (r *AssignmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	r.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *AssignmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.Version = 1
+	resp.Schema.MarkdownDescription = util.MarkdownDescription(`
+Provides a Hetzner Cloud Floating IP Assignment to assign a Floating IP to a Hetzner Cloud Server.
+Deleting a Floating IP Assignment will unassign the Floating IP from the Server.
+`)
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Floating IP Assignment, equal to the ID of the Floating IP.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
 			},
-			"server_id": {
-				Type:     schema.TypeInt,
-				Required: true,
+		},
+		"floating_ip_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Floating IP.",
+			Required:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
 			},
 		},
+		"server_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Server to assign the Floating IP to.",
+			Required:            true,
+		},
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
 	}
 }
 
-func resourceFloatingIPAssignmentCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+type assignmentResourceModel struct {
+	assignmentModel
 
-	floatingIPID := d.Get("floating_ip_id")
-	floatingIP := &hcloud.FloatingIP{ID: util.CastInt64(floatingIPID)}
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
 
-	serverID := d.Get("server_id")
+func (r *AssignmentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceutil.IDIdentitySchema("ID of the Floating IP Assignment, equal to the ID of the Floating IP.")
+}
 
-	server := &hcloud.Server{ID: util.CastInt64(serverID)}
+func (r *AssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data assignmentResourceModel
 
-	action, _, err := client.FloatingIP.Assign(ctx, floatingIP, server)
-	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if err = client.Action.WaitFor(ctx, action); err != nil {
-		return hcloudutil.ErrorToDiag(err)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Since a floating ip can only be assigned to one server
-	// we can use the floating ip id as floating ip association id.
-	d.SetId(util.FormatID(floatingIP.ID))
-	return resourceFloatingIPAssignmentRead(ctx, d, m)
-}
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
 
-func resourceFloatingIPAssignmentRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+	floatingIP := &hcloud.FloatingIP{ID: data.FloatingIPID.ValueInt64()}
+	server := &hcloud.Server{ID: data.ServerID.ValueInt64()}
 
-	floatingIPID, err := util.ParseID(d.Id())
+	action, _, err := r.client.FloatingIP.Assign(ctx, floatingIP, server)
 	if err != nil {
-		log.Printf("[WARN] Floating IP ID (%s) not found, removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	// Make sure to save the ID immediately so we can recover if the process stops after
+	// this call. Terraform marks the resource as "tainted", so it can be deleted and no
+	// surprise "duplicate resource" errors happen.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(floatingIP.ID))...)
+
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, action)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// 'floating_ip_id' and 'server_id' is 'Required' and 'TypeInt'
-	// therefore the cast should always work
-	floatingIP, _, err := client.FloatingIP.GetByID(ctx, floatingIPID)
+	// Fetch fresh data from the API
+	in, _, err := r.client.FloatingIP.GetByID(ctx, floatingIP.ID)
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	if floatingIP == nil {
-		log.Printf("[WARN] Floating IP ID (%v) not found, removing Floating IP Association from state", d.Get("floating_ip_id"))
-		d.SetId("")
-		return nil
+	if in == nil {
+		resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("floating ip", "id", floatingIP.ID))
+		return
 	}
 
-	// check if floating api is assigned to any server
-	if floatingIP.Server == nil {
-		log.Printf("[WARN] Floating IP (%v) is not associated to a server, removing Floating IP Association from state", d.Get("floating_ip_id"))
-		d.SetId("")
-		return nil
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// when importing the resource the server_id is not given
-	// because only the terraform ID (Floating IP ID in this case)
-	// is available, so we need to get the ID from the volume
-	// instead of from the configuration
-	serverID := util.CastInt64(d.Get("server_id"))
-	if serverID == 0 {
-		serverID = floatingIP.Server.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
+}
+
+func (r *AssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data assignmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in, _, err := r.client.FloatingIP.GetByID(ctx, data.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	server, _, err := client.Server.GetByID(ctx, serverID)
+	if in == nil || in.Server == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	server, _, err := r.client.Server.GetByID(ctx, in.Server.ID)
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
 	if server == nil {
-		log.Printf("[WARN] Server ID (%v) not found, removing Floating IP Association from state", d.Get("server_id"))
-		d.SetId("")
-		return nil
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	d.Set("server_id", floatingIP.Server.ID)
-	d.Set("floating_ip_id", floatingIP.ID)
-	return nil
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func resourceFloatingIPAssignmentUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+func (r *AssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, plan assignmentResourceModel
 
-	floatingIPID, err := util.ParseID(d.Id())
-	if err != nil {
-		log.Printf("[WARN] Invalid id (%s), removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	floatingIP, _, err := client.FloatingIP.GetByID(ctx, floatingIPID)
-	if err != nil {
-		log.Printf("[WARN] Invalid id (%s), removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
-	}
-	if floatingIP == nil {
-		log.Printf("[WARN] Floating IP ID (%v) not found, removing Floating IP Association from state", d.Get("floating_ip_id"))
-		d.SetId("")
-		return nil
-	}
-
-	d.Partial(true)
-
-	if d.HasChange("server_id") {
-		serverID := util.CastInt64(d.Get("server_id"))
-		if serverID == 0 {
-			action, _, err := client.FloatingIP.Unassign(ctx, floatingIP)
-			if err != nil {
-				if resourceFloatingIPIsNotFound(err, d) {
-					return nil
-				}
-				return hcloudutil.ErrorToDiag(err)
-			}
-			if err = client.Action.WaitFor(ctx, action); err != nil {
-				return hcloudutil.ErrorToDiag(err)
-			}
-		} else {
-			action, _, err := client.FloatingIP.Assign(ctx, floatingIP, &hcloud.Server{ID: serverID})
-			if err != nil {
-				if resourceFloatingIPIsNotFound(err, d) {
-					return nil
-				}
-				return hcloudutil.ErrorToDiag(err)
-			}
-			if err = client.Action.WaitFor(ctx, action); err != nil {
-				return hcloudutil.ErrorToDiag(err)
-			}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "update", updateTimeout)
+	defer cancel()
+
+	floatingIP := &hcloud.FloatingIP{ID: data.ID.ValueInt64()}
+
+	// Action: Server
+	if !plan.ServerID.IsUnknown() && !plan.ServerID.Equal(data.ServerID) {
+		action, _, err := r.client.FloatingIP.Assign(ctx, floatingIP, &hcloud.Server{ID: plan.ServerID.ValueInt64()})
+		if err != nil {
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
+		}
+
+		resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, action)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	d.Partial(false)
+	// Fetch fresh data from the API
+	in, _, err := r.client.FloatingIP.GetByID(ctx, floatingIP.ID)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+	if in == nil {
+		resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("floating ip", "id", floatingIP.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Timeouts = plan.Timeouts
 
-	return resourceFloatingIPAssignmentRead(ctx, d, m)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func resourceFloatingIPAssignmentDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+func (r *AssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data assignmentResourceModel
 
-	floatingIPID, err := util.ParseID(d.Id())
-	if err != nil {
-		log.Printf("[WARN] Invalid id (%s), removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	floatingIP, _, err := client.FloatingIP.GetByID(ctx, floatingIPID)
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
+
+	floatingIP, _, err := r.client.FloatingIP.GetByID(ctx, data.ID.ValueInt64())
 	if err != nil {
-		log.Printf("[WARN] Invalid id (%s), removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	if floatingIP == nil {
-		log.Printf("[WARN] Floating IP ID (%v) not found, removing Floating IP Association from state", d.Get("floating_ip_id"))
-		d.SetId("")
-		return nil
+	if floatingIP == nil || floatingIP.Server == nil {
+		return
 	}
-	if floatingIP.Server != nil {
-		action, _, err := client.FloatingIP.Unassign(ctx, floatingIP)
-		if err != nil {
-			return hcloudutil.ErrorToDiag(err)
-		}
 
-		if err = client.Action.WaitFor(ctx, action); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+	action, _, err := r.client.FloatingIP.Unassign(ctx, floatingIP)
+	if err != nil {
+		if hcloudutil.APIErrorIsNotFound(err) {
+			return
 		}
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	return nil
+
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, action)...)
+}
+
+func (r *AssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity resourceutil.IDIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("floating_ip_id"), identity.ID)...)
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.Append(util.InvalidImportID("$FLOATING_IP_ID", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("floating_ip_id"), id)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(types.Int64Value(id)))...)
 }
@@ -5,7 +5,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/floatingip"
@@ -107,3 +110,119 @@ func TestAccFloatingIPAssignmentResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccFloatingIPAssignmentResource_Identity(t *testing.T) {
+	var s hcloud.Server
+	var f hcloud.FloatingIP
+	tmplMan := testtemplate.Manager{}
+
+	resSSHKey := sshkey.NewRData(t, "server-floating-ip-identity")
+	resServer := &server.RData{
+		Name:  "fip-assignment-identity",
+		Type:  teste2e.TestServerType,
+		Image: teste2e.TestImage,
+		Labels: map[string]string{
+			"tf-test": fmt.Sprintf("tf-test-fip-assignment-%d", tmplMan.RandInt),
+		},
+		SSHKeys: []string{resSSHKey.TFID() + ".id"},
+	}
+	resServer.SetRName("server_assignment")
+
+	resFloatingIP := &floatingip.RData{
+		Name:             "fip-assignment-identity",
+		Type:             "ipv4",
+		HomeLocationName: teste2e.TestLocationName,
+	}
+	resFloatingIP.SetRName("floating_ip_assignment")
+
+	res := &floatingip.RDataAssignment{
+		FloatingIPID: resFloatingIP.TFID() + ".id",
+		ServerID:     resServer.TFID() + ".id",
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		CheckDestroy:             testsupport.CheckResourcesDestroyed(server.ResourceType, server.ByID(t, &s)),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_ssh_key", resSSHKey,
+					"testdata/r/hcloud_server", resServer,
+					"testdata/r/hcloud_floating_ip", resFloatingIP,
+					"testdata/r/hcloud_floating_ip_assignment", res,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testsupport.CheckResourceExists(resServer.TFID(), server.ByID(t, &s)),
+					testsupport.CheckResourceExists(resFloatingIP.TFID(), floatingip.ByID(t, &f)),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState(res.TFID(), tfjsonpath.New("id")),
+				},
+			},
+			{
+				// Import the Resource using its identity.
+				ResourceName:    res.TFID(),
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccFloatingIPAssignmentResource_UpgradePluginFramework(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	resSSHKey := sshkey.NewRData(t, "server-floating-ip-upgrade")
+	resServer := &server.RData{
+		Name:    "fip-assignment-upgrade",
+		Type:    teste2e.TestServerType,
+		Image:   teste2e.TestImage,
+		SSHKeys: []string{resSSHKey.TFID() + ".id"},
+	}
+	resServer.SetRName("server_assignment")
+
+	resFloatingIP := &floatingip.RData{
+		Name:             "fip-assignment-upgrade",
+		Type:             "ipv4",
+		HomeLocationName: teste2e.TestLocationName,
+	}
+	resFloatingIP.SetRName("floating_ip_assignment")
+
+	res := &floatingip.RDataAssignment{
+		FloatingIPID: resFloatingIP.TFID() + ".id",
+		ServerID:     resServer.TFID() + ".id",
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: teste2e.PreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"hcloud": {
+						VersionConstraint: "1.66.1",
+						Source:            "hetznercloud/hcloud",
+					},
+				},
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_ssh_key", resSSHKey,
+					"testdata/r/hcloud_server", resServer,
+					"testdata/r/hcloud_floating_ip", resFloatingIP,
+					"testdata/r/hcloud_floating_ip_assignment", res,
+				),
+			},
+			{
+				ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_ssh_key", resSSHKey,
+					"testdata/r/hcloud_server", resServer,
+					"testdata/r/hcloud_floating_ip", resFloatingIP,
+					"testdata/r/hcloud_floating_ip_assignment", res,
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
@@ -167,3 +167,41 @@ func TestAccFloatingIPResource_Protection(t *testing.T) {
 		},
 	})
 }
+
+func TestAccFloatingIPResource_UpgradePluginFramework(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	res := &floatingip.RData{
+		Name:             "floatingip-upgrade",
+		Type:             "ipv6",
+		HomeLocationName: teste2e.TestLocationName,
+		Labels: map[string]string{
+			"key": "value",
+		},
+		DeleteProtection: false,
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: teste2e.PreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"hcloud": {
+						VersionConstraint: "1.66.1",
+						Source:            "hetznercloud/hcloud",
+					},
+				},
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_floating_ip", res,
+				),
+			},
+			{
+				ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_floating_ip", res,
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
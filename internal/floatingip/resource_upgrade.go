@@ -0,0 +1,155 @@
+package floatingip
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
+)
+
+// modelV0 is the state of the floating ip resource, as stored by the SDKv2
+// implementation of the resource.
+type modelV0 struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	Description      types.String `tfsdk:"description"`
+	HomeLocation     types.String `tfsdk:"home_location"`
+	ServerID         types.Int64  `tfsdk:"server_id"`
+	IPAddress        types.String `tfsdk:"ip_address"`
+	IPNetwork        types.String `tfsdk:"ip_network"`
+	Labels           types.Map    `tfsdk:"labels"`
+	DeleteProtection types.Bool   `tfsdk:"delete_protection"`
+	Project          types.String `tfsdk:"project"`
+}
+
+func (r *Resource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                schema.StringAttribute{Computed: true},
+					"name":              schema.StringAttribute{Optional: true, Computed: true},
+					"type":              schema.StringAttribute{Required: true},
+					"description":       schema.StringAttribute{Optional: true},
+					"home_location":     schema.StringAttribute{Optional: true, Computed: true},
+					"server_id":         schema.Int64Attribute{Optional: true, Computed: true},
+					"ip_address":        schema.StringAttribute{Computed: true},
+					"ip_network":        schema.StringAttribute{Computed: true},
+					"labels":            schema.MapAttribute{ElementType: types.StringType, Optional: true},
+					"delete_protection": schema.BoolAttribute{Optional: true},
+					"project":           schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: upgradeStateV0,
+		},
+	}
+}
+
+func upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior modelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := util.ParseID(prior.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Floating IP ID", err.Error())
+		return
+	}
+
+	data := resourceModel{
+		model: model{
+			ID:               types.Int64Value(id),
+			Name:             prior.Name,
+			Type:             prior.Type,
+			Description:      prior.Description,
+			HomeLocation:     prior.HomeLocation,
+			ServerID:         prior.ServerID,
+			IPAddress:        prior.IPAddress,
+			IPNetwork:        prior.IPNetwork,
+			Labels:           prior.Labels,
+			DeleteProtection: prior.DeleteProtection,
+		},
+		Project:  prior.Project,
+		Timeouts: resourceutil.TimeoutsNull(),
+	}
+
+	// The SDKv2 stored the zero values for unset attributes.
+	if data.Description.IsNull() {
+		data.Description = types.StringValue("")
+	}
+	if data.ServerID.IsNull() {
+		data.ServerID = types.Int64Value(0)
+	}
+	if data.IPNetwork.ValueString() == "" {
+		data.IPNetwork = types.StringNull()
+	}
+	if data.Labels.IsNull() {
+		data.Labels = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+	if data.DeleteProtection.IsNull() {
+		data.DeleteProtection = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// assignmentModelV0 is the state of the floating ip assignment resource, as
+// stored by the SDKv2 implementation of the resource.
+type assignmentModelV0 struct {
+	ID           types.String `tfsdk:"id"`
+	FloatingIPID types.Int64  `tfsdk:"floating_ip_id"`
+	ServerID     types.Int64  `tfsdk:"server_id"`
+	Project      types.String `tfsdk:"project"`
+}
+
+func (r *AssignmentResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":             schema.StringAttribute{Computed: true},
+					"floating_ip_id": schema.Int64Attribute{Required: true},
+					"server_id":      schema.Int64Attribute{Required: true},
+					"project":        schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: upgradeAssignmentStateV0,
+		},
+	}
+}
+
+func upgradeAssignmentStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior assignmentModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := util.ParseID(prior.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Floating IP Assignment ID", err.Error())
+		return
+	}
+
+	data := assignmentResourceModel{
+		assignmentModel: assignmentModel{
+			ID:           types.Int64Value(id),
+			FloatingIPID: prior.FloatingIPID,
+			ServerID:     prior.ServerID,
+		},
+		Project:  prior.Project,
+		Timeouts: resourceutil.TimeoutsNull(),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
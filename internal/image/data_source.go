@@ -115,6 +115,7 @@ See the [Image API documentation](https://docs.hetzner.cloud/reference/cloud#ima
 			MarkdownDescription: "Filter results using a [Label Selector](https://docs.hetzner.cloud/reference/hetzner#label-selector).",
 			Optional:            true,
 		},
+		"project": datasourceutil.ProjectAttribute(),
 		"with_status": schema.SetAttribute{
 			MarkdownDescription: "Filter results by statuses, for example `creating` or `available`.",
 			Optional:            true,
@@ -140,6 +141,7 @@ type dataSourceModel struct {
 
 	Selector          types.String `tfsdk:"selector"`
 	WithSelector      types.String `tfsdk:"with_selector"`
+	Project           types.String `tfsdk:"project"`
 	WithStatus        types.Set    `tfsdk:"with_status"`
 	WithArchitecture  types.String `tfsdk:"with_architecture"`
 	MostRecent        types.Bool   `tfsdk:"most_recent"`
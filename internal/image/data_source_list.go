@@ -67,6 +67,7 @@ See the [Image API documentation](https://docs.hetzner.cloud/reference/cloud#ima
 			MarkdownDescription: "Filter results using a [Label Selector](https://docs.hetzner.cloud/reference/hetzner#label-selector).",
 			Optional:            true,
 		},
+		"project": datasourceutil.ProjectAttribute(),
 		"with_status": schema.SetAttribute{
 			MarkdownDescription: "Filter results by statuses, for example `creating` or `available`.",
 			Optional:            true,
@@ -93,6 +94,7 @@ type dataSourceListModel struct {
 	Images types.List   `tfsdk:"images"`
 
 	WithSelector      types.String `tfsdk:"with_selector"`
+	Project           types.String `tfsdk:"project"`
 	WithStatus        types.Set    `tfsdk:"with_status"`
 	WithArchitecture  types.Set    `tfsdk:"with_architecture"`
 	MostRecent        types.Bool   `tfsdk:"most_recent"`
@@ -0,0 +1,494 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
+)
+
+// ResourceType is the type name of the Hetzner Cloud Image resource.
+const ResourceType = "hcloud_image"
+
+var _ resource.Resource = (*Resource)(nil)
+var _ resource.ResourceWithConfigure = (*Resource)(nil)
+var _ resource.ResourceWithIdentity = (*Resource)(nil)
+var _ resource.ResourceWithConfigValidators = (*Resource)(nil)
+var _ resource.ResourceWithModifyPlan = (*Resource)(nil)
+
+type Resource struct {
+	client *hcloud.Client
+}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+// Metadata should return the full name of the resource.
+func (r *Resource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = ResourceType
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined Resource type. It is separately executed for each
+// ReadResource RPC.
+func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	r.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Schema should return the schema for this resource.
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = util.MarkdownDescription(`
+Uploads a custom disk image to Hetzner Cloud, and provides it as a snapshot Image.
+
+The upload creates a temporary Server and SSH Key, boots the Server into the rescue system and writes the
+image to its disk, either by downloading it from a ''url'' or by streaming a local ''file''. A snapshot of the
+disk is then created, and the temporary resources are deleted. The temporary resources are labeled with
+''hcloud-image-upload=true'', in case they must be deleted manually.
+
+The temporary Server is billed for the duration of the upload.
+
+The temporary Server is created from the ''ubuntu-24.04'' Image, the API therefore reports ''ubuntu'' as ''os_flavor''
+of the uploaded Image, regardless of its actual operating system. Use the ''description'' and ''labels'' to record the
+operating system of the Image.
+`)
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Image.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"url": schema.StringAttribute{
+			MarkdownDescription: "URL of the image to download from the rescue system. Exactly one of `url` or `file` must be set.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"file": schema.StringAttribute{
+			MarkdownDescription: "Path of a local image file to upload to the rescue system. Exactly one of `url` or `file` must be set. Changes to the content of the file are not detected, use `replace_triggered_by` with a hash of the file to upload it again.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"format": schema.StringAttribute{
+			MarkdownDescription: "Format of the image, `raw` or `qcow2`. A `qcow2` image is converted on the rescue system, and must fit into its memory.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(FormatRaw),
+			Validators: []validator.String{
+				stringvalidator.OneOf(FormatRaw, FormatQCOW2),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"compression": schema.StringAttribute{
+			MarkdownDescription: "Compression of the image, `none`, `bz2`, `xz` or `zstd`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(CompressionNone),
+			Validators: []validator.String{
+				stringvalidator.OneOf(CompressionNone, CompressionBZ2, CompressionXZ, CompressionZSTD),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"architecture": schema.StringAttribute{
+			MarkdownDescription: "CPU architecture of the image, `x86` or `arm`. Must match the architecture of the `server_type`.",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString(string(hcloud.ArchitectureX86)),
+			Validators: []validator.String{
+				stringvalidator.OneOf(string(hcloud.ArchitectureX86), string(hcloud.ArchitectureARM)),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"server_type": schema.StringAttribute{
+			MarkdownDescription: "Name of the Server Type used for the temporary Server. Its disk must be large enough for the image. Defaults to `cpx12` for `x86` and `cax11` for `arm`.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"location": schema.StringAttribute{
+			MarkdownDescription: "Name of the Location used for the temporary Server.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "Description of the Image.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"labels": resourceutil.LabelsSchema(),
+		"os_flavor": schema.StringAttribute{
+			MarkdownDescription: "Flavor of the operating system of the Image, as reported by the API. Always `ubuntu`, the flavor of the temporary Server the Image is created from.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"created": schema.StringAttribute{
+			MarkdownDescription: "Point in time when the Image was created (in RFC3339 format).",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
+	}
+}
+
+type resourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	URL          types.String `tfsdk:"url"`
+	File         types.String `tfsdk:"file"`
+	Format       types.String `tfsdk:"format"`
+	Compression  types.String `tfsdk:"compression"`
+	Architecture types.String `tfsdk:"architecture"`
+	ServerType   types.String `tfsdk:"server_type"`
+	Location     types.String `tfsdk:"location"`
+	Description  types.String `tfsdk:"description"`
+	Labels       types.Map    `tfsdk:"labels"`
+	OSFlavor     types.String `tfsdk:"os_flavor"`
+	Created      types.String `tfsdk:"created"`
+
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+var _ util.ModelFromAPI[*hcloud.Image] = &resourceModel{}
+var _ util.ModelToTerraform[types.Object] = &resourceModel{}
+
+func (m *resourceModel) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":           types.Int64Type,
+		"url":          types.StringType,
+		"file":         types.StringType,
+		"format":       types.StringType,
+		"compression":  types.StringType,
+		"architecture": types.StringType,
+		"server_type":  types.StringType,
+		"location":     types.StringType,
+		"description":  types.StringType,
+		"labels":       types.MapType{ElemType: types.StringType},
+		"os_flavor":    types.StringType,
+		"created":      types.StringType,
+		"project":      types.StringType,
+		"timeouts":     resourceutil.TimeoutsType(),
+	}
+}
+
+// FromAPI only sets the attributes returned by the API, the upload options are
+// kept from the configuration.
+func (m *resourceModel) FromAPI(ctx context.Context, hc *hcloud.Image) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	m.ID = types.Int64Value(hc.ID)
+	m.Architecture = types.StringValue(string(hc.Architecture))
+	m.Description = types.StringValue(hc.Description)
+	m.Labels, newDiags = resourceutil.LabelsMapValueFrom(ctx, hc.Labels)
+	diags.Append(newDiags...)
+	m.OSFlavor = types.StringValue(hc.OSFlavor)
+	m.Created = types.StringValue(hc.Created.Format(time.RFC3339))
+
+	return diags
+}
+
+func (m *resourceModel) ToTerraform(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
+}
+
+func (r *Resource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("url"),
+			path.MatchRoot("file"),
+		),
+	}
+}
+
+// ModifyPlan validates that the Server Type of the temporary Server matches the
+// architecture of the image, as the snapshot always has the architecture of the
+// Server Type.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Do not modify on resource destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan resourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ServerType.IsNull() || plan.ServerType.IsUnknown() || plan.Architecture.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state resourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// The image is not uploaded again.
+		if plan.ServerType.Equal(state.ServerType) && plan.Architecture.Equal(state.Architecture) {
+			return
+		}
+	}
+
+	if r.client == nil {
+		return
+	}
+
+	serverType, _, err := r.client.ServerType.Get(ctx, plan.ServerType.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+	if serverType == nil {
+		resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("server type", "name", plan.ServerType.ValueString()))
+		return
+	}
+
+	if string(serverType.Architecture) != plan.Architecture.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("server_type"),
+			"Server Type does not match the architecture",
+			fmt.Sprintf("The Server Type %q has the architecture %q, but the architecture of the image is %q.",
+				serverType.Name, serverType.Architecture, plan.Architecture.ValueString()),
+		)
+	}
+}
+
+func (r *Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceutil.IDIdentitySchema("ID of the Image.")
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 60*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
+
+	opts := uploadOpts{
+		URL:          data.URL.ValueString(),
+		Format:       data.Format.ValueString(),
+		Compression:  data.Compression.ValueString(),
+		Architecture: hcloud.Architecture(data.Architecture.ValueString()),
+		ServerType:   data.ServerType.ValueString(),
+		Location:     data.Location.ValueString(),
+	}
+
+	if !data.Description.IsUnknown() && !data.Description.IsNull() {
+		opts.Description = data.Description.ValueStringPointer()
+	}
+
+	resp.Diagnostics.Append(hcloudutil.TerraformLabelsToHCloud(ctx, data.Labels, &opts.Labels)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.File.IsNull() {
+		file, err := os.Open(data.File.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("file"), "Could not open image file", err.Error())
+			return
+		}
+		defer file.Close()
+
+		opts.Reader = file
+	}
+
+	in, newDiags := upload(ctx, r.client, opts, func(id int64) {
+		// Make sure to save the ID immediately so we can recover if the process stops after
+		// this call. Terraform marks the resource as "tainted", so it can be deleted and no
+		// surprise "duplicate resource" errors happen.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(id))...)
+	})
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch fresh data from the API
+	in, _, err := r.client.Image.GetByID(ctx, in.ID)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in, _, err := r.client.Image.GetByID(ctx, data.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	if in == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, plan resourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "update", updateTimeout)
+	defer cancel()
+
+	image := &hcloud.Image{ID: data.ID.ValueInt64()}
+
+	opts := hcloud.ImageUpdateOpts{}
+
+	if !plan.Description.IsUnknown() && !plan.Description.Equal(data.Description) {
+		opts.Description = plan.Description.ValueStringPointer()
+	}
+
+	if !plan.Labels.IsUnknown() && !plan.Labels.Equal(data.Labels) {
+		resp.Diagnostics.Append(hcloudutil.TerraformLabelsToHCloud(ctx, plan.Labels, &opts.Labels)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Always perform the update call last, even when empty, to populate the state with fresh data returned by
+	// the update.
+	in, _, err := r.client.Image.Update(ctx, image, opts)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
+
+	_, err := r.client.Image.Delete(ctx, &hcloud.Image{ID: data.ID.ValueInt64()})
+	if err != nil {
+		if hcloudutil.APIErrorIsNotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+}
@@ -0,0 +1,101 @@
+package image_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/image"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/teste2e"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testfake"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testmux"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testsupport"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testtemplate"
+)
+
+func TestAccImageResource(t *testing.T) {
+	if testfake.Enabled() {
+		t.Skip("The fake API does not provide a rescue system to upload the image to.")
+	}
+
+	tmplMan := testtemplate.Manager{}
+
+	res := &image.RData{
+		URL:          "https://github.com/siderolabs/talos/releases/download/v1.10.5/hcloud-amd64.raw.xz",
+		Compression:  image.CompressionXZ,
+		Architecture: hcloud.ArchitectureX86,
+		Description:  "talos-v1.10.5",
+		Labels: map[string]string{
+			"key": "value",
+		},
+	}
+	res.SetRName("default")
+
+	resUpdated := testtemplate.DeepCopy(t, res)
+	resUpdated.Description = "talos-v1.10.5-updated"
+	resUpdated.Labels = map[string]string{
+		"key": "updated",
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		CheckDestroy:             testsupport.CheckAPIResourceAllAbsent(image.ResourceType, image.GetAPIResource()),
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t, "testdata/r/hcloud_image", res),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testsupport.CheckAPIResourcePresent(res.TFID(), image.GetAPIResource()),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(res.TFID(), tfjsonpath.New("architecture"), knownvalue.StringExact("x86")),
+					statecheck.ExpectKnownValue(res.TFID(), tfjsonpath.New("description"), knownvalue.StringExact(res.Description)),
+					statecheck.ExpectKnownValue(res.TFID(), tfjsonpath.New("format"), knownvalue.StringExact(image.FormatRaw)),
+					statecheck.ExpectKnownValue(res.TFID(), tfjsonpath.New("labels"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"key": knownvalue.StringExact("value"),
+					})),
+					statecheck.ExpectKnownValue(res.TFID(), tfjsonpath.New("os_flavor"), knownvalue.StringExact("ubuntu")),
+					statecheck.ExpectKnownValue(res.TFID(), tfjsonpath.New("created"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: tmplMan.Render(t, "testdata/r/hcloud_image", resUpdated),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(res.TFID(), tfjsonpath.New("description"), knownvalue.StringExact(resUpdated.Description)),
+					statecheck.ExpectKnownValue(res.TFID(), tfjsonpath.New("labels"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"key": knownvalue.StringExact("updated"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccImageResource_ServerTypeArchitecture(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	res := &image.RData{
+		URL:          "https://github.com/siderolabs/talos/releases/download/v1.10.5/hcloud-amd64.raw.xz",
+		Compression:  image.CompressionXZ,
+		Architecture: hcloud.ArchitectureX86,
+		ServerType:   "cax11",
+	}
+	res.SetRName("default")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      tmplMan.Render(t, "testdata/r/hcloud_image", res),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Server Type does not match the architecture`),
+			},
+		},
+	})
+}
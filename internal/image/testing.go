@@ -1,12 +1,27 @@
 package image
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testsupport"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/testtemplate"
 )
 
+// GetAPIResource returns a [testsupport.GetAPIResourceFunc] for [hcloud.Image].
+func GetAPIResource() testsupport.GetAPIResourceFunc[hcloud.Image] {
+	return func(c *hcloud.Client, attrs map[string]string) (*hcloud.Image, error) {
+		id, err := strconv.ParseInt(attrs["id"], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		result, _, err := c.Image.GetByID(context.Background(), id)
+		return result, err
+	}
+}
+
 // DData defines the fields for the "testdata/d/hcloud_image"
 // template.
 type DData struct {
@@ -44,3 +59,20 @@ type DDataList struct {
 func (d *DDataList) TFID() string {
 	return fmt.Sprintf("data.%s.%s", DataSourceListType, d.RName())
 }
+
+// RData defines the fields for the "testdata/r/hcloud_image" template.
+type RData struct {
+	testtemplate.DataCommon
+
+	URL          string
+	Compression  string
+	Architecture hcloud.Architecture
+	ServerType   string
+	Description  string
+	Labels       map[string]string
+}
+
+// TFID returns the resource identifier.
+func (d *RData) TFID() string {
+	return fmt.Sprintf("%s.%s", ResourceType, d.RName())
+}
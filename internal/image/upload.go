@@ -0,0 +1,321 @@
+package image
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/kit/randutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/kit/sshutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+)
+
+const (
+	FormatRaw   = "raw"
+	FormatQCOW2 = "qcow2"
+
+	CompressionNone = "none"
+	CompressionBZ2  = "bz2"
+	CompressionXZ   = "xz"
+	CompressionZSTD = "zstd"
+)
+
+// uploadLabel is set on the temporary resources created during the upload, to
+// find them in case the cleanup failed.
+const uploadLabel = "hcloud-image-upload"
+
+// uploadCleanupTimeout is the timeout used to delete the temporary resources,
+// even if the upload timed out.
+const uploadCleanupTimeout = 5 * time.Minute
+
+// uploadSSHRetryInterval is the interval between the attempts to connect to the
+// rescue system, while it is booting.
+var uploadSSHRetryInterval = 5 * time.Second
+
+// uploadServerImage is the Image of the temporary server. The snapshot is
+// created from this server, the API therefore reports the OS flavor of this
+// Image for the uploaded image.
+const uploadServerImage = "ubuntu-24.04"
+
+// defaultUploadServerTypes are the Server Types used for the temporary server,
+// by architecture.
+var defaultUploadServerTypes = map[hcloud.Architecture]string{
+	hcloud.ArchitectureX86: "cpx12",
+	hcloud.ArchitectureARM: "cax11",
+}
+
+type uploadOpts struct {
+	// URL of the image to download from the rescue system.
+	URL string
+	// Reader of the image to stream to the rescue system, used when URL is
+	// empty.
+	Reader io.Reader
+
+	Format       string
+	Compression  string
+	Architecture hcloud.Architecture
+	ServerType   string
+	Location     string
+
+	Description *string
+	Labels      map[string]string
+}
+
+// uploadCommand returns the shell command writing the image to the disk of the
+// rescue system.
+func uploadCommand(opts uploadOpts) string {
+	var source string
+	if opts.URL != "" {
+		source = fmt.Sprintf("wget --no-verbose -O - %s", shellQuote(opts.URL))
+	} else {
+		source = "cat"
+	}
+
+	var decompress string
+	switch opts.Compression {
+	case CompressionBZ2:
+		decompress = " | bzip2 -cd"
+	case CompressionXZ:
+		decompress = " | xz -cd"
+	case CompressionZSTD:
+		decompress = " | zstd -cd"
+	}
+
+	var write string
+	switch opts.Format {
+	case FormatQCOW2:
+		// qemu-img needs random access to the qcow2 image, it must be stored on
+		// the in-memory file system of the rescue system first.
+		write = " > /tmp/image.qcow2 && qemu-img convert -f qcow2 -O raw /tmp/image.qcow2 /dev/sda"
+	default:
+		write = " | dd of=/dev/sda bs=4M"
+	}
+
+	return fmt.Sprintf("bash -c %s", shellQuote("set -euo pipefail; "+source+decompress+write+" && sync"))
+}
+
+// shellQuote quotes the value to be used as a single shell word.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'"'"'`) + "'"
+}
+
+// upload writes the image to the disk of a temporary server booted into the
+// rescue system, and creates a snapshot of it. The temporary server and SSH key
+// are always deleted, even if the upload failed.
+//
+// The ID of the snapshot is passed to onCreated as soon as it is known.
+func upload(ctx context.Context, client *hcloud.Client, opts uploadOpts, onCreated func(id int64)) (image *hcloud.Image, diags diag.Diagnostics) {
+	name := fmt.Sprintf("image-upload-%s", randutil.GenerateID())
+	labels := map[string]string{uploadLabel: "true"}
+
+	privateKey, publicKey, err := sshutil.GenerateKeyPair()
+	if err != nil {
+		diags.AddError("Could not generate SSH key", err.Error())
+		return nil, diags
+	}
+	signer, err := ssh.ParsePrivateKey(privateKey)
+	if err != nil {
+		diags.AddError("Could not generate SSH key", err.Error())
+		return nil, diags
+	}
+
+	sshKey, _, err := client.SSHKey.Create(ctx, hcloud.SSHKeyCreateOpts{
+		Name:      name,
+		PublicKey: string(publicKey),
+		Labels:    labels,
+	})
+	if err != nil {
+		diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return nil, diags
+	}
+	defer func() {
+		cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), uploadCleanupTimeout)
+		defer cancel()
+
+		if _, err := client.SSHKey.Delete(cleanupCtx, sshKey); err != nil && !hcloudutil.APIErrorIsNotFound(err) {
+			diags.AddWarning("Could not delete temporary SSH key",
+				fmt.Sprintf("The SSH key %q (%d) used to upload the image must be deleted manually: %s", sshKey.Name, sshKey.ID, err))
+		}
+	}()
+
+	serverType := opts.ServerType
+	if serverType == "" {
+		serverType = defaultUploadServerTypes[opts.Architecture]
+	}
+
+	createOpts := hcloud.ServerCreateOpts{
+		Name:             name,
+		ServerType:       &hcloud.ServerType{Name: serverType},
+		Image:            &hcloud.Image{Name: uploadServerImage},
+		SSHKeys:          []*hcloud.SSHKey{sshKey},
+		Labels:           labels,
+		StartAfterCreate: new(false),
+	}
+	if opts.Location != "" {
+		createOpts.Location = &hcloud.Location{Name: opts.Location}
+	}
+
+	result, _, err := client.Server.Create(ctx, createOpts)
+	if err != nil {
+		diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return nil, diags
+	}
+	server := result.Server
+	defer func() {
+		cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), uploadCleanupTimeout)
+		defer cancel()
+
+		deleteResult, _, err := client.Server.DeleteWithResult(cleanupCtx, server)
+		if err == nil {
+			err = client.Action.WaitFor(cleanupCtx, deleteResult.Action)
+		}
+		if err != nil && !hcloudutil.APIErrorIsNotFound(err) {
+			diags.AddWarning("Could not delete temporary server",
+				fmt.Sprintf("The server %q (%d) used to upload the image must be deleted manually: %s", server.Name, server.ID, err))
+		}
+	}()
+
+	diags.Append(hcloudutil.SettleActions(ctx, &client.Action, append([]*hcloud.Action{result.Action}, result.NextActions...)...)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tflog.Debug(ctx, "booting temporary server into the rescue system", map[string]any{"server_id": server.ID})
+
+	rescueResult, _, err := client.Server.EnableRescue(ctx, server, hcloud.ServerEnableRescueOpts{
+		Type:    hcloud.ServerRescueTypeLinux64,
+		SSHKeys: []*hcloud.SSHKey{sshKey},
+	})
+	if err != nil {
+		diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return nil, diags
+	}
+	diags.Append(hcloudutil.SettleActions(ctx, &client.Action, rescueResult.Action)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	action, _, err := client.Server.Poweron(ctx, server)
+	if err != nil {
+		diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return nil, diags
+	}
+	diags.Append(hcloudutil.SettleActions(ctx, &client.Action, action)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tflog.Debug(ctx, "writing image to the temporary server disk", map[string]any{"server_id": server.ID})
+
+	addr := net.JoinHostPort(server.PublicNet.IPv4.IP.String(), "22")
+	if err := runSSHCommand(ctx, addr, signer, uploadCommand(opts), opts.Reader); err != nil {
+		diags.AddError("Could not write image", err.Error())
+		return nil, diags
+	}
+
+	action, _, err = client.Server.Poweroff(ctx, server)
+	if err != nil {
+		diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return nil, diags
+	}
+	diags.Append(hcloudutil.SettleActions(ctx, &client.Action, action)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tflog.Debug(ctx, "creating snapshot of the temporary server", map[string]any{"server_id": server.ID})
+
+	imageResult, _, err := client.Server.CreateImage(ctx, server, &hcloud.ServerCreateImageOpts{
+		Type:        hcloud.ImageTypeSnapshot,
+		Description: opts.Description,
+		Labels:      opts.Labels,
+	})
+	if err != nil {
+		diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return nil, diags
+	}
+	onCreated(imageResult.Image.ID)
+
+	diags.Append(hcloudutil.SettleActions(ctx, &client.Action, imageResult.Action)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return imageResult.Image, diags
+}
+
+// runSSHCommand runs the command on the host, and retries to connect until the
+// host accepts SSH connections. The stdin reader is optional.
+func runSSHCommand(ctx context.Context, addr string, signer ssh.Signer, command string, stdin io.Reader) error {
+	config := &ssh.ClientConfig{
+		User: "root",
+		Auth: []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		// The host is a temporary server created for the upload, its host key
+		// is not known in advance.
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(), // nolint: gosec
+		Timeout:         30 * time.Second,
+	}
+
+	var client *ssh.Client
+	for {
+		var err error
+		client, err = dialSSH(ctx, addr, config)
+		if err == nil {
+			break
+		}
+
+		tflog.Debug(ctx, "waiting for the rescue system to accept SSH connections", map[string]any{"error": err.Error()})
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("could not connect to the rescue system: %w", errors.Join(ctx.Err(), err))
+		case <-time.After(uploadSSHRetryInterval):
+		}
+	}
+	defer client.Close()
+
+	// Abort the command when the context is canceled.
+	stop := context.AfterFunc(ctx, func() { _ = client.Close() })
+	defer stop()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	var stderr bytes.Buffer
+	session.Stdin = stdin
+	session.Stderr = &stderr
+
+	if err := session.Run(command); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+func dialSSH(ctx context.Context, addr string, config *ssh.ClientConfig) (*ssh.Client, error) {
+	dialer := net.Dialer{Timeout: config.Timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	c, chans, reqs, err := ssh.NewClientConn(conn, addr, config)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return ssh.NewClient(c, chans, reqs), nil
+}
@@ -0,0 +1,47 @@
+package image
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadCommand(t *testing.T) {
+	testCases := []struct {
+		name string
+		opts uploadOpts
+		want string
+	}{
+		{
+			name: "url raw",
+			opts: uploadOpts{URL: "https://example.com/image.raw", Format: FormatRaw, Compression: CompressionNone},
+			want: `bash -c 'set -euo pipefail; wget --no-verbose -O - '"'"'https://example.com/image.raw'"'"' | dd of=/dev/sda bs=4M && sync'`,
+		},
+		{
+			name: "url xz",
+			opts: uploadOpts{URL: "https://example.com/image.raw.xz", Format: FormatRaw, Compression: CompressionXZ},
+			want: `bash -c 'set -euo pipefail; wget --no-verbose -O - '"'"'https://example.com/image.raw.xz'"'"' | xz -cd | dd of=/dev/sda bs=4M && sync'`,
+		},
+		{
+			name: "file bz2",
+			opts: uploadOpts{Format: FormatRaw, Compression: CompressionBZ2},
+			want: `bash -c 'set -euo pipefail; cat | bzip2 -cd | dd of=/dev/sda bs=4M && sync'`,
+		},
+		{
+			name: "file qcow2 zstd",
+			opts: uploadOpts{Format: FormatQCOW2, Compression: CompressionZSTD},
+			want: `bash -c 'set -euo pipefail; cat | zstd -cd > /tmp/image.qcow2 && qemu-img convert -f qcow2 -O raw /tmp/image.qcow2 /dev/sda && sync'`,
+		},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, uploadCommand(tt.opts))
+		})
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	assert.Equal(t, `'foo'`, shellQuote("foo"))
+	assert.Equal(t, `'it'"'"'s'`, shellQuote("it's"))
+	assert.Equal(t, `'$(rm -rf /); echo'`, shellQuote("$(rm -rf /); echo"))
+}
@@ -0,0 +1,211 @@
+package iso
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/deprecation"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/datasourceutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/merge"
+)
+
+func getCommonDataSourceSchema(readOnly bool) map[string]schema.Attribute {
+	return merge.Maps(
+		map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the ISO.",
+				Optional:            !readOnly,
+				Computed:            readOnly,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the ISO.",
+				Optional:            !readOnly,
+				Computed:            readOnly,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the ISO.",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the ISO, `public` or `private`.",
+				Computed:            true,
+			},
+			"architecture": schema.StringAttribute{
+				MarkdownDescription: "CPU architecture compatible with the ISO. Null if the ISO is compatible with all architectures.",
+				Computed:            true,
+			},
+		},
+		deprecation.DataSourceSchema("ISO"),
+	)
+}
+
+func withTypeSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Filter results by type, `public` or `private`.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(string(hcloud.ISOTypePublic), string(hcloud.ISOTypePrivate)),
+		},
+	}
+}
+
+const DataSourceType = "hcloud_iso"
+
+var _ datasource.DataSource = (*DataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*DataSource)(nil)
+var _ datasource.DataSourceWithConfigValidators = (*DataSource)(nil)
+
+type DataSource struct {
+	client *hcloud.Client
+}
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+func (d *DataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = DataSourceType
+}
+
+func (d *DataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	d.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *DataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = `
+Provides details about a Hetzner Cloud ISO, to attach to a Server using its ''iso'' argument.
+
+It is recommended to always provide the ISO architecture (using ''with_architecture'').
+
+See the [ISO API documentation](https://docs.hetzner.cloud/reference/cloud#isos) for more details.
+`
+
+	resp.Schema.Attributes = merge.Maps(
+		getCommonDataSourceSchema(false),
+		map[string]schema.Attribute{
+			"project": datasourceutil.ProjectAttribute(),
+			"with_architecture": schema.StringAttribute{
+				MarkdownDescription: "Filter results by architecture, for example `x86` or `arm`. ISOs compatible with all architectures are always included.",
+				Optional:            true,
+			},
+			"with_type": withTypeSchema(),
+			"include_deprecated": schema.BoolAttribute{
+				MarkdownDescription: "Include deprecated ISOs.",
+				Optional:            true,
+			},
+		},
+	)
+}
+
+type dataSourceModel struct {
+	model
+
+	Project           types.String `tfsdk:"project"`
+	WithArchitecture  types.String `tfsdk:"with_architecture"`
+	WithType          types.String `tfsdk:"with_type"`
+	IncludeDeprecated types.Bool   `tfsdk:"include_deprecated"`
+}
+
+var _ util.ModelFromAPI[*hcloud.ISO] = &dataSourceModel{}
+
+func (m *dataSourceModel) FromAPI(ctx context.Context, in *hcloud.ISO) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(m.model.FromAPI(ctx, in)...)
+
+	return diags
+}
+
+func (d *DataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var result *hcloud.ISO
+	var err error
+	var newDiag diag.Diagnostic
+
+	switch {
+	case !data.ID.IsNull():
+		result, _, err = d.client.ISO.GetByID(ctx, data.ID.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
+		}
+		if result == nil {
+			resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("iso", "id", data.ID.String()))
+			return
+		}
+	case !data.Name.IsNull():
+		opts := hcloud.ISOListOpts{}
+		opts.Name = data.Name.ValueString()
+		if !data.WithArchitecture.IsNull() {
+			opts.Architecture = []hcloud.Architecture{hcloud.Architecture(data.WithArchitecture.ValueString())}
+			opts.IncludeArchitectureWildcard = true
+		}
+
+		all, err := d.client.ISO.AllWithOpts(ctx, opts)
+		if err != nil {
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
+		}
+
+		all = filterISOs(all, data.WithType.ValueString(), data.IncludeDeprecated.ValueBool())
+
+		result, newDiag = hcloudutil.GetOne(all,
+			hcloudutil.WithResourceName("iso"),
+			hcloudutil.WithUsing("name", opts.Name),
+			hcloudutil.WithListOpts(opts),
+		)
+		if newDiag != nil {
+			resp.Diagnostics.Append(newDiag)
+			return
+		}
+	}
+
+	if result.IsDeprecated() {
+		resp.Diagnostics.AddWarning("ISO deprecated", fmt.Sprintf(
+			"ISO %q is deprecated and will no longer be available after %s.",
+			result.Name,
+			result.UnavailableAfter().Format(time.DateOnly),
+		))
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
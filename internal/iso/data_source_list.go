@@ -0,0 +1,153 @@
+package iso
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/kit/sliceutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/datasourceutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+)
+
+// DataSourceListType is the type name of the Hetzner Cloud ISOs datasource.
+const DataSourceListType = "hcloud_isos"
+
+var _ datasource.DataSource = (*DataSourceList)(nil)
+var _ datasource.DataSourceWithConfigure = (*DataSourceList)(nil)
+
+type DataSourceList struct {
+	client *hcloud.Client
+}
+
+func NewDataSourceList() datasource.DataSource {
+	return &DataSourceList{}
+}
+
+func (d *DataSourceList) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = DataSourceListType
+}
+
+func (d *DataSourceList) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	d.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *DataSourceList) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = `
+Provides a list of Hetzner Cloud ISOs.
+
+It is recommended to always provide the ISO architecture (using ''with_architecture'').
+
+See the [ISO API documentation](https://docs.hetzner.cloud/reference/cloud#isos) for more details.
+`
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"isos": schema.ListNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: getCommonDataSourceSchema(true),
+			},
+			Computed: true,
+		},
+		"project": datasourceutil.ProjectAttribute(),
+		"with_architecture": schema.SetAttribute{
+			MarkdownDescription: "Filter results by architecture, for example `x86` or `arm`. ISOs compatible with all architectures are always included.",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"with_type": withTypeSchema(),
+		"include_deprecated": schema.BoolAttribute{
+			MarkdownDescription: "Include deprecated ISOs.",
+			Optional:            true,
+		},
+	}
+}
+
+type dataSourceListModel struct {
+	ID   types.String `tfsdk:"id"`
+	ISOs types.List   `tfsdk:"isos"`
+
+	Project           types.String `tfsdk:"project"`
+	WithArchitecture  types.Set    `tfsdk:"with_architecture"`
+	WithType          types.String `tfsdk:"with_type"`
+	IncludeDeprecated types.Bool   `tfsdk:"include_deprecated"`
+}
+
+var _ util.ModelFromAPI[[]*hcloud.ISO] = &dataSourceListModel{}
+
+func (m *dataSourceListModel) FromAPI(ctx context.Context, in []*hcloud.ISO) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	tfIDs := make([]string, 0, len(in))
+	tfItems := make([]attr.Value, 0, len(in))
+	for _, item := range in {
+		var value model
+		diags.Append(value.FromAPI(ctx, item)...)
+
+		tfItem, newDiags := value.ToTerraform(ctx)
+		diags.Append(newDiags...)
+
+		tfItems = append(tfItems, tfItem)
+		tfIDs = append(tfIDs, util.FormatID(item.ID))
+	}
+
+	m.ID = types.StringValue(datasourceutil.ListID(tfIDs))
+	m.ISOs, newDiags = types.ListValue((&model{}).tfType(), tfItems)
+	diags.Append(newDiags...)
+
+	return diags
+}
+
+func (d *DataSourceList) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dataSourceListModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := hcloud.ISOListOpts{}
+	if !data.WithArchitecture.IsNull() {
+		values := make([]string, 0, len(data.WithArchitecture.Elements()))
+		resp.Diagnostics.Append(data.WithArchitecture.ElementsAs(ctx, &values, false)...)
+
+		opts.Architecture = sliceutil.Transform(values, func(o string) hcloud.Architecture {
+			return hcloud.Architecture(o)
+		})
+		opts.IncludeArchitectureWildcard = true
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.ISO.AllWithOpts(ctx, opts)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	result = filterISOs(result, data.WithType.ValueString(), data.IncludeDeprecated.ValueBool())
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
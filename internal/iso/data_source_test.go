@@ -0,0 +1,91 @@
+package iso_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/iso"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/teste2e"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testmux"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testtemplate"
+)
+
+func TestAccISODataSource(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	byID := &iso.DData{
+		ID: "8637", // Windows Server 2022 English
+	}
+	byID.SetRName("by_id")
+
+	byName := &iso.DData{
+		Name:             "${data.hcloud_iso.by_id.name}",
+		WithArchitecture: hcloud.ArchitectureX86,
+		WithType:         hcloud.ISOTypePublic,
+	}
+	byName.SetRName("by_name")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t,
+					"testdata/d/hcloud_iso", byID,
+					"testdata/d/hcloud_iso", byName,
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(byID.TFID(), tfjsonpath.New("id"), knownvalue.Int64Exact(8637)),
+					statecheck.ExpectKnownValue(byID.TFID(), tfjsonpath.New("name"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(byID.TFID(), tfjsonpath.New("description"), knownvalue.StringExact("Windows Server 2022 English")),
+					statecheck.ExpectKnownValue(byID.TFID(), tfjsonpath.New("type"), knownvalue.StringExact("public")),
+					statecheck.ExpectKnownValue(byID.TFID(), tfjsonpath.New("architecture"), knownvalue.StringExact("x86")),
+					statecheck.ExpectKnownValue(byID.TFID(), tfjsonpath.New("is_deprecated"), knownvalue.Bool(false)),
+
+					statecheck.ExpectKnownValue(byName.TFID(), tfjsonpath.New("id"), knownvalue.Int64Exact(8637)),
+					statecheck.ExpectKnownValue(byName.TFID(), tfjsonpath.New("type"), knownvalue.StringExact("public")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccISODataSourceList(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	all := &iso.DDataList{}
+	all.SetRName("all")
+
+	byArchitecture := &iso.DDataList{
+		WithArchitecture: hcloud.ArchitectureARM,
+		WithType:         hcloud.ISOTypePublic,
+	}
+	byArchitecture.SetRName("architecture")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t,
+					"testdata/d/hcloud_isos", all,
+					"testdata/d/hcloud_isos", byArchitecture,
+				),
+
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(all.TFID(), tfjsonpath.New("isos"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(all.TFID(), tfjsonpath.New("isos").AtSliceIndex(0).AtMapKey("name"), knownvalue.NotNull()),
+
+					statecheck.ExpectKnownValue(byArchitecture.TFID(), tfjsonpath.New("isos"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(byArchitecture.TFID(), tfjsonpath.New("isos").AtSliceIndex(0).AtMapKey("type"), knownvalue.StringExact("public")),
+					statecheck.ExpectKnownValue(byArchitecture.TFID(), tfjsonpath.New("isos").AtSliceIndex(0).AtMapKey("architecture"), knownvalue.StringExact("arm")),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,87 @@
+package iso
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/deprecation"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/merge"
+)
+
+type model struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	Type         types.String `tfsdk:"type"`
+	Architecture types.String `tfsdk:"architecture"`
+
+	deprecation.DeprecationModel
+}
+
+var _ util.ModelFromAPI[*hcloud.ISO] = &model{}
+var _ util.ModelToTerraform[types.Object] = &model{}
+
+func (m *model) tfAttributesTypes() map[string]attr.Type {
+	return merge.Maps(
+		map[string]attr.Type{
+			"id":           types.Int64Type,
+			"name":         types.StringType,
+			"description":  types.StringType,
+			"type":         types.StringType,
+			"architecture": types.StringType,
+		},
+		deprecation.AttrTypes(),
+	)
+}
+
+func (m *model) tfType() attr.Type {
+	return basetypes.ObjectType{AttrTypes: m.tfAttributesTypes()}
+}
+
+func (m *model) FromAPI(ctx context.Context, hc *hcloud.ISO) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	m.ID = types.Int64Value(hc.ID)
+	m.Name = types.StringValue(hc.Name)
+	m.Description = types.StringValue(hc.Description)
+	m.Type = types.StringValue(string(hc.Type))
+
+	// ISOs without architecture are compatible with all architectures.
+	if hc.Architecture != nil {
+		m.Architecture = types.StringValue(string(*hc.Architecture))
+	} else {
+		m.Architecture = types.StringNull()
+	}
+
+	m.DeprecationModel, newDiags = deprecation.NewDeprecationModel(ctx, hc)
+	diags.Append(newDiags...)
+
+	return diags
+}
+
+func (m *model) ToTerraform(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
+}
+
+// filterISOs returns the ISOs matching the type, and excludes the deprecated
+// ISOs unless requested. The API does not support these filters.
+func filterISOs(in []*hcloud.ISO, isoType string, includeDeprecated bool) []*hcloud.ISO {
+	result := make([]*hcloud.ISO, 0, len(in))
+	for _, item := range in {
+		if isoType != "" && string(item.Type) != isoType {
+			continue
+		}
+		if !includeDeprecated && item.IsDeprecated() {
+			continue
+		}
+		result = append(result, item)
+	}
+	return result
+}
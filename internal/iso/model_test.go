@@ -0,0 +1,70 @@
+package iso
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func TestModel(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("public", func(t *testing.T) {
+		in := &hcloud.ISO{
+			ID:           8637,
+			Name:         "Windows-Server-2022-English.iso",
+			Description:  "Windows Server 2022 English",
+			Type:         hcloud.ISOTypePublic,
+			Architecture: hcloud.Ptr(hcloud.ArchitectureX86),
+		}
+		o := &model{}
+		assert.Nil(t, o.FromAPI(ctx, in))
+		assert.Equal(t, int64(8637), o.ID.ValueInt64())
+		assert.Equal(t, "Windows-Server-2022-English.iso", o.Name.ValueString())
+		assert.Equal(t, "Windows Server 2022 English", o.Description.ValueString())
+		assert.Equal(t, "public", o.Type.ValueString())
+		assert.Equal(t, "x86", o.Architecture.ValueString())
+		assert.False(t, o.IsDeprecated.ValueBool())
+		assert.True(t, o.DeprecationAnnounced.IsNull())
+		assert.True(t, o.UnavailableAfter.IsNull())
+	})
+
+	t.Run("private deprecated", func(t *testing.T) {
+		in := &hcloud.ISO{
+			ID:   1234,
+			Name: "rescue.iso",
+			Type: hcloud.ISOTypePrivate,
+			DeprecatableResource: hcloud.DeprecatableResource{
+				Deprecation: &hcloud.DeprecationInfo{
+					Announced:        time.Date(2026, 5, 1, 17, 0, 0, 0, time.UTC),
+					UnavailableAfter: time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC),
+				},
+			},
+		}
+		o := &model{}
+		assert.Nil(t, o.FromAPI(ctx, in))
+		assert.Equal(t, "private", o.Type.ValueString())
+		assert.True(t, o.Architecture.IsNull())
+		assert.True(t, o.IsDeprecated.ValueBool())
+		assert.Equal(t, "2026-05-01T17:00:00Z", o.DeprecationAnnounced.ValueString())
+		assert.Equal(t, "2026-08-01T00:00:00Z", o.UnavailableAfter.ValueString())
+	})
+}
+
+func TestFilterISOs(t *testing.T) {
+	public := &hcloud.ISO{ID: 1, Type: hcloud.ISOTypePublic}
+	private := &hcloud.ISO{ID: 2, Type: hcloud.ISOTypePrivate}
+	deprecated := &hcloud.ISO{ID: 3, Type: hcloud.ISOTypePublic, DeprecatableResource: hcloud.DeprecatableResource{
+		Deprecation: &hcloud.DeprecationInfo{},
+	}}
+	all := []*hcloud.ISO{public, private, deprecated}
+
+	assert.Equal(t, []*hcloud.ISO{public, private}, filterISOs(all, "", false))
+	assert.Equal(t, all, filterISOs(all, "", true))
+	assert.Equal(t, []*hcloud.ISO{public}, filterISOs(all, "public", false))
+	assert.Equal(t, []*hcloud.ISO{public, deprecated}, filterISOs(all, "public", true))
+	assert.Equal(t, []*hcloud.ISO{private}, filterISOs(all, "private", true))
+}
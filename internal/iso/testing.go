@@ -0,0 +1,40 @@
+package iso
+
+import (
+	"fmt"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testtemplate"
+)
+
+// DData defines the fields for the "testdata/d/hcloud_iso"
+// template.
+type DData struct {
+	testtemplate.DataCommon
+
+	ID                string
+	Name              string
+	WithArchitecture  hcloud.Architecture
+	WithType          hcloud.ISOType
+	IncludeDeprecated bool
+}
+
+// TFID returns the data source identifier.
+func (d *DData) TFID() string {
+	return fmt.Sprintf("data.%s.%s", DataSourceType, d.RName())
+}
+
+// DDataList defines the fields for the "testdata/d/hcloud_isos"
+// template.
+type DDataList struct {
+	testtemplate.DataCommon
+
+	WithArchitecture  hcloud.Architecture
+	WithType          hcloud.ISOType
+	IncludeDeprecated bool
+}
+
+// TFID returns the data source identifier.
+func (d *DDataList) TFID() string {
+	return fmt.Sprintf("data.%s.%s", DataSourceListType, d.RName())
+}
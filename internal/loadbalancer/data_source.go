@@ -96,6 +96,7 @@ func getCommonDataSchema() map[string]*schema.Schema {
 						Type:     schema.TypeString,
 						Computed: true,
 					},
+					"health_status": getHealthStatusSchema(),
 				},
 			},
 		},
@@ -270,7 +271,7 @@ func dataSourceHcloudLoadBalancerRead(ctx context.Context, d *schema.ResourceDat
 		if lb == nil {
 			return diag.Errorf("no Load Balancer found with id %d", id)
 		}
-		setLoadBalancerSchema(d, lb)
+		setLoadBalancerDataSchema(d, lb)
 		return nil
 	}
 	if name, ok := d.GetOk("name"); ok {
@@ -281,7 +282,7 @@ func dataSourceHcloudLoadBalancerRead(ctx context.Context, d *schema.ResourceDat
 		if lb == nil {
 			return diag.Errorf("no Load Balancer found with name %s", name)
 		}
-		setLoadBalancerSchema(d, lb)
+		setLoadBalancerDataSchema(d, lb)
 		return nil
 	}
 
@@ -304,7 +305,7 @@ func dataSourceHcloudLoadBalancerRead(ctx context.Context, d *schema.ResourceDat
 		if len(allLoadBalancers) > 1 {
 			return diag.Errorf("more than one Load Balancer found for selector %q", selector)
 		}
-		setLoadBalancerSchema(d, allLoadBalancers[0])
+		setLoadBalancerDataSchema(d, allLoadBalancers[0])
 		return nil
 	}
 	return diag.Errorf("please specify an id, a name or a selector to lookup the Load Balancer")
@@ -325,10 +326,40 @@ func dataSourceHcloudLoadBalancerListRead(ctx context.Context, d *schema.Resourc
 	tfLoadBalancers := make([]map[string]any, len(allLoadBalancers))
 	for i, loadBalancer := range allLoadBalancers {
 		ids[i] = util.FormatID(loadBalancer.ID)
-		tfLoadBalancers[i] = getLoadBalancerAttributes(loadBalancer)
+		tfLoadBalancers[i] = getLoadBalancerDataAttributes(loadBalancer)
 	}
 	d.Set("load_balancers", tfLoadBalancers)
 	d.SetId(datasourceutil.ListID(ids))
 
 	return nil
 }
+
+func setLoadBalancerDataSchema(d *schema.ResourceData, lb *hcloud.LoadBalancer) {
+	util.SetSchemaFromAttributes(d, getLoadBalancerDataAttributes(lb))
+}
+
+// getLoadBalancerDataAttributes returns the attributes of the data sources,
+// which provide more details about the targets than the resource.
+func getLoadBalancerDataAttributes(lb *hcloud.LoadBalancer) map[string]any {
+	res := getLoadBalancerAttributes(lb)
+	res["target"] = targetToTerraformDataTargets(lb.Targets)
+	return res
+}
+
+func targetToTerraformDataTargets(targets []hcloud.LoadBalancerTarget) []map[string]any {
+	tfTargets := make([]map[string]any, len(targets))
+	for i, target := range targets {
+		tfTarget := map[string]any{
+			"type":          string(target.Type),
+			"health_status": healthStatusToTerraformHealthStatus(targetHealthStatus(target)),
+		}
+		switch target.Type {
+		case hcloud.LoadBalancerTargetTypeServer:
+			tfTarget["server_id"] = target.Server.Server.ID
+		case hcloud.LoadBalancerTargetTypeLabelSelector:
+			tfTarget["label_selector"] = target.LabelSelector.Selector
+		}
+		tfTargets[i] = tfTarget
+	}
+	return tfTargets
+}
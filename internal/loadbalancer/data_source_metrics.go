@@ -0,0 +1,130 @@
+package loadbalancer
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/metrics"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/datasourceutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+)
+
+// MetricsDataSourceType is the type name of the Hetzner Cloud Load Balancer metrics
+// datasource.
+const MetricsDataSourceType = "hcloud_load_balancer_metrics"
+
+var _ datasource.DataSource = (*MetricsDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*MetricsDataSource)(nil)
+
+type MetricsDataSource struct {
+	client *hcloud.Client
+}
+
+func NewMetricsDataSource() datasource.DataSource {
+	return &MetricsDataSource{}
+}
+
+func (d *MetricsDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = MetricsDataSourceType
+}
+
+func (d *MetricsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	d.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *MetricsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = util.MarkdownDescription(`
+Provides the metrics of a Hetzner Cloud Load Balancer, for a period of time.
+
+The metrics are read during each plan and apply, use a period relative to ''plantimestamp()'' to get the latest metrics.
+
+See the [Get Metrics for a Load Balancer documentation](https://docs.hetzner.cloud/reference/cloud#tag/load-balancers/get_load_balancer_metrics) for more details.
+`)
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"load_balancer_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Load Balancer.",
+			Required:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Type of the metrics, `open_connections`, `connections_per_second`, `requests_per_second` or `bandwidth`.",
+			Required:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(hcloud.LoadBalancerMetricOpenConnections),
+					string(hcloud.LoadBalancerMetricConnectionsPerSecond),
+					string(hcloud.LoadBalancerMetricRequestsPerSecond),
+					string(hcloud.LoadBalancerMetricBandwidth),
+				),
+			},
+		},
+		"project": datasourceutil.ProjectAttribute(),
+	}
+	maps.Copy(resp.Schema.Attributes, metrics.DataSourceSchema())
+}
+
+type metricsDataSourceModel struct {
+	metrics.Model
+
+	LoadBalancerID types.Int64  `tfsdk:"load_balancer_id"`
+	Type           types.String `tfsdk:"type"`
+	Project        types.String `tfsdk:"project"`
+}
+
+func (d *MetricsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data metricsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	start, end, newDiags := data.Period()
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := hcloud.LoadBalancerGetMetricsOpts{
+		Types: []hcloud.LoadBalancerMetricType{hcloud.LoadBalancerMetricType(data.Type.ValueString())},
+		Start: start,
+		End:   end,
+		Step:  int(data.Step.ValueInt64()),
+	}
+
+	result, _, err := d.client.LoadBalancer.GetMetrics(ctx, &hcloud.LoadBalancer{ID: data.LoadBalancerID.ValueInt64()}, opts)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	timeSeries := make(map[string][]metrics.Value, len(result.TimeSeries))
+	for name, values := range result.TimeSeries {
+		timeSeries[name] = make([]metrics.Value, 0, len(values))
+		for _, value := range values {
+			timeSeries[name] = append(timeSeries[name], metrics.Value{Timestamp: value.Timestamp, Value: value.Value})
+		}
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, result.Step, timeSeries)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,59 @@
+package loadbalancer_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/loadbalancer"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/teste2e"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testmux"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testsupport"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testtemplate"
+)
+
+func TestAccLoadBalancerMetricsDataSource(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	res := &loadbalancer.RData{
+		Name:         "lb-metrics",
+		LocationName: teste2e.TestLocationName,
+	}
+	res.SetRName("main")
+
+	openConnections := &loadbalancer.DDataMetrics{
+		LoadBalancerID: res.TFID() + ".id",
+		Type:           hcloud.LoadBalancerMetricOpenConnections,
+		Start:          `timeadd(plantimestamp(), "-1h")`,
+		End:            `plantimestamp()`,
+		Step:           60,
+	}
+	openConnections.SetRName("open_connections")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		CheckDestroy:             testsupport.CheckResourcesDestroyed(loadbalancer.ResourceType, loadbalancer.ByID(t, nil)),
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_load_balancer", res,
+				),
+			},
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_load_balancer", res,
+					"testdata/d/hcloud_load_balancer_metrics", openConnections,
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(openConnections.TFID(), tfjsonpath.New("step"), knownvalue.Int64Exact(60)),
+					statecheck.ExpectKnownValue(openConnections.TFID(), tfjsonpath.New("time_series").AtMapKey("open_connections").AtMapKey("values"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
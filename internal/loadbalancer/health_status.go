@@ -0,0 +1,152 @@
+package loadbalancer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+// healthyTargetPollInterval is the interval between the checks of the target
+// health status, while waiting for the target to be healthy.
+var healthyTargetPollInterval = 5 * time.Second
+
+// getHealthStatusSchema returns the computed schema of the per-service health
+// status of a target.
+func getHealthStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"listen_port": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// targetHealthStatus returns the health status of the target for each service.
+//
+// The health status of a label selector target is aggregated from the health
+// status of the matching targets: a service is only healthy if all matching
+// targets are healthy, and unhealthy if any matching target is unhealthy.
+func targetHealthStatus(tgt hcloud.LoadBalancerTarget) []hcloud.LoadBalancerTargetHealthStatus {
+	if tgt.Type != hcloud.LoadBalancerTargetTypeLabelSelector {
+		return tgt.HealthStatus
+	}
+
+	rank := map[hcloud.LoadBalancerTargetHealthStatusStatus]int{
+		hcloud.LoadBalancerTargetHealthStatusStatusHealthy:   0,
+		hcloud.LoadBalancerTargetHealthStatusStatusUnknown:   1,
+		hcloud.LoadBalancerTargetHealthStatusStatusUnhealthy: 2,
+	}
+
+	result := make([]hcloud.LoadBalancerTargetHealthStatus, 0)
+	for _, subTgt := range tgt.Targets {
+		for _, status := range subTgt.HealthStatus {
+			i := slices.IndexFunc(result, func(s hcloud.LoadBalancerTargetHealthStatus) bool {
+				return s.ListenPort == status.ListenPort
+			})
+			if i < 0 {
+				result = append(result, status)
+				continue
+			}
+			if rank[status.Status] > rank[result[i].Status] {
+				result[i].Status = status.Status
+			}
+		}
+	}
+
+	slices.SortFunc(result, func(a, b hcloud.LoadBalancerTargetHealthStatus) int {
+		return a.ListenPort - b.ListenPort
+	})
+	return result
+}
+
+func healthStatusToTerraformHealthStatus(statuses []hcloud.LoadBalancerTargetHealthStatus) []map[string]any {
+	tfStatuses := make([]map[string]any, len(statuses))
+	for i, status := range statuses {
+		tfStatuses[i] = map[string]any{
+			"listen_port": status.ListenPort,
+			"status":      string(status.Status),
+		}
+	}
+	return tfStatuses
+}
+
+// unhealthyListenPorts returns the listen ports of the services for which the
+// target is not healthy yet, with their status.
+func unhealthyListenPorts(statuses []hcloud.LoadBalancerTargetHealthStatus) []string {
+	var ports []string
+	for _, status := range statuses {
+		if status.Status != hcloud.LoadBalancerTargetHealthStatusStatusHealthy {
+			ports = append(ports, fmt.Sprintf("%d (%s)", status.ListenPort, status.Status))
+		}
+	}
+	return ports
+}
+
+// unhealthyTarget returns the reasons why the target is not healthy yet. A label
+// selector target without any matching target is never healthy, as no target
+// receives the traffic of the Load Balancer.
+func unhealthyTarget(tgt hcloud.LoadBalancerTarget) []string {
+	if tgt.Type == hcloud.LoadBalancerTargetTypeLabelSelector && len(tgt.Targets) == 0 {
+		return []string{"no target matches the label selector"}
+	}
+	return unhealthyListenPorts(targetHealthStatus(tgt))
+}
+
+// waitForHealthyTarget waits until the target reports healthy for all services
+// of the Load Balancer, or until the context is done. A target without any
+// services is considered healthy, a label selector target without any matching
+// target is not.
+func waitForHealthyTarget(
+	ctx context.Context, client *hcloud.Client, lbID int64, tgtType hcloud.LoadBalancerTargetType, d *schema.ResourceData,
+) (hcloud.LoadBalancerTarget, diag.Diagnostics) {
+	for {
+		_, tgt, err := findLoadBalancerTarget(ctx, client, lbID, tgtType, d)
+		if err != nil {
+			return hcloud.LoadBalancerTarget{}, diag.Errorf("wait for healthy load balancer target: %v", err)
+		}
+
+		unhealthy := unhealthyTarget(tgt)
+		if len(unhealthy) == 0 {
+			return tgt, nil
+		}
+
+		tflog.Debug(ctx, "waiting for load balancer target to be healthy", map[string]any{
+			"load_balancer_id": lbID,
+			"unhealthy":        unhealthy,
+		})
+
+		select {
+		case <-ctx.Done():
+			detail := fmt.Sprintf("The target did not report healthy for all services of Load Balancer %d: %s.",
+				lbID, strings.Join(unhealthy, ", "))
+			if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				detail += " " + ctx.Err().Error()
+			}
+			return tgt, diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Load Balancer target is not healthy",
+				Detail:   detail,
+			}}
+		case <-time.After(healthyTargetPollInterval):
+		}
+	}
+}
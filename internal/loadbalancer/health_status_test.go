@@ -0,0 +1,107 @@
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func TestTargetHealthStatus(t *testing.T) {
+	healthy := hcloud.LoadBalancerTargetHealthStatusStatusHealthy
+	unknown := hcloud.LoadBalancerTargetHealthStatusStatusUnknown
+	unhealthy := hcloud.LoadBalancerTargetHealthStatusStatusUnhealthy
+
+	t.Run("server", func(t *testing.T) {
+		tgt := hcloud.LoadBalancerTarget{
+			Type: hcloud.LoadBalancerTargetTypeServer,
+			HealthStatus: []hcloud.LoadBalancerTargetHealthStatus{
+				{ListenPort: 80, Status: healthy},
+				{ListenPort: 443, Status: unhealthy},
+			},
+		}
+
+		statuses := targetHealthStatus(tgt)
+		assert.Equal(t, tgt.HealthStatus, statuses)
+		assert.Equal(t, []string{"443 (unhealthy)"}, unhealthyListenPorts(statuses))
+	})
+
+	t.Run("label selector", func(t *testing.T) {
+		tgt := hcloud.LoadBalancerTarget{
+			Type: hcloud.LoadBalancerTargetTypeLabelSelector,
+			Targets: []hcloud.LoadBalancerTarget{
+				{
+					HealthStatus: []hcloud.LoadBalancerTargetHealthStatus{
+						{ListenPort: 443, Status: healthy},
+						{ListenPort: 80, Status: healthy},
+						{ListenPort: 8080, Status: unhealthy},
+					},
+				},
+				{
+					HealthStatus: []hcloud.LoadBalancerTargetHealthStatus{
+						{ListenPort: 80, Status: healthy},
+						{ListenPort: 443, Status: unknown},
+						{ListenPort: 8080, Status: unknown},
+					},
+				},
+			},
+		}
+
+		statuses := targetHealthStatus(tgt)
+		assert.Equal(t, []hcloud.LoadBalancerTargetHealthStatus{
+			{ListenPort: 80, Status: healthy},
+			{ListenPort: 443, Status: unknown},
+			{ListenPort: 8080, Status: unhealthy},
+		}, statuses)
+		assert.Equal(t, []string{"443 (unknown)", "8080 (unhealthy)"}, unhealthyListenPorts(statuses))
+	})
+
+	t.Run("without services", func(t *testing.T) {
+		statuses := targetHealthStatus(hcloud.LoadBalancerTarget{Type: hcloud.LoadBalancerTargetTypeIP})
+		assert.Empty(t, statuses)
+		assert.Empty(t, unhealthyListenPorts(statuses))
+	})
+}
+
+func TestUnhealthyTarget(t *testing.T) {
+	healthy := hcloud.LoadBalancerTargetHealthStatusStatusHealthy
+	unhealthy := hcloud.LoadBalancerTargetHealthStatusStatusUnhealthy
+
+	t.Run("server", func(t *testing.T) {
+		assert.Equal(t, []string{"443 (unhealthy)"}, unhealthyTarget(hcloud.LoadBalancerTarget{
+			Type: hcloud.LoadBalancerTargetTypeServer,
+			HealthStatus: []hcloud.LoadBalancerTargetHealthStatus{
+				{ListenPort: 80, Status: healthy},
+				{ListenPort: 443, Status: unhealthy},
+			},
+		}))
+	})
+
+	t.Run("without services", func(t *testing.T) {
+		assert.Empty(t, unhealthyTarget(hcloud.LoadBalancerTarget{Type: hcloud.LoadBalancerTargetTypeServer}))
+	})
+
+	t.Run("label selector", func(t *testing.T) {
+		assert.Empty(t, unhealthyTarget(hcloud.LoadBalancerTarget{
+			Type: hcloud.LoadBalancerTargetTypeLabelSelector,
+			Targets: []hcloud.LoadBalancerTarget{
+				{HealthStatus: []hcloud.LoadBalancerTargetHealthStatus{{ListenPort: 80, Status: healthy}}},
+			},
+		}))
+	})
+
+	t.Run("label selector without matching targets", func(t *testing.T) {
+		assert.Equal(t, []string{"no target matches the label selector"}, unhealthyTarget(hcloud.LoadBalancerTarget{
+			Type: hcloud.LoadBalancerTargetTypeLabelSelector,
+		}))
+	})
+}
+
+func TestHealthStatusToTerraformHealthStatus(t *testing.T) {
+	assert.Equal(t, []map[string]any{
+		{"listen_port": 80, "status": "healthy"},
+	}, healthStatusToTerraformHealthStatus([]hcloud.LoadBalancerTargetHealthStatus{
+		{ListenPort: 80, Status: hcloud.LoadBalancerTargetHealthStatusStatusHealthy},
+	}))
+}
@@ -0,0 +1,82 @@
+package loadbalancer
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/listresourceutil"
+)
+
+var _ list.ListResource = (*ListResource)(nil)
+var _ list.ListResourceWithConfigure = (*ListResource)(nil)
+var _ list.ListResourceWithRawV6Schemas = (*ListResource)(nil)
+
+// ListResource lists the instances of the Load Balancer resource, which is
+// implemented with the SDKv2.
+type ListResource struct {
+	client *hcloud.Client
+}
+
+func NewListResource() list.ListResource {
+	return &ListResource{}
+}
+
+func (r *ListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = ResourceType
+}
+
+func (r *ListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	r.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+}
+
+func (r *ListResource) RawV6Schemas(ctx context.Context, _ list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	listresourceutil.RawV6Schemas(ctx, ResourceType, hcloudutil.WithProjectSchema(Resource(), true), resp)
+}
+
+func (r *ListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listresourceutil.WithSelectorSchema()
+	resp.Schema.MarkdownDescription = "Lists the Hetzner Cloud Load Balancers."
+}
+
+func (r *ListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data listresourceutil.WithSelectorModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	opts := hcloud.LoadBalancerListOpts{}
+	opts.LabelSelector = data.WithSelector.ValueString()
+
+	result, err := r.client.LoadBalancer.AllWithOpts(ctx, opts)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(hcloudutil.APIErrorDiagnostics(err))
+		return
+	}
+
+	stream.Results = listresourceutil.Results(ctx, req, result, func(ctx context.Context, in *hcloud.LoadBalancer, item *list.ListResult) {
+		item.DisplayName = in.Name
+		item.Diagnostics.Append(item.Identity.SetAttribute(ctx, path.Root("id"), in.ID)...)
+
+		if !req.IncludeResource {
+			return
+		}
+
+		res := hcloudutil.WithProjectSchema(Resource(), true)
+		d := res.Data(nil)
+		setLoadBalancerSchema(d, in)
+
+		item.Diagnostics.Append(listresourceutil.SetResourceData(ctx, res, d, item)...)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -19,6 +20,9 @@ type networkResourceData struct {
 	IP             iptypes.IPAddress `tfsdk:"ip"`
 
 	EnablePublicInterface types.Bool `tfsdk:"enable_public_interface"`
+
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // nolint:unparam
@@ -37,3 +41,15 @@ func populateNetworkResourceData(
 
 	return nil
 }
+
+type networkIdentityModel struct {
+	LoadBalancerID types.Int64 `tfsdk:"load_balancer_id"`
+	NetworkID      types.Int64 `tfsdk:"network_id"`
+}
+
+func newNetworkIdentity(data networkResourceData) networkIdentityModel {
+	return networkIdentityModel{
+		LoadBalancerID: data.LoadBalancerID,
+		NetworkID:      data.NetworkID,
+	}
+}
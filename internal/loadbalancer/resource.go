@@ -12,6 +12,7 @@ import (
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
 )
 
 // ResourceType is the type name of the Hetzner Cloud Load Balancer resource.
@@ -25,8 +26,9 @@ func Resource() *schema.Resource {
 		UpdateContext: resourceLoadBalancerUpdate,
 		DeleteContext: resourceLoadBalancerDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceutil.ImportStatePassthroughWithIDIdentity,
 		},
+		Identity: resourceutil.IDResourceIdentity("ID of the Load Balancer."),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -194,6 +196,10 @@ func resourceLoadBalancerRead(ctx context.Context, d *schema.ResourceData, m any
 		return nil
 	}
 	setLoadBalancerSchema(d, loadBalancer)
+
+	if err := resourceutil.SetIDIdentity(d, loadBalancer.ID); err != nil {
+		return diag.FromErr(err)
+	}
 	return nil
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -24,6 +25,7 @@ import (
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/control"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/validateutil"
 )
 
@@ -33,6 +35,7 @@ var _ resource.Resource = (*NetworkResource)(nil)
 var _ resource.ResourceWithConfigure = (*NetworkResource)(nil)
 var _ resource.ResourceWithConfigValidators = (*NetworkResource)(nil)
 var _ resource.ResourceWithImportState = (*NetworkResource)(nil)
+var _ resource.ResourceWithIdentity = (*NetworkResource)(nil)
 var _ resource.ResourceWithModifyPlan = (*NetworkResource)(nil)
 
 type NetworkResource struct {
@@ -57,7 +60,7 @@ func (r *NetworkResource) Configure(_ context.Context, req resource.ConfigureReq
 	}
 }
 
-func (r *NetworkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NetworkResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema.MarkdownDescription = util.MarkdownDescription(`
 Manage the attachment of a Load Balancer in a Network in the Hetzner Cloud.
 `)
@@ -113,6 +116,11 @@ Manage the attachment of a Load Balancer in a Network in the Hetzner Cloud.
 			Computed:            true,
 			Default:             booldefault.StaticBool(true),
 		},
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
 	}
 }
 
@@ -125,6 +133,21 @@ func (r *NetworkResource) ConfigValidators(context.Context) []resource.ConfigVal
 	}
 }
 
+func (r *NetworkResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"load_balancer_id": identityschema.Int64Attribute{
+				Description:       "ID of the Load Balancer.",
+				RequiredForImport: true,
+			},
+			"network_id": identityschema.Int64Attribute{
+				Description:       "ID of the Network.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *NetworkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	// Do not modify on resource creation.
 	if req.State.Raw.IsNull() {
@@ -203,6 +226,15 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
+
 	loadBalancer := &hcloud.LoadBalancer{ID: data.LoadBalancerID.ValueInt64()}
 
 	opts := hcloud.LoadBalancerAttachToNetworkOpts{}
@@ -234,7 +266,7 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 	// Apply changes
 	var action *hcloud.Action
 
-	err := control.Retry(control.DefaultRetries, func() error {
+	err := control.Retry(ctx, control.DefaultRetries, func() error {
 		var innerErr error
 
 		action, _, innerErr = r.client.LoadBalancer.AttachToNetwork(ctx, loadBalancer, opts)
@@ -320,6 +352,7 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newNetworkIdentity(data))...)
 }
 
 func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -363,6 +396,7 @@ func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newNetworkIdentity(data))...)
 }
 
 func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -374,6 +408,15 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "update", updateTimeout)
+	defer cancel()
+
 	loadBalancer, network, err := r.ParseID(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(
@@ -424,7 +467,10 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	data.Timeouts = plan.Timeouts
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newNetworkIdentity(data))...)
 }
 
 func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -435,6 +481,15 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
+
 	loadBalancer, network, err := r.ParseID(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(
@@ -450,7 +505,7 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	var action *hcloud.Action
-	err = control.Retry(control.DefaultRetries, func() error {
+	err = control.Retry(ctx, control.DefaultRetries, func() error {
 		var innerErr error
 
 		action, _, innerErr = r.client.LoadBalancer.DetachFromNetwork(ctx, loadBalancer, opts)
@@ -490,6 +545,13 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *NetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity networkIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%d-%d", identity.LoadBalancerID.ValueInt64(), identity.NetworkID.ValueInt64()))...)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
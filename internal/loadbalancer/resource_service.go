@@ -225,7 +225,7 @@ func resourceLoadBalancerServiceCreate(ctx context.Context, d *schema.ResourceDa
 		opts.HealthCheck = parseTFHealthCheckAdd(tfHealthCheck.([]any))
 	}
 
-	err = control.Retry(control.DefaultRetries, func() error {
+	err = control.Retry(ctx, control.DefaultRetries, func() error {
 		var err error
 
 		action, _, err = c.LoadBalancer.AddService(ctx, &lb, opts)
@@ -8,6 +8,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -38,6 +39,10 @@ func TargetResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceLoadBalancerTargetImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"type": {
@@ -78,6 +83,12 @@ func TargetResource() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"wait_for_healthy_targets": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"health_status": getHealthStatusSchema(),
 		},
 	}
 }
@@ -119,6 +130,14 @@ func resourceLoadBalancerTargetCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.Errorf("add load balancer target: %v", err)
 	}
 	setLoadBalancerTarget(d, lbID, tgt)
+
+	if d.Get("wait_for_healthy_targets").(bool) {
+		tgt, diags := waitForHealthyTarget(ctx, c, lbID, tgtType, d)
+		if diags.HasError() {
+			return diags
+		}
+		setLoadBalancerTarget(d, lbID, tgt)
+	}
 	return nil
 }
 
@@ -154,7 +173,7 @@ func resourceLoadBalancerCreateServerTarget(
 		opts.UsePrivateIP = new(usePrivateIP)
 	}
 
-	err = control.Retry(control.DefaultRetries, func() error {
+	err = control.Retry(ctx, control.DefaultRetries, func() error {
 		var err error
 
 		if usePrivateIP && len(lb.PrivateNet) == 0 {
@@ -322,6 +341,11 @@ func resourceLoadBalancerTargetImport(ctx context.Context, d *schema.ResourceDat
 		return nil, fmt.Errorf("unsupported target type: %s", tgtType)
 	}
 
+	// Not returned by the API, set the default value.
+	if err := d.Set("wait_for_healthy_targets", false); err != nil {
+		return nil, err
+	}
+
 	// Read existing state from api and finish resource
 	diag := resourceLoadBalancerTargetRead(ctx, d, m)
 	if diag.HasError() {
@@ -344,6 +368,20 @@ func resourceLoadBalancerTargetUpdate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return hcloudutil.ErrorToDiag(err)
 	}
+
+	// Changing the wait setting does not require to recreate the target.
+	if !d.HasChangesExcept("wait_for_healthy_targets") {
+		if d.Get("wait_for_healthy_targets").(bool) {
+			var diags diag.Diagnostics
+			tgt, diags = waitForHealthyTarget(ctx, client, lbID, tgtType, d)
+			if diags.HasError() {
+				return diags
+			}
+		}
+		setLoadBalancerTarget(d, lbID, tgt)
+		return nil
+	}
+
 	if err := removeLoadBalancerTarget(ctx, client, lb, tgt); err != nil {
 		return hcloudutil.ErrorToDiag(err)
 	}
@@ -375,7 +413,7 @@ func removeLoadBalancerTarget(ctx context.Context, c *hcloud.Client, lb *hcloud.
 		hcErr  hcloud.Error
 	)
 
-	err = control.Retry(control.DefaultRetries, func() error {
+	err = control.Retry(ctx, control.DefaultRetries, func() error {
 		switch tgt.Type {
 		case hcloud.LoadBalancerTargetTypeServer:
 			action, _, err = c.LoadBalancer.RemoveServerTarget(ctx, lb, tgt.Server.Server)
@@ -457,6 +495,7 @@ func findLoadBalancerTarget(
 func setLoadBalancerTarget(d *schema.ResourceData, lbID int64, tgt hcloud.LoadBalancerTarget) {
 	d.Set("type", tgt.Type)
 	d.Set("load_balancer_id", lbID)
+	d.Set("health_status", healthStatusToTerraformHealthStatus(targetHealthStatus(tgt)))
 
 	switch tgt.Type {
 	case hcloud.LoadBalancerTargetTypeServer:
@@ -85,6 +85,90 @@ func TestAccLoadBalancerTargetResource_ServerTarget(t *testing.T) {
 	})
 }
 
+func TestAccLoadBalancerTargetResource_ServerTarget_WaitForHealthy(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	resSSHKey := sshkey.NewRData(t, "lb-server-target-healthy")
+	resServer := &server.RData{
+		Name:    "lb-server-target-healthy",
+		Type:    teste2e.TestServerType,
+		Image:   teste2e.TestImage,
+		SSHKeys: []string{resSSHKey.TFID() + ".id"},
+	}
+	resServer.SetRName("lb-server-target-healthy")
+
+	resLoadBalancer := &loadbalancer.RData{
+		Name:        "target-healthy-test-lb",
+		Type:        teste2e.TestLoadBalancerType,
+		NetworkZone: "eu-central",
+	}
+	resLoadBalancer.SetRName(resLoadBalancer.Name)
+
+	// The default health check of the service checks the SSH port of the server.
+	resService := &loadbalancer.RDataService{
+		Name:            "ssh",
+		LoadBalancerID:  resLoadBalancer.TFID() + ".id",
+		Protocol:        "tcp",
+		ListenPort:      22,
+		DestinationPort: 22,
+	}
+	resService.SetRName("ssh")
+
+	res1 := &loadbalancer.RDataTarget{
+		Name:           "lb-test-target-healthy",
+		Type:           "server",
+		LoadBalancerID: resLoadBalancer.TFID() + ".id",
+		ServerID:       resServer.TFID() + ".id",
+		WaitForHealthy: true,
+		DependsOn:      []string{resService.TFID()},
+	}
+	res1.SetRName("lb-test-target-healthy")
+
+	resData := &loadbalancer.DData{
+		LoadBalancerID: resLoadBalancer.TFID() + ".id",
+	}
+	resData.SetRName("lb-test-target-healthy")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		CheckDestroy:             testsupport.CheckResourcesDestroyed(loadbalancer.ResourceType, loadbalancer.ByID(t, nil)),
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_ssh_key", resSSHKey,
+					"testdata/r/hcloud_server", resServer,
+					"testdata/r/hcloud_load_balancer", resLoadBalancer,
+					"testdata/r/hcloud_load_balancer_service", resService,
+					"testdata/r/hcloud_load_balancer_target", res1,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(res1.TFID(), "wait_for_healthy_targets", "true"),
+					resource.TestCheckResourceAttr(res1.TFID(), "health_status.#", "1"),
+					resource.TestCheckResourceAttr(res1.TFID(), "health_status.0.listen_port", "22"),
+					resource.TestCheckResourceAttr(res1.TFID(), "health_status.0.status", "healthy"),
+				),
+			},
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_ssh_key", resSSHKey,
+					"testdata/r/hcloud_server", resServer,
+					"testdata/r/hcloud_load_balancer", resLoadBalancer,
+					"testdata/r/hcloud_load_balancer_service", resService,
+					"testdata/r/hcloud_load_balancer_target", res1,
+					"testdata/d/hcloud_load_balancer", resData,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resData.TFID(), "target.#", "1"),
+					resource.TestCheckResourceAttr(resData.TFID(), "target.0.health_status.#", "1"),
+					resource.TestCheckResourceAttr(resData.TFID(), "target.0.health_status.0.listen_port", "22"),
+					resource.TestCheckResourceAttr(resData.TFID(), "target.0.health_status.0.status", "healthy"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLoadBalancerTargetResource_ServerTarget_UsePrivateIP(t *testing.T) {
 	var (
 		lb  hcloud.LoadBalancer
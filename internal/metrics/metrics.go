@@ -0,0 +1,142 @@
+// Package metrics contains the schema and model shared by the metrics data
+// sources, for example `hcloud_server_metrics`.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// DataSourceSchema returns the attributes shared by the metrics data sources.
+func DataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"start": schema.StringAttribute{
+			MarkdownDescription: "Start of the period to get the metrics for (in RFC3339 format).",
+			Required:            true,
+		},
+		"end": schema.StringAttribute{
+			MarkdownDescription: "End of the period to get the metrics for (in RFC3339 format).",
+			Required:            true,
+		},
+		"step": schema.Int64Attribute{
+			MarkdownDescription: "Resolution of the time series, in seconds. Defaults to a step computed by the API to return at most 200 values per time series.",
+			Optional:            true,
+			Computed:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"time_series": schema.MapNestedAttribute{
+			MarkdownDescription: "Time series of the metrics, by name, as returned by the API.",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"values": schema.ListNestedAttribute{
+						MarkdownDescription: "Values of the time series.",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"timestamp": schema.Int64Attribute{
+									MarkdownDescription: "Point in time of the value (in Unix time).",
+									Computed:            true,
+								},
+								"value": schema.StringAttribute{
+									MarkdownDescription: "Value of the metric at this point in time. Use `tonumber` to convert it to a number.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Model holds the values of the attributes shared by the metrics data sources.
+type Model struct {
+	Start      types.String `tfsdk:"start"`
+	End        types.String `tfsdk:"end"`
+	Step       types.Int64  `tfsdk:"step"`
+	TimeSeries types.Map    `tfsdk:"time_series"`
+}
+
+// Period returns the parsed start and end of the period.
+func (m *Model) Period() (time.Time, time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	start, err := time.Parse(time.RFC3339, m.Start.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("start"), "Invalid start", fmt.Sprintf("Could not parse start as RFC3339 time: %s", err))
+	}
+	end, err := time.Parse(time.RFC3339, m.End.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("end"), "Invalid end", fmt.Sprintf("Could not parse end as RFC3339 time: %s", err))
+	}
+	if !diags.HasError() && !end.After(start) {
+		diags.AddAttributeError(path.Root("end"), "Invalid end", "End must be after start.")
+	}
+
+	return start, end, diags
+}
+
+// Value is a single value of a time series.
+type Value struct {
+	Timestamp float64
+	Value     string
+}
+
+var valueAttrTypes = map[string]attr.Type{
+	"timestamp": types.Int64Type,
+	"value":     types.StringType,
+}
+
+var timeSeriesAttrTypes = map[string]attr.Type{
+	"values": types.ListType{ElemType: basetypes.ObjectType{AttrTypes: valueAttrTypes}},
+}
+
+// FromAPI sets the step and time series returned by the API.
+func (m *Model) FromAPI(_ context.Context, step float64, timeSeries map[string][]Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	m.Step = types.Int64Value(int64(step))
+
+	tfTimeSeries := make(map[string]attr.Value, len(timeSeries))
+	for name, values := range timeSeries {
+		tfValues := make([]attr.Value, 0, len(values))
+		for _, value := range values {
+			var tfValue basetypes.ObjectValue
+			tfValue, newDiags = types.ObjectValue(valueAttrTypes, map[string]attr.Value{
+				"timestamp": types.Int64Value(int64(value.Timestamp)),
+				"value":     types.StringValue(value.Value),
+			})
+			diags.Append(newDiags...)
+			tfValues = append(tfValues, tfValue)
+		}
+
+		var tfList basetypes.ListValue
+		tfList, newDiags = types.ListValue(basetypes.ObjectType{AttrTypes: valueAttrTypes}, tfValues)
+		diags.Append(newDiags...)
+
+		tfTimeSeries[name], newDiags = types.ObjectValue(timeSeriesAttrTypes, map[string]attr.Value{
+			"values": tfList,
+		})
+		diags.Append(newDiags...)
+	}
+
+	m.TimeSeries, newDiags = types.MapValue(basetypes.ObjectType{AttrTypes: timeSeriesAttrTypes}, tfTimeSeries)
+	diags.Append(newDiags...)
+
+	return diags
+}
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelPeriod(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		m := Model{
+			Start: types.StringValue("2026-05-01T17:00:00Z"),
+			End:   types.StringValue("2026-05-01T19:00:00+01:00"),
+		}
+		start, end, diags := m.Period()
+		assert.False(t, diags.HasError())
+		assert.Equal(t, time.Date(2026, 5, 1, 17, 0, 0, 0, time.UTC), start.UTC())
+		assert.Equal(t, time.Date(2026, 5, 1, 18, 0, 0, 0, time.UTC), end.UTC())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		m := Model{
+			Start: types.StringValue("2026-05-01"),
+			End:   types.StringValue("2026-05-01T18:00:00Z"),
+		}
+		_, _, diags := m.Period()
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Invalid start", diags[0].Summary())
+	})
+
+	t.Run("end before start", func(t *testing.T) {
+		m := Model{
+			Start: types.StringValue("2026-05-01T18:00:00Z"),
+			End:   types.StringValue("2026-05-01T17:00:00Z"),
+		}
+		_, _, diags := m.Period()
+		assert.True(t, diags.HasError())
+		assert.Equal(t, "Invalid end", diags[0].Summary())
+	})
+}
+
+func TestModelFromAPI(t *testing.T) {
+	ctx := t.Context()
+
+	m := Model{}
+	diags := m.FromAPI(ctx, 60, map[string][]Value{
+		"cpu": {
+			{Timestamp: 1777654800, Value: "1.5"},
+			{Timestamp: 1777654860, Value: "2.25"},
+		},
+		"empty": {},
+	})
+	assert.False(t, diags.HasError())
+	assert.Equal(t, int64(60), m.Step.ValueInt64())
+	assert.Equal(t,
+		`{"cpu":{"values":[{"timestamp":1777654800,"value":"1.5"},{"timestamp":1777654860,"value":"2.25"}]},"empty":{"values":[]}}`,
+		m.TimeSeries.String(),
+	)
+}
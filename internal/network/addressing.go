@@ -0,0 +1,144 @@
+package network
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+)
+
+// privateRanges are the private IPv4 ranges of RFC1918, in which the IP range
+// of a Network must be.
+var privateRanges = []netip.Prefix{
+	netip.MustParsePrefix("10.0.0.0/8"),
+	netip.MustParsePrefix("172.16.0.0/12"),
+	netip.MustParsePrefix("192.168.0.0/16"),
+}
+
+// publicGatewayIP is used as gateway for the public network interface of the
+// servers, it cannot be used in a Network.
+var publicGatewayIP = netip.MustParseAddr("172.31.1.1")
+
+// parseIPv4Prefix parses an IPv4 CIDR, which must be a network address.
+func parseIPv4Prefix(cidr string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid cidr %q: %w", cidr, err)
+	}
+	if !prefix.Addr().Is4() {
+		return netip.Prefix{}, fmt.Errorf("invalid cidr %q, must be an IPv4 cidr", cidr)
+	}
+	if prefix.Masked() != prefix {
+		return netip.Prefix{}, fmt.Errorf("invalid cidr %q, must be a network address, for example %q", cidr, prefix.Masked().String())
+	}
+	return prefix, nil
+}
+
+// isPrivateRange returns whether the CIDR is within one of the private IPv4
+// ranges of RFC1918.
+func isPrivateRange(cidr string) (bool, error) {
+	prefix, err := parseIPv4Prefix(cidr)
+	if err != nil {
+		return false, err
+	}
+	for _, privateRange := range privateRanges {
+		if privateRange.Bits() <= prefix.Bits() && privateRange.Contains(prefix.Addr()) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// allocateSubnet returns the first CIDR of the given prefix length within the
+// network IP range, that does not overlap with any of the existing CIDRs or
+// with the public gateway IP.
+func allocateSubnet(networkCIDR string, bits int64, existing []string) (string, error) {
+	network, err := parseIPv4Prefix(networkCIDR)
+	if err != nil {
+		return "", err
+	}
+	if bits < int64(network.Bits()) || bits > 32 {
+		return "", fmt.Errorf("invalid prefix %d, must be between %d and 32", bits, network.Bits())
+	}
+
+	used := make([]netip.Prefix, 0, len(existing)+1)
+	used = append(used, netip.PrefixFrom(publicGatewayIP, 32))
+	for _, cidr := range existing {
+		prefix, err := parseIPv4Prefix(cidr)
+		if err != nil {
+			return "", err
+		}
+		used = append(used, prefix)
+	}
+
+	size := uint64(1) << (32 - bits)
+	first, last := prefixRange(network)
+
+	for start := first; start+size-1 <= last; {
+		candidate := netip.PrefixFrom(uint64ToAddr(start), int(bits))
+
+		next := start
+		for _, prefix := range used {
+			if !candidate.Overlaps(prefix) {
+				continue
+			}
+			// Skip all the candidates overlapping with the used CIDR.
+			_, usedLast := prefixRange(prefix)
+			next = max(next, alignUp(usedLast+1, size))
+		}
+		if next == start {
+			return candidate.String(), nil
+		}
+		start = next
+	}
+
+	return "", fmt.Errorf("no free subnet with prefix %d left in %q", bits, network.String())
+}
+
+// nextFreeIP returns the first IP of the subnet that is not used. The network
+// address, the first IP reserved for the gateway, the broadcast address and the
+// public gateway IP are never returned.
+func nextFreeIP(subnetCIDR string, usedIPs []string) (string, error) {
+	subnet, err := parseIPv4Prefix(subnetCIDR)
+	if err != nil {
+		return "", err
+	}
+
+	used := make(map[netip.Addr]bool, len(usedIPs)+1)
+	used[publicGatewayIP] = true
+	for _, raw := range usedIPs {
+		ip, err := netip.ParseAddr(raw)
+		if err != nil {
+			return "", fmt.Errorf("invalid ip %q: %w", raw, err)
+		}
+		used[ip] = true
+	}
+
+	first, last := prefixRange(subnet)
+	// Skip the network address and the gateway, until the broadcast address.
+	for ip := first + 2; ip < last; ip++ {
+		addr := uint64ToAddr(ip)
+		if !used[addr] {
+			return addr.String(), nil
+		}
+	}
+
+	return "", fmt.Errorf("no free ip left in %q", subnet.String())
+}
+
+// prefixRange returns the first and last address of the prefix.
+func prefixRange(prefix netip.Prefix) (uint64, uint64) {
+	addr := prefix.Addr().As4()
+	first := uint64(binary.BigEndian.Uint32(addr[:]))
+	return first, first + (uint64(1) << (32 - prefix.Bits())) - 1
+}
+
+// alignUp rounds the address up to the next multiple of size.
+func alignUp(addr, size uint64) uint64 {
+	return (addr + size - 1) / size * size
+}
+
+func uint64ToAddr(value uint64) netip.Addr {
+	var addr [4]byte
+	binary.BigEndian.PutUint32(addr[:], uint32(value))
+	return netip.AddrFrom4(addr)
+}
@@ -0,0 +1,174 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsPrivateRange(t *testing.T) {
+	testCases := []struct {
+		cidr     string
+		expected bool
+		err      string
+	}{
+		{cidr: "10.0.0.0/16", expected: true},
+		{cidr: "10.0.0.0/8", expected: true},
+		{cidr: "172.16.0.0/12", expected: true},
+		{cidr: "172.31.0.0/24", expected: true},
+		{cidr: "192.168.10.0/24", expected: true},
+		{cidr: "172.0.0.0/8", expected: false},
+		{cidr: "203.0.113.0/24", expected: false},
+		{cidr: "10.0.0.1/16", err: `invalid cidr "10.0.0.1/16", must be a network address, for example "10.0.0.0/16"`},
+		{cidr: "fd00::/8", err: `invalid cidr "fd00::/8", must be an IPv4 cidr`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.cidr, func(t *testing.T) {
+			result, err := isPrivateRange(testCase.cidr)
+			if testCase.err != "" {
+				assert.EqualError(t, err, testCase.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, result)
+		})
+	}
+}
+
+func TestAllocateSubnet(t *testing.T) {
+	testCases := []struct {
+		name     string
+		network  string
+		prefix   int64
+		existing []string
+		expected string
+		err      string
+	}{
+		{
+			name:     "empty network",
+			network:  "10.0.0.0/16",
+			prefix:   24,
+			expected: "10.0.0.0/24",
+		},
+		{
+			name:     "after existing",
+			network:  "10.0.0.0/16",
+			prefix:   24,
+			existing: []string{"10.0.0.0/24", "10.0.1.0/24"},
+			expected: "10.0.2.0/24",
+		},
+		{
+			name:     "fill gap",
+			network:  "10.0.0.0/16",
+			prefix:   24,
+			existing: []string{"10.0.0.0/24", "10.0.2.0/24"},
+			expected: "10.0.1.0/24",
+		},
+		{
+			name:     "skip larger existing",
+			network:  "10.0.0.0/16",
+			prefix:   28,
+			existing: []string{"10.0.0.0/22", "10.0.4.0/32"},
+			expected: "10.0.4.16/28",
+		},
+		{
+			name:     "align after smaller existing",
+			network:  "10.0.0.0/16",
+			prefix:   24,
+			existing: []string{"10.0.0.64/26"},
+			expected: "10.0.1.0/24",
+		},
+		{
+			name:     "ignore existing outside of network",
+			network:  "10.0.0.0/16",
+			prefix:   24,
+			existing: []string{"10.1.0.0/24"},
+			expected: "10.0.0.0/24",
+		},
+		{
+			name:     "skip public gateway",
+			network:  "172.31.1.0/24",
+			prefix:   30,
+			expected: "172.31.1.4/30",
+		},
+		{
+			name:     "full network",
+			network:  "10.0.0.0/23",
+			prefix:   24,
+			existing: []string{"10.0.0.0/24", "10.0.1.0/24"},
+			err:      `no free subnet with prefix 24 left in "10.0.0.0/23"`,
+		},
+		{
+			name:    "prefix larger than network",
+			network: "10.0.0.0/16",
+			prefix:  8,
+			err:     "invalid prefix 8, must be between 16 and 32",
+		},
+		{
+			name:     "invalid existing",
+			network:  "10.0.0.0/16",
+			prefix:   24,
+			existing: []string{"10.0.0.0"},
+			err:      `invalid cidr "10.0.0.0": netip.ParsePrefix("10.0.0.0"): no '/'`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := allocateSubnet(testCase.network, testCase.prefix, testCase.existing)
+			if testCase.err != "" {
+				assert.EqualError(t, err, testCase.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, result)
+		})
+	}
+}
+
+func TestNextFreeIP(t *testing.T) {
+	testCases := []struct {
+		name     string
+		subnet   string
+		used     []string
+		expected string
+		err      string
+	}{
+		{
+			name:     "empty subnet",
+			subnet:   "10.0.1.0/24",
+			expected: "10.0.1.2",
+		},
+		{
+			name:     "skip used",
+			subnet:   "10.0.1.0/24",
+			used:     []string{"10.0.1.2", "10.0.1.3", "10.0.1.5"},
+			expected: "10.0.1.4",
+		},
+		{
+			name:   "full subnet",
+			subnet: "10.0.1.0/29",
+			used:   []string{"10.0.1.2", "10.0.1.3", "10.0.1.4", "10.0.1.5", "10.0.1.6"},
+			err:    `no free ip left in "10.0.1.0/29"`,
+		},
+		{
+			name:   "invalid used",
+			subnet: "10.0.1.0/24",
+			used:   []string{"10.0.1.256"},
+			err:    `invalid ip "10.0.1.256": ParseAddr("10.0.1.256"): IPv4 field has value >255`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := nextFreeIP(testCase.subnet, testCase.used)
+			if testCase.err != "" {
+				assert.EqualError(t, err, testCase.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, result)
+		})
+	}
+}
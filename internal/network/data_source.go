@@ -173,3 +173,18 @@ func dataSourceHcloudNetworkListRead(ctx context.Context, d *schema.ResourceData
 
 	return nil
 }
+
+func setNetworkSchema(d *schema.ResourceData, n *hcloud.Network) {
+	util.SetSchemaFromAttributes(d, getNetworkAttributes(n))
+}
+
+func getNetworkAttributes(n *hcloud.Network) map[string]any {
+	return map[string]any{
+		"id":                       n.ID,
+		"ip_range":                 n.IPRange.String(),
+		"name":                     n.Name,
+		"labels":                   n.Labels,
+		"delete_protection":        n.Protection.Delete,
+		"expose_routes_to_vswitch": n.ExposeRoutesToVSwitch,
+	}
+}
@@ -0,0 +1,52 @@
+package network
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+)
+
+func NewIsPrivateRangeFunction() function.Function {
+	return &IsPrivateRangeFunction{}
+}
+
+type IsPrivateRangeFunction struct{}
+
+func (f *IsPrivateRangeFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "is_private_range"
+}
+
+func (f *IsPrivateRangeFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Check if an IP range is private",
+		MarkdownDescription: util.MarkdownDescription(`
+Check if an IPv4 range is within one of the private ranges of RFC1918 (''10.0.0.0/8'', ''172.16.0.0/12'' and ''192.168.0.0/16''), as required for the ''ip_range'' of a ''hcloud_network''.
+`),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cidr",
+				Description: "IP range to check, for example `10.0.0.0/16`.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *IsPrivateRangeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &cidr))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := isPrivateRange(cidr)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
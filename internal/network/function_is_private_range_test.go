@@ -0,0 +1,43 @@
+package network_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testmux"
+)
+
+func TestIsPrivateRangeFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::hcloud::is_private_range("172.16.0.0/16")
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(true)),
+				},
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::hcloud::is_private_range("203.0.113.0/24")
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(false)),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package network
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+)
+
+func NewNextFreeIPFunction() function.Function {
+	return &NextFreeIPFunction{}
+}
+
+type NextFreeIPFunction struct{}
+
+func (f *NextFreeIPFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "next_free_ip"
+}
+
+func (f *NextFreeIPFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Return the next free IP of a subnet",
+		MarkdownDescription: util.MarkdownDescription(`
+Return the first free IP of a Network subnet, for example to use as ''ip'' of a server ''network'' or as ''ip'' of a ''hcloud_load_balancer_network''.
+
+The network address, the first IP of the subnet reserved for the gateway, the broadcast address and ''172.31.1.1'', which is used as gateway for the public network interface of servers, are never returned.
+
+The constraints specific to ''vswitch'' subnets are not taken into account, the IPs of the dedicated servers connected to the vSwitch must be passed in ''used''.
+`),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "subnet",
+				Description: "IP range of the subnet, for example `10.0.1.0/24`.",
+			},
+			function.ListParameter{
+				Name:        "used",
+				ElementType: types.StringType,
+				Description: "IPs already used in the subnet.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NextFreeIPFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var subnet string
+	var used []string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &subnet, &used))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := nextFreeIP(subnet, used)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,42 @@
+package network_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testmux"
+)
+
+func TestNextFreeIPFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::hcloud::next_free_ip("10.0.1.0/24", ["10.0.1.2"])
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("10.0.1.3")),
+				},
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::hcloud::next_free_ip("10.0.1.0/30", [])
+				}`,
+				ExpectError: regexp.MustCompile(`no free ip left`),
+			},
+		},
+	})
+}
@@ -0,0 +1,68 @@
+package network
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+)
+
+func NewSubnetAllocateFunction() function.Function {
+	return &SubnetAllocateFunction{}
+}
+
+type SubnetAllocateFunction struct{}
+
+func (f *SubnetAllocateFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "subnet_allocate"
+}
+
+func (f *SubnetAllocateFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Allocate a subnet in a Network",
+		MarkdownDescription: util.MarkdownDescription(`
+Allocate the first free subnet of a given prefix length in the IP range of a Network, for example to use as ''ip_range'' of a ''hcloud_network_subnet'' or as ''destination'' of a ''hcloud_network_route''.
+
+The allocated subnet does not overlap with any of the existing subnets or route destinations, nor with ''172.31.1.1'', which is used as gateway for the public network interface of servers.
+
+The constraints specific to ''vswitch'' subnets are not taken into account, the IP ranges already used on the vSwitch must be passed in ''existing''.
+`),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "network_cidr",
+				Description: "IP range of the Network, for example `10.0.0.0/16`.",
+			},
+			function.Int64Parameter{
+				Name:        "prefix",
+				Description: "Prefix length of the subnet to allocate, for example `24`.",
+			},
+			function.ListParameter{
+				Name:        "existing",
+				ElementType: types.StringType,
+				Description: "IP ranges of the existing subnets and route destinations in the Network.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SubnetAllocateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var networkCIDR string
+	var prefix int64
+	var existing []string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &networkCIDR, &prefix, &existing))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := allocateSubnet(networkCIDR, prefix, existing)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
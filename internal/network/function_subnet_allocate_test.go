@@ -0,0 +1,42 @@
+package network_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testmux"
+)
+
+func TestSubnetAllocateFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::hcloud::subnet_allocate("10.0.0.0/16", 24, ["10.0.0.0/24", "10.0.1.0/25"])
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("10.0.2.0/24")),
+				},
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::hcloud::subnet_allocate("10.0.0.0/24", 24, ["10.0.0.0/24"])
+				}`,
+				ExpectError: regexp.MustCompile(`no free subnet with prefix 24 left`),
+			},
+		},
+	})
+}
@@ -0,0 +1,177 @@
+package network
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
+)
+
+type model struct {
+	ID                    types.Int64  `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	IPRange               types.String `tfsdk:"ip_range"`
+	Labels                types.Map    `tfsdk:"labels"`
+	DeleteProtection      types.Bool   `tfsdk:"delete_protection"`
+	ExposeRoutesToVSwitch types.Bool   `tfsdk:"expose_routes_to_vswitch"`
+}
+
+var _ util.ModelFromAPI[*hcloud.Network] = &model{}
+var _ util.ModelToTerraform[types.Object] = &model{}
+
+func (m *model) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                       types.Int64Type,
+		"name":                     types.StringType,
+		"ip_range":                 types.StringType,
+		"labels":                   types.MapType{ElemType: types.StringType},
+		"delete_protection":        types.BoolType,
+		"expose_routes_to_vswitch": types.BoolType,
+	}
+}
+
+func (m *model) FromAPI(ctx context.Context, hc *hcloud.Network) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	m.ID = types.Int64Value(hc.ID)
+	m.Name = types.StringValue(hc.Name)
+	m.IPRange = types.StringValue(hc.IPRange.String())
+
+	m.Labels, newDiags = resourceutil.LabelsMapValueFrom(ctx, hc.Labels)
+	diags.Append(newDiags...)
+
+	m.DeleteProtection = types.BoolValue(hc.Protection.Delete)
+	m.ExposeRoutesToVSwitch = types.BoolValue(hc.ExposeRoutesToVSwitch)
+
+	return diags
+}
+
+func (m *model) ToTerraform(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
+}
+
+// networkSubnet is a subnet of a network, the API does not return the network
+// with the subnet.
+type networkSubnet struct {
+	Network *hcloud.Network
+	Subnet  hcloud.NetworkSubnet
+}
+
+type subnetModel struct {
+	ID          types.String `tfsdk:"id"`
+	NetworkID   types.Int64  `tfsdk:"network_id"`
+	Type        types.String `tfsdk:"type"`
+	NetworkZone types.String `tfsdk:"network_zone"`
+	IPRange     types.String `tfsdk:"ip_range"`
+	Gateway     types.String `tfsdk:"gateway"`
+	VSwitchID   types.Int64  `tfsdk:"vswitch_id"`
+}
+
+type subnetIdentityModel struct {
+	NetworkID types.Int64  `tfsdk:"network_id"`
+	IPRange   types.String `tfsdk:"ip_range"`
+}
+
+func newSubnetIdentity(data subnetModel) subnetIdentityModel {
+	return subnetIdentityModel{
+		NetworkID: data.NetworkID,
+		IPRange:   data.IPRange,
+	}
+}
+
+var _ util.ModelFromAPI[networkSubnet] = &subnetModel{}
+var _ util.ModelToTerraform[types.Object] = &subnetModel{}
+
+func (m *subnetModel) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":           types.StringType,
+		"network_id":   types.Int64Type,
+		"type":         types.StringType,
+		"network_zone": types.StringType,
+		"ip_range":     types.StringType,
+		"gateway":      types.StringType,
+		"vswitch_id":   types.Int64Type,
+	}
+}
+
+func (m *subnetModel) FromAPI(_ context.Context, hc networkSubnet) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(generateNetworkSubnetID(hc.Network, hc.Subnet.IPRange.String()))
+	m.NetworkID = types.Int64Value(hc.Network.ID)
+	m.Type = types.StringValue(string(hc.Subnet.Type))
+	m.NetworkZone = types.StringValue(string(hc.Subnet.NetworkZone))
+	m.IPRange = types.StringValue(hc.Subnet.IPRange.String())
+	m.Gateway = types.StringValue(hc.Subnet.Gateway.String())
+
+	if hc.Subnet.Type == hcloud.NetworkSubnetTypeVSwitch {
+		m.VSwitchID = types.Int64Value(hc.Subnet.VSwitchID)
+	} else {
+		m.VSwitchID = types.Int64Null()
+	}
+
+	return diags
+}
+
+func (m *subnetModel) ToTerraform(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
+}
+
+// networkRoute is a route of a network, the API does not return the network
+// with the route.
+type networkRoute struct {
+	Network *hcloud.Network
+	Route   hcloud.NetworkRoute
+}
+
+type routeModel struct {
+	ID          types.String `tfsdk:"id"`
+	NetworkID   types.Int64  `tfsdk:"network_id"`
+	Destination types.String `tfsdk:"destination"`
+	Gateway     types.String `tfsdk:"gateway"`
+}
+
+type routeIdentityModel struct {
+	NetworkID   types.Int64  `tfsdk:"network_id"`
+	Destination types.String `tfsdk:"destination"`
+}
+
+func newRouteIdentity(data routeModel) routeIdentityModel {
+	return routeIdentityModel{
+		NetworkID:   data.NetworkID,
+		Destination: data.Destination,
+	}
+}
+
+var _ util.ModelFromAPI[networkRoute] = &routeModel{}
+var _ util.ModelToTerraform[types.Object] = &routeModel{}
+
+func (m *routeModel) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":          types.StringType,
+		"network_id":  types.Int64Type,
+		"destination": types.StringType,
+		"gateway":     types.StringType,
+	}
+}
+
+func (m *routeModel) FromAPI(_ context.Context, hc networkRoute) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(generateNetworkRouteID(hc.Network, hc.Route.Destination.String()))
+	m.NetworkID = types.Int64Value(hc.Network.ID)
+	m.Destination = types.StringValue(hc.Route.Destination.String())
+	m.Gateway = types.StringValue(hc.Route.Gateway.String())
+
+	return diags
+}
+
+func (m *routeModel) ToTerraform(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
+}
@@ -0,0 +1,108 @@
+package network
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func mustParseCIDR(t *testing.T, s string) *net.IPNet {
+	t.Helper()
+	_, ipNet, err := net.ParseCIDR(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ipNet
+}
+
+func TestModel(t *testing.T) {
+	ctx := t.Context()
+
+	in := &hcloud.Network{
+		ID:                    42,
+		Name:                  "network",
+		IPRange:               mustParseCIDR(t, "10.0.0.0/16"),
+		Labels:                map[string]string{"key": "value"},
+		Protection:            hcloud.NetworkProtection{Delete: true},
+		ExposeRoutesToVSwitch: true,
+	}
+
+	o := &model{}
+	assert.Nil(t, o.FromAPI(ctx, in))
+	assert.Equal(t, int64(42), o.ID.ValueInt64())
+	assert.Equal(t, "network", o.Name.ValueString())
+	assert.Equal(t, "10.0.0.0/16", o.IPRange.ValueString())
+
+	labels := map[string]string{}
+	assert.Nil(t, o.Labels.ElementsAs(ctx, &labels, false))
+	assert.Equal(t, map[string]string{"key": "value"}, labels)
+
+	assert.Equal(t, true, o.DeleteProtection.ValueBool())
+	assert.Equal(t, true, o.ExposeRoutesToVSwitch.ValueBool())
+}
+
+func TestSubnetModel(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("cloud", func(t *testing.T) {
+		in := networkSubnet{
+			Network: &hcloud.Network{ID: 42},
+			Subnet: hcloud.NetworkSubnet{
+				Type:        hcloud.NetworkSubnetTypeCloud,
+				IPRange:     mustParseCIDR(t, "10.0.1.0/24"),
+				NetworkZone: hcloud.NetworkZoneEUCentral,
+				Gateway:     net.ParseIP("10.0.0.1"),
+			},
+		}
+
+		o := &subnetModel{}
+		assert.Nil(t, o.FromAPI(ctx, in))
+		assert.Equal(t, "42-10.0.1.0/24", o.ID.ValueString())
+		assert.Equal(t, int64(42), o.NetworkID.ValueInt64())
+		assert.Equal(t, "cloud", o.Type.ValueString())
+		assert.Equal(t, "eu-central", o.NetworkZone.ValueString())
+		assert.Equal(t, "10.0.1.0/24", o.IPRange.ValueString())
+		assert.Equal(t, "10.0.0.1", o.Gateway.ValueString())
+		assert.True(t, o.VSwitchID.IsNull())
+	})
+
+	t.Run("vswitch", func(t *testing.T) {
+		in := networkSubnet{
+			Network: &hcloud.Network{ID: 42},
+			Subnet: hcloud.NetworkSubnet{
+				Type:        hcloud.NetworkSubnetTypeVSwitch,
+				IPRange:     mustParseCIDR(t, "10.0.2.0/24"),
+				NetworkZone: hcloud.NetworkZoneEUCentral,
+				Gateway:     net.ParseIP("10.0.0.1"),
+				VSwitchID:   4711,
+			},
+		}
+
+		o := &subnetModel{}
+		assert.Nil(t, o.FromAPI(ctx, in))
+		assert.Equal(t, "vswitch", o.Type.ValueString())
+		assert.Equal(t, int64(4711), o.VSwitchID.ValueInt64())
+	})
+}
+
+func TestRouteModel(t *testing.T) {
+	ctx := t.Context()
+
+	in := networkRoute{
+		Network: &hcloud.Network{ID: 42},
+		Route: hcloud.NetworkRoute{
+			Destination: mustParseCIDR(t, "10.100.1.0/24"),
+			Gateway:     net.ParseIP("10.0.1.1"),
+		},
+	}
+
+	o := &routeModel{}
+	assert.Nil(t, o.FromAPI(ctx, in))
+	assert.Equal(t, "42-10.100.1.0/24", o.ID.ValueString())
+	assert.Equal(t, int64(42), o.NetworkID.ValueInt64())
+	assert.Equal(t, "10.100.1.0/24", o.Destination.ValueString())
+	assert.Equal(t, "10.0.1.1", o.Gateway.ValueString())
+}
@@ -2,245 +2,337 @@ package network
 
 import (
 	"context"
-	"log"
 	"net"
+	"strconv"
 
-	"github.com/hashicorp/go-cty/cty"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/validateutil"
 )
 
 // ResourceType is the type name of the Hetzner Cloud Network resource.
 const ResourceType = "hcloud_network"
 
-// Resource creates a Terraform schema for the hcloud_network resource.
-func Resource() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceNetworkCreate,
-		ReadContext:   resourceNetworkRead,
-		UpdateContext: resourceNetworkUpdate,
-		DeleteContext: resourceNetworkDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+var _ resource.Resource = (*Resource)(nil)
+var _ resource.ResourceWithConfigure = (*Resource)(nil)
+var _ resource.ResourceWithImportState = (*Resource)(nil)
+var _ resource.ResourceWithIdentity = (*Resource)(nil)
+var _ resource.ResourceWithUpgradeState = (*Resource)(nil)
 
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"ip_range": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.IsCIDR,
-			},
-			"labels": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				ValidateDiagFunc: func(i any, path cty.Path) diag.Diagnostics { // nolint:revive
-					if ok, err := hcloud.ValidateResourceLabels(i.(map[string]any)); !ok {
-						return diag.FromErr(err)
-					}
-					return nil
-				},
+type Resource struct {
+	client *hcloud.Client
+}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+func (r *Resource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = ResourceType
+}
+
+func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	r.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.Version = 1
+	resp.Schema.MarkdownDescription = util.MarkdownDescription(`
+Provides a Hetzner Cloud Network to represent a Network in the Hetzner Cloud.
+
+See the [Networks API documentation](https://docs.hetzner.cloud/reference/cloud#tag/networks) for more details.
+`)
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Network.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
 			},
-			"delete_protection": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the Network.",
+			Required:            true,
+		},
+		"ip_range": schema.StringAttribute{
+			MarkdownDescription: "IP range of the Network, must be a private IPv4 range (RFC 1918).",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
 			},
-			"expose_routes_to_vswitch": {
-				Type:        schema.TypeBool,
-				Description: "Enable or disable exposing the routes to the vSwitch connection. The exposing only takes effect if a vSwitch connection is active.",
-				Optional:    true,
-				Default:     false,
+			Validators: []validator.String{
+				validateutil.CIDR(),
 			},
 		},
+		"labels": resourceutil.LabelsSchema(),
+		"delete_protection": schema.BoolAttribute{
+			MarkdownDescription: "Whether delete protection is enabled.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"expose_routes_to_vswitch": schema.BoolAttribute{
+			MarkdownDescription: "Enable or disable exposing the routes to the vSwitch connection. The exposing only takes effect if a vSwitch connection is active.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
 	}
 }
 
-func resourceNetworkCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+func (r *Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceutil.IDIdentitySchema("ID of the Network.")
+}
+
+type resourceModel struct {
+	model
+
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	_, ipRange, err := net.ParseCIDR(d.Get("ip_range").(string))
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
+
+	_, ipRange, err := net.ParseCIDR(data.IPRange.ValueString())
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.AddAttributeError(path.Root("ip_range"), "Invalid IP Range", err.Error())
+		return
 	}
 
 	opts := hcloud.NetworkCreateOpts{
-		Name:                  d.Get("name").(string),
+		Name:                  data.Name.ValueString(),
 		IPRange:               ipRange,
-		ExposeRoutesToVSwitch: d.Get("expose_routes_to_vswitch").(bool),
+		ExposeRoutesToVSwitch: data.ExposeRoutesToVSwitch.ValueBool(),
 	}
-	if labels, ok := d.GetOk("labels"); ok {
-		tmpLabels := make(map[string]string)
-		for k, v := range labels.(map[string]any) {
-			tmpLabels[k] = v.(string)
-		}
-		opts.Labels = tmpLabels
+
+	resp.Diagnostics.Append(hcloudutil.TerraformLabelsToHCloud(ctx, data.Labels, &opts.Labels)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	network, _, err := client.Network.Create(ctx, opts)
+	result, _, err := r.client.Network.Create(ctx, opts)
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
 
-	d.SetId(util.FormatID(network.ID))
+	// Make sure to save the ID immediately so we can recover if the process stops after
+	// this call. Terraform marks the resource as "tainted", so it can be deleted and no
+	// surprise "duplicate resource" errors happen.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(result.ID))...)
 
-	deleteProtection := d.Get("delete_protection").(bool)
-	if deleteProtection {
-		if err := setProtection(ctx, client, network, deleteProtection); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+	if data.DeleteProtection.ValueBool() {
+		resp.Diagnostics.Append(setProtection(ctx, r.client, result, true)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	return resourceNetworkRead(ctx, d, m)
+	// Fetch fresh data from the API
+	in, _, err := r.client.Network.GetByID(ctx, result.ID)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+	if in == nil {
+		resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("network", "id", result.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func resourceNetworkRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	network, _, err := client.Network.Get(ctx, d.Id())
+	in, _, err := r.client.Network.GetByID(ctx, data.ID.ValueInt64())
 	if err != nil {
-		if resourceNetworkIsNotFound(err, d) {
-			return nil
-		}
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+	if in == nil {
+		resp.State.RemoveResource(ctx)
+		return
 	}
-	if network == nil {
-		d.SetId("")
-		return nil
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	setNetworkSchema(d, network)
-	return nil
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func resourceNetworkUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, plan resourceModel
 
-	network, _, err := client.Network.Get(ctx, d.Id())
-	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
-	}
-	if network == nil {
-		d.SetId("")
-		return nil
-	}
-
-	d.Partial(true)
-	if d.HasChange("name") {
-		newName := d.Get("name")
-		_, _, err := client.Network.Update(ctx, network, hcloud.NetworkUpdateOpts{
-			Name: newName.(string),
-		})
-		if err != nil {
-			if resourceNetworkIsNotFound(err, d) {
-				return nil
-			}
-			return hcloudutil.ErrorToDiag(err)
-		}
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if d.HasChange("labels") {
-		labels := d.Get("labels")
-		tmpLabels := make(map[string]string)
-		for k, v := range labels.(map[string]any) {
-			tmpLabels[k] = v.(string)
-		}
-		_, _, err := client.Network.Update(ctx, network, hcloud.NetworkUpdateOpts{
-			Labels: tmpLabels,
-		})
-		if err != nil {
-			if resourceNetworkIsNotFound(err, d) {
-				return nil
-			}
-			return hcloudutil.ErrorToDiag(err)
-		}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if d.HasChange("expose_routes_to_vswitch") {
-		_, _, err := client.Network.Update(ctx, network, hcloud.NetworkUpdateOpts{
-			ExposeRoutesToVSwitch: new(d.Get("expose_routes_to_vswitch").(bool)),
-		})
-		if err != nil {
-			if resourceNetworkIsNotFound(err, d) {
-				return nil
-			}
-			return hcloudutil.ErrorToDiag(err)
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "update", updateTimeout)
+	defer cancel()
+
+	network := &hcloud.Network{ID: data.ID.ValueInt64()}
+
+	// Action: Delete Protection
+	if !plan.DeleteProtection.IsUnknown() && !plan.DeleteProtection.Equal(data.DeleteProtection) {
+		resp.Diagnostics.Append(setProtection(ctx, r.client, network, plan.DeleteProtection.ValueBool())...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	if d.HasChange("delete_protection") {
-		deletionProtection := d.Get("delete_protection").(bool)
-		if err := setProtection(ctx, client, network, deletionProtection); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+	// Update fields on resource
+	opts := hcloud.NetworkUpdateOpts{}
+
+	if !plan.Name.IsUnknown() && !plan.Name.Equal(data.Name) {
+		opts.Name = plan.Name.ValueString()
+	}
+	if !plan.Labels.IsUnknown() && !plan.Labels.Equal(data.Labels) {
+		resp.Diagnostics.Append(hcloudutil.TerraformLabelsToHCloud(ctx, plan.Labels, &opts.Labels)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
+	if !plan.ExposeRoutesToVSwitch.IsUnknown() && !plan.ExposeRoutesToVSwitch.Equal(data.ExposeRoutesToVSwitch) {
+		opts.ExposeRoutesToVSwitch = plan.ExposeRoutesToVSwitch.ValueBoolPointer()
+	}
 
-	d.Partial(false)
+	// Always perform the update call last, even when empty, to populate the state with fresh data returned by
+	// the update.
+	in, _, err := r.client.Network.Update(ctx, network, opts)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	return resourceNetworkRead(ctx, d, m)
+	data.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func resourceNetworkDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resourceModel
 
-	networkID, err := util.ParseID(d.Id())
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	if err != nil {
-		log.Printf("[WARN] invalid network id (%s), removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if _, err := client.Network.Delete(ctx, &hcloud.Network{ID: networkID}); err != nil {
-		if hcloud.IsError(err, hcloud.ErrorCodeNotFound) {
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
+
+	_, err := r.client.Network.Delete(ctx, &hcloud.Network{ID: data.ID.ValueInt64()})
+	if err != nil {
+		if hcloudutil.APIErrorIsNotFound(err) {
 			// network has already been deleted
-			return nil
+			return
 		}
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-
-	return nil
 }
 
-func resourceNetworkIsNotFound(err error, d *schema.ResourceData) bool {
-	if hcloud.IsError(err, hcloud.ErrorCodeNotFound) {
-		log.Printf("[WARN] Network (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return true
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity resourceutil.IDIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		return
 	}
-	return false
-}
 
-func setNetworkSchema(d *schema.ResourceData, n *hcloud.Network) {
-	util.SetSchemaFromAttributes(d, getNetworkAttributes(n))
-}
-
-func getNetworkAttributes(n *hcloud.Network) map[string]any {
-	return map[string]any{
-		"id":                       n.ID,
-		"ip_range":                 n.IPRange.String(),
-		"name":                     n.Name,
-		"labels":                   n.Labels,
-		"delete_protection":        n.Protection.Delete,
-		"expose_routes_to_vswitch": n.ExposeRoutesToVSwitch,
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.Append(util.InvalidImportID("$NETWORK_ID", req.ID))
+		return
 	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
-func setProtection(ctx context.Context, c *hcloud.Client, n *hcloud.Network, deleteProtection bool) error {
+func setProtection(ctx context.Context, c *hcloud.Client, n *hcloud.Network, deleteProtection bool) diag.Diagnostics {
 	action, _, err := c.Network.ChangeProtection(ctx, n,
 		hcloud.NetworkChangeProtectionOpts{
 			Delete: &deleteProtection,
 		},
 	)
 	if err != nil {
-		return err
+		return hcloudutil.APIErrorDiagnostics(err)
 	}
 
-	return c.Action.WaitFor(ctx, action)
+	return hcloudutil.SettleActions(ctx, &c.Action, action)
 }
@@ -4,134 +4,286 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"net"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/control"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/validateutil"
 )
 
 // RouteResourceType is the type name of the Hetzner Cloud Network Route resource.
 const RouteResourceType = "hcloud_network_route"
 
-// RouteResource creates a Terraform schema for the hcloud_network_route
-// resource.
-func RouteResource() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceNetworkRouteCreate,
-		ReadContext:   resourceNetworkRouteRead,
-		DeleteContext: resourceNetworkRouteDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+var _ resource.Resource = (*RouteResource)(nil)
+var _ resource.ResourceWithConfigure = (*RouteResource)(nil)
+var _ resource.ResourceWithImportState = (*RouteResource)(nil)
+var _ resource.ResourceWithIdentity = (*RouteResource)(nil)
+var _ resource.ResourceWithUpgradeState = (*RouteResource)(nil)
+
+type RouteResource struct {
+	client *hcloud.Client
+}
+
+func NewRouteResource() resource.Resource {
+	return &RouteResource{}
+}
+
+func (r *RouteResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = RouteResourceType
+}
+
+func (r *RouteResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	r.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *RouteResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.Version = 1
+	resp.Schema.MarkdownDescription = util.MarkdownDescription(`
+Provides a Hetzner Cloud Network Route to represent a Network Route in the Hetzner Cloud.
+`)
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the Network Route, in the format `<network-id>-<destination>`.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"network_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Network the route should be added to.",
+			Required:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
 		},
-		Schema: map[string]*schema.Schema{
-			"network_id": {
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: true,
+		"destination": schema.StringAttribute{
+			MarkdownDescription: "Destination network or host of this route. Must be a subnet of the ip_range of the Network. Must not overlap with an existing ip_range in any subnets or with any destinations in other routes or with the first ip of the networks ip_range or with 172.31.1.1.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
 			},
-			"destination": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+			Validators: []validator.String{
+				validateutil.CIDR(),
 			},
-			"gateway": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+		},
+		"gateway": schema.StringAttribute{
+			MarkdownDescription: "Gateway for the route. Cannot be the first ip of the networks ip_range and also cannot be 172.31.1.1 as this IP is being used as a gateway for the public network interface of servers.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				validateutil.IP(),
 			},
 		},
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
 	}
 }
 
-func resourceNetworkRouteCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	var action *hcloud.Action
+type routeResourceModel struct {
+	routeModel
 
-	c := m.(*hcloud.Client)
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
 
-	_, destination, err := net.ParseCIDR(d.Get("destination").(string))
-	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+func (r *RouteResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"network_id": identityschema.Int64Attribute{
+				Description:       "ID of the Network.",
+				RequiredForImport: true,
+			},
+			"destination": identityschema.StringAttribute{
+				Description:       "Destination network or host of the Route.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+func (r *RouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data routeResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	gateway := net.ParseIP(d.Get("gateway").(string))
-	if gateway == nil {
-		log.Printf("[WARN] Invalid gateway (%s), removing from state.", gateway)
-		d.SetId("")
-		return nil
+	createTimeout, diags := data.Timeouts.Create(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	networkID := d.Get("network_id")
-	network := &hcloud.Network{ID: util.CastInt64(networkID)}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
+
+	_, destination, err := net.ParseCIDR(data.Destination.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("destination"), "Invalid Destination", err.Error())
+		return
+	}
+
+	network := &hcloud.Network{ID: data.NetworkID.ValueInt64()}
+
 	opts := hcloud.NetworkAddRouteOpts{
 		Route: hcloud.NetworkRoute{
 			Destination: destination,
-			Gateway:     gateway,
+			Gateway:     net.ParseIP(data.Gateway.ValueString()),
 		},
 	}
 
-	err = control.Retry(control.DefaultRetries, func() error {
+	var action *hcloud.Action
+	err = control.Retry(ctx, control.DefaultRetries, func() error {
 		var err error
 
-		action, _, err = c.Network.AddRoute(ctx, network, opts)
+		action, _, err = r.client.Network.AddRoute(ctx, network, opts)
 		if hcloud.IsError(err, hcloud.ErrorCodeConflict) {
 			return err
 		}
 		return control.AbortRetry(err)
 	})
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	d.SetId(generateNetworkRouteID(network, destination.String()))
-	if err = c.Action.WaitFor(ctx, action); err != nil {
-		return hcloudutil.ErrorToDiag(err)
+
+	// Make sure to save the ID immediately so we can recover if the process stops after
+	// this call. Terraform marks the resource as "tainted", so it can be deleted and no
+	// surprise "duplicate resource" errors happen.
+	id := generateNetworkRouteID(network, destination.String())
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, action)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return resourceNetworkRouteRead(ctx, d, m)
+	// Fetch fresh data from the API
+	in, route, err := lookupNetworkRouteID(ctx, id, r.client)
+	if errors.Is(err, errInvalidNetworkRouteID) {
+		resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("network route", "id", id))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, networkRoute{Network: in, Route: route})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newRouteIdentity(data.routeModel))...)
 }
 
-func resourceNetworkRouteRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+func (r *RouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data routeResourceModel
 
-	network, route, err := lookupNetworkRouteID(ctx, d.Id(), client)
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in, route, err := lookupNetworkRouteID(ctx, data.ID.ValueString(), r.client)
 	if errors.Is(err, errInvalidNetworkRouteID) {
-		log.Printf("[WARN] Invalid id (%s), removing from state: %s", d.Id(), err)
-		d.SetId("")
-		return nil
+		tflog.Warn(ctx, "Network route not found, removing from state", map[string]any{"id": data.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
 	}
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	if network == nil {
-		log.Printf("[WARN] Network Route (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, networkRoute{Network: in, Route: route})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newRouteIdentity(data.routeModel))...)
+}
+
+func (r *RouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, plan routeResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	d.SetId(generateNetworkRouteID(network, route.Destination.String()))
-	setNetworkRouteSchema(d, network, route)
-	return nil
+
+	// All other attributes require a replacement of the resource.
+	data.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newRouteIdentity(data.routeModel))...)
 }
 
-func resourceNetworkRouteDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	var action *hcloud.Action
+func (r *RouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data routeResourceModel
 
-	c := m.(*hcloud.Client)
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
 
-	network, route, err := lookupNetworkRouteID(ctx, d.Id(), c)
+	network, route, err := lookupNetworkRouteID(ctx, data.ID.ValueString(), r.client)
+	if errors.Is(err, errInvalidNetworkRouteID) {
+		// network route has already been deleted
+		return
+	}
 	if err != nil {
-		log.Printf("[WARN] Invalid id (%s), removing from state: %s", d.Id(), err)
-		d.SetId("")
-		return nil
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	err = control.Retry(control.DefaultRetries, func() error {
+
+	var action *hcloud.Action
+	err = control.Retry(ctx, control.DefaultRetries, func() error {
 		var err error
 
-		action, _, err = c.Network.DeleteRoute(ctx, network, hcloud.NetworkDeleteRouteOpts{
+		action, _, err = r.client.Network.DeleteRoute(ctx, network, hcloud.NetworkDeleteRouteOpts{
 			Route: route,
 		})
 		if hcloud.IsError(err, hcloud.ErrorCodeConflict) {
@@ -139,25 +291,47 @@ func resourceNetworkRouteDelete(ctx context.Context, d *schema.ResourceData, m a
 		}
 		return control.AbortRetry(err)
 	})
-	if hcloud.IsError(err, hcloud.ErrorCodeNotFound) {
-		// network route has already been deleted
-		return nil
-	}
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		if hcloudutil.APIErrorIsNotFound(err) {
+			// network route has already been deleted
+			return
+		}
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
 
-	if err = c.Action.WaitFor(ctx, action); err != nil {
-		return hcloudutil.ErrorToDiag(err)
-	}
-	return nil
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, action)...)
 }
 
-func setNetworkRouteSchema(d *schema.ResourceData, n *hcloud.Network, s hcloud.NetworkRoute) {
-	d.SetId(generateNetworkRouteID(n, s.Destination.String()))
-	d.Set("network_id", n.ID)
-	d.Set("destination", s.Destination.String())
-	d.Set("gateway", s.Gateway.String())
+func (r *RouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity routeIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id := generateNetworkRouteID(&hcloud.Network{ID: identity.NetworkID.ValueInt64()}, identity.Destination.ValueString())
+		if _, _, err := parseNetworkRouteID(id); err != nil {
+			resp.Diagnostics.Append(util.InvalidImportID("$NETWORK_ID-$DESTINATION", id))
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
+	}
+
+	networkID, destination, err := parseNetworkRouteID(req.ID)
+	if err != nil {
+		resp.Diagnostics.Append(util.InvalidImportID("$NETWORK_ID-$DESTINATION", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, routeIdentityModel{
+		NetworkID:   types.Int64Value(networkID),
+		Destination: types.StringValue(destination.String()),
+	})...)
 }
 
 func generateNetworkRouteID(network *hcloud.Network, destination string) string {
@@ -166,46 +340,51 @@ func generateNetworkRouteID(network *hcloud.Network, destination string) string
 
 var errInvalidNetworkRouteID = errors.New("invalid network route id")
 
-// lookupNetworkRouteID parses the terraform network route record id and return the network and route
-//
-// id format: <network id>-<ip range>
-// Examples:
-// 123-192.168.100.1/32 (network route of network 123 with the destination 192.168.100.1/32)
-func lookupNetworkRouteID(ctx context.Context, terraformID string, client *hcloud.Client) (network *hcloud.Network, route hcloud.NetworkRoute, err error) {
-	if terraformID == "" {
-		err = errInvalidNetworkRouteID
-		return
+func parseNetworkRouteID(s string) (int64, *net.IPNet, error) {
+	if s == "" {
+		return 0, nil, errInvalidNetworkRouteID
 	}
-	parts := strings.SplitN(terraformID, "-", 2)
+	parts := strings.SplitN(s, "-", 2)
 	if len(parts) != 2 {
-		err = errInvalidNetworkRouteID
-		return
+		return 0, nil, errInvalidNetworkRouteID
 	}
 
 	networkID, err := util.ParseID(parts[0])
 	if err != nil {
-		err = errInvalidNetworkRouteID
-		return
+		return 0, nil, errInvalidNetworkRouteID
 	}
 
 	_, destination, err := net.ParseCIDR(parts[1])
 	if destination == nil || err != nil {
-		err = errInvalidNetworkRouteID
-		return
+		return 0, nil, errInvalidNetworkRouteID
 	}
 
-	network, _, err = client.Network.GetByID(ctx, networkID)
+	return networkID, destination, nil
+}
+
+// lookupNetworkRouteID parses the terraform network route record id and return the network and route
+//
+// id format: <network id>-<ip range>
+// Examples:
+// 123-192.168.100.1/32 (network route of network 123 with the destination 192.168.100.1/32)
+func lookupNetworkRouteID(ctx context.Context, terraformID string, client *hcloud.Client) (*hcloud.Network, hcloud.NetworkRoute, error) {
+	networkID, destination, err := parseNetworkRouteID(terraformID)
+	if err != nil {
+		return nil, hcloud.NetworkRoute{}, err
+	}
+
+	network, _, err := client.Network.GetByID(ctx, networkID)
+	if err != nil {
+		return nil, hcloud.NetworkRoute{}, err
+	}
 	if network == nil {
-		err = errInvalidNetworkRouteID
-		return
+		return nil, hcloud.NetworkRoute{}, errInvalidNetworkRouteID
 	}
 
 	for _, r := range network.Routes {
 		if r.Destination.String() == destination.String() {
-			route = r
-			return
+			return network, r, nil
 		}
 	}
-	err = errInvalidNetworkRouteID
-	return
+	return nil, hcloud.NetworkRoute{}, errInvalidNetworkRouteID
 }
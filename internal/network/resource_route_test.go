@@ -5,7 +5,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/network"
@@ -60,3 +63,91 @@ func TestAccNetworkRouteResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccNetworkRouteResource_Identity(t *testing.T) {
+	var nw hcloud.Network
+
+	resNetwork := &network.RData{
+		Name:    "network-test-route-identity",
+		IPRange: "10.0.0.0/16",
+	}
+	resNetwork.SetRName("network-route-identity")
+	res := &network.RDataRoute{
+		NetworkID:   resNetwork.TFID() + ".id",
+		Destination: "10.100.1.0/24",
+		Gateway:     "10.0.1.1",
+	}
+	res.SetRName("network-route-identity")
+	tmplMan := testtemplate.Manager{}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		CheckDestroy:             testsupport.CheckResourcesDestroyed(network.ResourceType, network.ByID(t, &nw)),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_network", resNetwork,
+					"testdata/r/hcloud_network_route", res,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testsupport.CheckResourceExists(resNetwork.TFID(), network.ByID(t, &nw)),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState(res.TFID(), tfjsonpath.New("network_id")),
+					statecheck.ExpectIdentityValueMatchesState(res.TFID(), tfjsonpath.New("destination")),
+				},
+			},
+			{
+				// Import the Resource using its identity.
+				ResourceName:    res.TFID(),
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccNetworkRouteResource_UpgradePluginFramework(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	resNetwork := &network.RData{
+		Name:    "network-route-upgrade",
+		IPRange: "10.0.0.0/16",
+	}
+	resNetwork.SetRName("network-route-upgrade")
+	res := &network.RDataRoute{
+		NetworkID:   resNetwork.TFID() + ".id",
+		Destination: "10.100.1.0/24",
+		Gateway:     "10.0.1.1",
+	}
+	res.SetRName("network-route-upgrade")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: teste2e.PreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"hcloud": {
+						VersionConstraint: "1.66.1",
+						Source:            "hetznercloud/hcloud",
+					},
+				},
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_network", resNetwork,
+					"testdata/r/hcloud_network_route", res,
+				),
+			},
+			{
+				ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_network", resNetwork,
+					"testdata/r/hcloud_network_route", res,
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
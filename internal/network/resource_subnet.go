@@ -4,102 +4,199 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"net"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/control"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/validateutil"
 )
 
 // SubnetResourceType is the type name of the Hetzner Cloud Network Subnet resource.
 const SubnetResourceType = "hcloud_network_subnet"
 
-// SubnetResource creates a Terraform schema for the hcloud_network_subnet
-// resource.
-func SubnetResource() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceNetworkSubnetCreate,
-		ReadContext:   resourceNetworkSubnetRead,
-		DeleteContext: resourceNetworkSubnetDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+var _ resource.Resource = (*SubnetResource)(nil)
+var _ resource.ResourceWithConfigure = (*SubnetResource)(nil)
+var _ resource.ResourceWithImportState = (*SubnetResource)(nil)
+var _ resource.ResourceWithIdentity = (*SubnetResource)(nil)
+var _ resource.ResourceWithUpgradeState = (*SubnetResource)(nil)
+
+type SubnetResource struct {
+	client *hcloud.Client
+}
+
+func NewSubnetResource() resource.Resource {
+	return &SubnetResource{}
+}
+
+func (r *SubnetResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = SubnetResourceType
+}
+
+func (r *SubnetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	r.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *SubnetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.Version = 1
+	resp.Schema.MarkdownDescription = util.MarkdownDescription(`
+Provides a Hetzner Cloud Network Subnet to represent a Subnet in the Hetzner Cloud.
+`)
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the Network Subnet, in the format `<network-id>-<ip-range>`.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
 		},
-		Schema: map[string]*schema.Schema{
-			"network_id": {
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: true,
+		"network_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Network the Subnet should be added to.",
+			Required:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
 			},
-			"type": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					"cloud",
-					"server",
-					"vswitch",
-				}, false),
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "Type of Subnet. `server`, `cloud` or `vswitch`.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
 			},
-			"network_zone": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					string(hcloud.NetworkSubnetTypeCloud),
+					string(hcloud.NetworkSubnetTypeServer),
+					string(hcloud.NetworkSubnetTypeVSwitch),
+				),
 			},
-			"ip_range": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+		},
+		"network_zone": schema.StringAttribute{
+			MarkdownDescription: "Name of the Network Zone.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
 			},
-			"gateway": {
-				Type:     schema.TypeString,
-				Computed: true,
+		},
+		"ip_range": schema.StringAttribute{
+			MarkdownDescription: "Range to allocate IPs from. Must be a subnet of the ip_range of the Network and must not overlap with any other subnets or with any destinations in routes.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				validateutil.CIDR(),
 			},
-			"vswitch_id": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				ForceNew: true,
+		},
+		"gateway": schema.StringAttribute{
+			MarkdownDescription: "Gateway of the Subnet.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"vswitch_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the vSwitch, required when the type is `vswitch`.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
 	}
 }
 
-func resourceNetworkSubnetCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	var action *hcloud.Action
+type subnetResourceModel struct {
+	subnetModel
+
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *SubnetResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"network_id": identityschema.Int64Attribute{
+				Description:       "ID of the Network.",
+				RequiredForImport: true,
+			},
+			"ip_range": identityschema.StringAttribute{
+				Description:       "IP range of the Subnet.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
 
-	c := m.(*hcloud.Client)
+func (r *SubnetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data subnetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	_, ipRange, err := net.ParseCIDR(d.Get("ip_range").(string))
+	createTimeout, diags := data.Timeouts.Create(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
+
+	_, ipRange, err := net.ParseCIDR(data.IPRange.ValueString())
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.AddAttributeError(path.Root("ip_range"), "Invalid IP Range", err.Error())
+		return
 	}
-	networkID := d.Get("network_id")
-	network := &hcloud.Network{ID: util.CastInt64(networkID)}
 
-	subnetType := hcloud.NetworkSubnetType(d.Get("type").(string))
+	network := &hcloud.Network{ID: data.NetworkID.ValueInt64()}
+
 	opts := hcloud.NetworkAddSubnetOpts{
 		Subnet: hcloud.NetworkSubnet{
 			IPRange:     ipRange,
-			NetworkZone: hcloud.NetworkZone(d.Get("network_zone").(string)),
-			Type:        subnetType,
+			NetworkZone: hcloud.NetworkZone(data.NetworkZone.ValueString()),
+			Type:        hcloud.NetworkSubnetType(data.Type.ValueString()),
 		},
 	}
-
-	if subnetType == hcloud.NetworkSubnetTypeVSwitch {
-		vSwitchID := d.Get("vswitch_id")
-		opts.Subnet.VSwitchID = util.CastInt64(vSwitchID)
+	if opts.Subnet.Type == hcloud.NetworkSubnetTypeVSwitch {
+		opts.Subnet.VSwitchID = data.VSwitchID.ValueInt64()
 	}
 
-	err = control.Retry(control.DefaultRetries, func() error {
+	var action *hcloud.Action
+	err = control.Retry(ctx, control.DefaultRetries, func() error {
 		var err error
 
-		action, _, err = c.Network.AddSubnet(ctx, network, opts)
+		action, _, err = r.client.Network.AddSubnet(ctx, network, opts)
 		if hcloud.IsError(err, hcloud.ErrorCodeConflict) {
 			return err
 		}
@@ -109,99 +206,176 @@ func resourceNetworkSubnetCreate(ctx context.Context, d *schema.ResourceData, m
 		return control.AbortRetry(err)
 	})
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	// Make sure to save the ID immediately so we can recover if the process stops after
+	// this call. Terraform marks the resource as "tainted", so it can be deleted and no
+	// surprise "duplicate resource" errors happen.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), generateNetworkSubnetID(network, ipRange.String()))...)
+
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, action)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	d.SetId(generateNetworkSubnetID(network, ipRange.String()))
 
-	if err = c.Action.WaitFor(ctx, action); err != nil {
-		return hcloudutil.ErrorToDiag(err)
+	// Fetch fresh data from the API
+	in, subnet, err := lookupNetworkSubnetID(ctx, generateNetworkSubnetID(network, ipRange.String()), r.client)
+	if err != nil && !errors.Is(err, errInvalidNetworkSubnetID) {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+	if in == nil {
+		resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("network subnet", "ip_range", ipRange.String()))
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, networkSubnet{Network: in, Subnet: subnet})...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return resourceNetworkSubnetRead(ctx, d, m)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newSubnetIdentity(data.subnetModel))...)
 }
 
-func resourceNetworkSubnetRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+func (r *SubnetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data subnetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	network, subnet, err := lookupNetworkSubnetID(ctx, d.Id(), client)
+	in, subnet, err := lookupNetworkSubnetID(ctx, data.ID.ValueString(), r.client)
 	if errors.Is(err, errInvalidNetworkSubnetID) {
-		log.Printf("[WARN] Invalid id (%s), removing from state: %s", d.Id(), err)
-		d.SetId("")
-		return nil
+		tflog.Warn(ctx, "Invalid network subnet id, removing from state", map[string]any{"id": data.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
 	}
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	if network == nil {
-		log.Printf("[WARN] Network Subnet (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
+	if in == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, networkSubnet{Network: in, Subnet: subnet})...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	d.SetId(generateNetworkSubnetID(network, subnet.IPRange.String()))
-	setNetworkSubnetSchema(d, network, subnet)
-	return nil
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newSubnetIdentity(data.subnetModel))...)
 }
 
-func resourceNetworkSubnetDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	var (
-		action  *hcloud.Action
-		network *hcloud.Network
-	)
+func (r *SubnetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, plan subnetResourceModel
 
-	c := m.(*hcloud.Client)
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	err := control.Retry(control.DefaultRetries*10, func() error {
-		var (
-			subnet hcloud.NetworkSubnet
-			err    error
-		)
+	// All other attributes require a replacement of the resource.
+	data.Timeouts = plan.Timeouts
 
-		network, subnet, err = lookupNetworkSubnetID(ctx, d.Id(), c)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newSubnetIdentity(data.subnetModel))...)
+}
+
+func (r *SubnetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data subnetResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
+
+	var action *hcloud.Action
+	err := control.Retry(ctx, control.DefaultRetries*10, func() error {
+		network, subnet, err := lookupNetworkSubnetID(ctx, data.ID.ValueString(), r.client)
 		if err != nil {
 			return control.AbortRetry(err)
 		}
+		if network == nil {
+			return control.AbortRetry(errInvalidNetworkSubnetID)
+		}
 
-		action, _, err = c.Network.DeleteSubnet(ctx, network, hcloud.NetworkDeleteSubnetOpts{
+		action, _, err = r.client.Network.DeleteSubnet(ctx, network, hcloud.NetworkDeleteSubnetOpts{
 			Subnet: subnet,
 		})
 		if hcloud.IsError(err, hcloud.ErrorCodeConflict) || hcloud.IsError(err, hcloud.ErrorCodeLocked) {
 			return err
 		}
-		if hcloud.IsError(err, hcloud.ErrorCodeServiceError) &&
-			(strings.Contains(err.Error(), "servers are attached") || strings.Contains(err.Error(), "network has attached resources")) {
+		if isSubnetResourcesAttachedError(err) {
 			return err
 		}
 		return control.AbortRetry(err)
 	})
-	if hcloud.IsError(err, hcloud.ErrorCodeNotFound) || errors.Is(err, errInvalidNetworkSubnetID) {
-		d.SetId("")
-		return nil
+	if hcloudutil.APIErrorIsNotFound(err) || errors.Is(err, errInvalidNetworkSubnetID) {
+		// network subnet has already been deleted
+		return
 	}
-	if hcloud.IsError(err, hcloud.ErrorCodeServiceError) &&
-		(strings.Contains(err.Error(), "servers are attached") || strings.Contains(err.Error(), "network has attached resources")) {
-		log.Printf("[WARN] Network Subnet (%s) has still resources attached. We assume that the network will be deleted fully, removing from state", d.Id())
-		d.SetId("")
-		return nil
+	if isSubnetResourcesAttachedError(err) {
+		tflog.Warn(ctx, "Network subnet has still resources attached, we assume that the network will be deleted fully, removing from state", map[string]any{"id": data.ID.ValueString()})
+		return
 	}
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
-	}
-	if err = c.Action.WaitFor(ctx, action); err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	return nil
+
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, action)...)
 }
 
-func setNetworkSubnetSchema(d *schema.ResourceData, n *hcloud.Network, s hcloud.NetworkSubnet) {
-	d.SetId(generateNetworkSubnetID(n, s.IPRange.String()))
-	d.Set("network_id", n.ID)
-	d.Set("network_zone", s.NetworkZone)
-	d.Set("ip_range", s.IPRange.String())
-	d.Set("type", s.Type)
-	d.Set("gateway", s.Gateway.String())
-	if s.Type == hcloud.NetworkSubnetTypeVSwitch {
-		d.Set("vswitch_id", s.VSwitchID)
+func (r *SubnetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity subnetIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id := generateNetworkSubnetID(&hcloud.Network{ID: identity.NetworkID.ValueInt64()}, identity.IPRange.ValueString())
+		if _, _, err := ParseSubnetID(id); err != nil {
+			resp.Diagnostics.Append(util.InvalidImportID("$NETWORK_ID-$IP_RANGE", id))
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+		return
 	}
+
+	networkID, ipRange, err := ParseSubnetID(req.ID)
+	if err != nil {
+		resp.Diagnostics.Append(util.InvalidImportID("$NETWORK_ID-$IP_RANGE", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, subnetIdentityModel{
+		NetworkID: types.Int64Value(networkID),
+		IPRange:   types.StringValue(ipRange.String()),
+	})...)
+}
+
+func isSubnetResourcesAttachedError(err error) bool {
+	return hcloud.IsError(err, hcloud.ErrorCodeServiceError) &&
+		(strings.Contains(err.Error(), "servers are attached") || strings.Contains(err.Error(), "network has attached resources"))
 }
 
 func generateNetworkSubnetID(network *hcloud.Network, ipRange string) string {
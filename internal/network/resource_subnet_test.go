@@ -5,7 +5,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/network"
@@ -64,6 +67,53 @@ func TestAccNetworkSubnetResource(t *testing.T) {
 	})
 }
 
+func TestAccNetworkSubnetResource_Identity(t *testing.T) {
+	var nw hcloud.Network
+
+	resNetwork := &network.RData{
+		Name:    "network-test-subnet-identity",
+		IPRange: "10.0.0.0/16",
+	}
+	resNetwork.SetRName("network-subnet-identity")
+	res := &network.RDataSubnet{
+		Type:        "cloud",
+		NetworkID:   resNetwork.TFID() + ".id",
+		NetworkZone: "eu-central",
+		IPRange:     "10.0.0.0/24",
+	}
+	res.SetRName("network-subnet-identity")
+	tmplMan := testtemplate.Manager{}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		CheckDestroy:             testsupport.CheckResourcesDestroyed(network.ResourceType, network.ByID(t, &nw)),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_network", resNetwork,
+					"testdata/r/hcloud_network_subnet", res,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testsupport.CheckResourceExists(resNetwork.TFID(), network.ByID(t, &nw)),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState(res.TFID(), tfjsonpath.New("network_id")),
+					statecheck.ExpectIdentityValueMatchesState(res.TFID(), tfjsonpath.New("ip_range")),
+				},
+			},
+			{
+				// Import the Resource using its identity.
+				ResourceName:    res.TFID(),
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func TestAccNetworkSubnetResource_VSwitch(t *testing.T) {
 	t.Skip("No VSwitch available in test account")
 
@@ -107,3 +157,46 @@ func TestAccNetworkSubnetResource_VSwitch(t *testing.T) {
 		},
 	})
 }
+
+func TestAccNetworkSubnetResource_UpgradePluginFramework(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	resNetwork := &network.RData{
+		Name:    "network-subnet-upgrade",
+		IPRange: "10.0.0.0/16",
+	}
+	resNetwork.SetRName("network-subnet-upgrade")
+	res := &network.RDataSubnet{
+		Type:        "cloud",
+		NetworkID:   resNetwork.TFID() + ".id",
+		NetworkZone: "eu-central",
+		IPRange:     "10.0.0.0/24",
+	}
+	res.SetRName("network-subnet-upgrade")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: teste2e.PreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"hcloud": {
+						VersionConstraint: "1.66.1",
+						Source:            "hetznercloud/hcloud",
+					},
+				},
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_network", resNetwork,
+					"testdata/r/hcloud_network_subnet", res,
+				),
+			},
+			{
+				ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_network", resNetwork,
+					"testdata/r/hcloud_network_subnet", res,
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
@@ -221,3 +221,39 @@ func TestAccNetworkResource_ExposeRouteToVSwitch(t *testing.T) {
 		},
 	})
 }
+
+func TestAccNetworkResource_UpgradePluginFramework(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	res := &network.RData{
+		Name:    "network-upgrade",
+		IPRange: "10.0.0.0/16",
+		Labels: map[string]string{
+			"key": "value",
+		},
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: teste2e.PreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"hcloud": {
+						VersionConstraint: "1.66.1",
+						Source:            "hetznercloud/hcloud",
+					},
+				},
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_network", res,
+				),
+			},
+			{
+				ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_network", res,
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,197 @@
+package network
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
+)
+
+// modelV0 is the state of the network resource, as stored by the SDKv2
+// implementation of the resource.
+type modelV0 struct {
+	ID                    types.String `tfsdk:"id"`
+	Name                  types.String `tfsdk:"name"`
+	IPRange               types.String `tfsdk:"ip_range"`
+	Labels                types.Map    `tfsdk:"labels"`
+	DeleteProtection      types.Bool   `tfsdk:"delete_protection"`
+	ExposeRoutesToVSwitch types.Bool   `tfsdk:"expose_routes_to_vswitch"`
+	Project               types.String `tfsdk:"project"`
+}
+
+func (r *Resource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                       schema.StringAttribute{Computed: true},
+					"name":                     schema.StringAttribute{Required: true},
+					"ip_range":                 schema.StringAttribute{Required: true},
+					"labels":                   schema.MapAttribute{ElementType: types.StringType, Optional: true},
+					"delete_protection":        schema.BoolAttribute{Optional: true},
+					"expose_routes_to_vswitch": schema.BoolAttribute{Optional: true},
+					"project":                  schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: upgradeStateV0,
+		},
+	}
+}
+
+func upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior modelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := util.ParseID(prior.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Network ID", err.Error())
+		return
+	}
+
+	data := resourceModel{
+		model: model{
+			ID:                    types.Int64Value(id),
+			Name:                  prior.Name,
+			IPRange:               prior.IPRange,
+			Labels:                prior.Labels,
+			DeleteProtection:      prior.DeleteProtection,
+			ExposeRoutesToVSwitch: prior.ExposeRoutesToVSwitch,
+		},
+		Project:  prior.Project,
+		Timeouts: resourceutil.TimeoutsNull(),
+	}
+
+	// The SDKv2 stored the zero values for unset attributes.
+	if data.Labels.IsNull() {
+		data.Labels = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+	if data.DeleteProtection.IsNull() {
+		data.DeleteProtection = types.BoolValue(false)
+	}
+	if data.ExposeRoutesToVSwitch.IsNull() {
+		data.ExposeRoutesToVSwitch = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// subnetModelV0 is the state of the network subnet resource, as stored by the
+// SDKv2 implementation of the resource.
+type subnetModelV0 struct {
+	ID          types.String `tfsdk:"id"`
+	NetworkID   types.Int64  `tfsdk:"network_id"`
+	Type        types.String `tfsdk:"type"`
+	NetworkZone types.String `tfsdk:"network_zone"`
+	IPRange     types.String `tfsdk:"ip_range"`
+	Gateway     types.String `tfsdk:"gateway"`
+	VSwitchID   types.Int64  `tfsdk:"vswitch_id"`
+	Project     types.String `tfsdk:"project"`
+}
+
+func (r *SubnetResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":           schema.StringAttribute{Computed: true},
+					"network_id":   schema.Int64Attribute{Required: true},
+					"type":         schema.StringAttribute{Required: true},
+					"network_zone": schema.StringAttribute{Required: true},
+					"ip_range":     schema.StringAttribute{Required: true},
+					"gateway":      schema.StringAttribute{Computed: true},
+					"vswitch_id":   schema.Int64Attribute{Optional: true},
+					"project":      schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: upgradeSubnetStateV0,
+		},
+	}
+}
+
+func upgradeSubnetStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior subnetModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := subnetResourceModel{
+		subnetModel: subnetModel{
+			ID:          prior.ID,
+			NetworkID:   prior.NetworkID,
+			Type:        prior.Type,
+			NetworkZone: prior.NetworkZone,
+			IPRange:     prior.IPRange,
+			Gateway:     prior.Gateway,
+			VSwitchID:   prior.VSwitchID,
+		},
+		Project:  prior.Project,
+		Timeouts: resourceutil.TimeoutsNull(),
+	}
+
+	// The SDKv2 stored the zero values for unset attributes.
+	if data.VSwitchID.ValueInt64() == 0 {
+		data.VSwitchID = types.Int64Null()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// routeModelV0 is the state of the network route resource, as stored by the
+// SDKv2 implementation of the resource.
+type routeModelV0 struct {
+	ID          types.String `tfsdk:"id"`
+	NetworkID   types.Int64  `tfsdk:"network_id"`
+	Destination types.String `tfsdk:"destination"`
+	Gateway     types.String `tfsdk:"gateway"`
+	Project     types.String `tfsdk:"project"`
+}
+
+func (r *RouteResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":          schema.StringAttribute{Computed: true},
+					"network_id":  schema.Int64Attribute{Required: true},
+					"destination": schema.StringAttribute{Required: true},
+					"gateway":     schema.StringAttribute{Required: true},
+					"project":     schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: upgradeRouteStateV0,
+		},
+	}
+}
+
+func upgradeRouteStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior routeModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data := routeResourceModel{
+		routeModel: routeModel{
+			ID:          prior.ID,
+			NetworkID:   prior.NetworkID,
+			Destination: prior.Destination,
+			Gateway:     prior.Gateway,
+		},
+		Project:  prior.Project,
+		Timeouts: resourceutil.TimeoutsNull(),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
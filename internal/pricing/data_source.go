@@ -0,0 +1,192 @@
+package pricing
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/datasourceutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+)
+
+// DataSourceType is the type name of the Hetzner Cloud pricing datasource.
+const DataSourceType = "hcloud_pricing"
+
+var _ datasource.DataSource = (*DataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*DataSource)(nil)
+
+type DataSource struct {
+	client *hcloud.Client
+}
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+func (d *DataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = DataSourceType
+}
+
+func (d *DataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	d.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func priceSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"net": schema.StringAttribute{
+				MarkdownDescription: "Price without VAT.",
+				Computed:            true,
+			},
+			"gross": schema.StringAttribute{
+				MarkdownDescription: "Price with VAT.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func resourceTypePricingSchema(resource string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "Prices of the " + resource + "s, per Location.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.Int64Attribute{
+					MarkdownDescription: "ID of the " + resource + ".",
+					Computed:            true,
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Name of the " + resource + ".",
+					Computed:            true,
+				},
+				"prices": schema.ListNestedAttribute{
+					MarkdownDescription: "Prices of the " + resource + " in each Location.",
+					Computed:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"location": schema.StringAttribute{
+								MarkdownDescription: "Name of the Location.",
+								Computed:            true,
+							},
+							"price_hourly":  priceSchema("Hourly price."),
+							"price_monthly": priceSchema("Monthly price."),
+							"included_traffic": schema.Int64Attribute{
+								MarkdownDescription: "Free traffic per month in bytes.",
+								Computed:            true,
+							},
+							"price_per_tb_traffic": priceSchema("Price per additional TB of traffic."),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ipPricingSchema(resource string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "Prices of the " + resource + "s, per type and Location.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					MarkdownDescription: "Type of the " + resource + ", `ipv4` or `ipv6`.",
+					Computed:            true,
+				},
+				"prices": schema.ListNestedAttribute{
+					MarkdownDescription: "Prices of the " + resource + " in each Location.",
+					Computed:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"location": schema.StringAttribute{
+								MarkdownDescription: "Name of the Location.",
+								Computed:            true,
+							},
+							"price_hourly":  priceSchema("Hourly price. Null if the " + resource + " is only billed monthly."),
+							"price_monthly": priceSchema("Monthly price."),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = `
+Provides the prices of the Hetzner Cloud resources, for example to estimate the costs of a plan.
+
+The prices are strings with the precision returned by the API, and are expressed in the ''currency'' of the project.
+
+See the [Pricing API documentation](https://docs.hetzner.cloud/reference/cloud#pricing) for more details.
+`
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"project": datasourceutil.ProjectAttribute(),
+		"currency": schema.StringAttribute{
+			MarkdownDescription: "Currency of the prices, for example `EUR`.",
+			Computed:            true,
+		},
+		"vat_rate": schema.StringAttribute{
+			MarkdownDescription: "VAT rate used to compute the gross prices, in percent.",
+			Computed:            true,
+		},
+		"server_types":              resourceTypePricingSchema("Server Type"),
+		"load_balancer_types":       resourceTypePricingSchema("Load Balancer Type"),
+		"primary_ips":               ipPricingSchema("Primary IP"),
+		"floating_ips":              ipPricingSchema("Floating IP"),
+		"volume_price_per_gb_month": priceSchema("Monthly price per GB of Volume."),
+		"image_price_per_gb_month":  priceSchema("Monthly price per GB of Image (snapshots and backups)."),
+		"server_backup_percentage": schema.StringAttribute{
+			MarkdownDescription: "Price of the Server backups, in percent of the Server price.",
+			Computed:            true,
+		},
+	}
+}
+
+type dataSourceModel struct {
+	model
+
+	ID      types.String `tfsdk:"id"`
+	Project types.String `tfsdk:"project"`
+}
+
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data dataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, _, err := d.client.Pricing.Get(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue("pricing")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,48 @@
+package pricing_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/pricing"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/teste2e"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testmux"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testtemplate"
+)
+
+func TestAccPricingDataSource(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	data := &pricing.DData{}
+	data.SetRName("main")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t,
+					"testdata/d/hcloud_pricing", data,
+				),
+
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(data.TFID(), tfjsonpath.New("currency"), knownvalue.StringExact("EUR")),
+					statecheck.ExpectKnownValue(data.TFID(), tfjsonpath.New("vat_rate"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(data.TFID(), tfjsonpath.New("server_types").AtSliceIndex(0).AtMapKey("name"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(data.TFID(), tfjsonpath.New("server_types").AtSliceIndex(0).AtMapKey("prices").AtSliceIndex(0).AtMapKey("location"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(data.TFID(), tfjsonpath.New("server_types").AtSliceIndex(0).AtMapKey("prices").AtSliceIndex(0).AtMapKey("price_monthly").AtMapKey("gross"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(data.TFID(), tfjsonpath.New("load_balancer_types").AtSliceIndex(0).AtMapKey("name"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(data.TFID(), tfjsonpath.New("primary_ips").AtSliceIndex(0).AtMapKey("prices").AtSliceIndex(0).AtMapKey("price_hourly"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(data.TFID(), tfjsonpath.New("floating_ips").AtSliceIndex(0).AtMapKey("prices").AtSliceIndex(0).AtMapKey("price_hourly"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(data.TFID(), tfjsonpath.New("volume_price_per_gb_month").AtMapKey("net"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(data.TFID(), tfjsonpath.New("image_price_per_gb_month").AtMapKey("net"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(data.TFID(), tfjsonpath.New("server_backup_percentage"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,261 @@
+package pricing
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+)
+
+type model struct {
+	Currency               types.String `tfsdk:"currency"`
+	VATRate                types.String `tfsdk:"vat_rate"`
+	ServerTypes            types.List   `tfsdk:"server_types"`
+	LoadBalancerTypes      types.List   `tfsdk:"load_balancer_types"`
+	PrimaryIPs             types.List   `tfsdk:"primary_ips"`
+	FloatingIPs            types.List   `tfsdk:"floating_ips"`
+	VolumePerGBMonth       types.Object `tfsdk:"volume_price_per_gb_month"`
+	ImagePerGBMonth        types.Object `tfsdk:"image_price_per_gb_month"`
+	ServerBackupPercentage types.String `tfsdk:"server_backup_percentage"`
+}
+
+var _ util.ModelFromAPI[hcloud.Pricing] = &model{}
+
+func (m *model) FromAPI(ctx context.Context, hc hcloud.Pricing) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	m.Currency = types.StringValue(hc.Currency)
+	m.VATRate = types.StringValue(hc.VATRate)
+
+	{
+		tfItems := make([]attr.Value, 0, len(hc.ServerTypes))
+		for _, item := range hc.ServerTypes {
+			prices := make([]hcloudResourceLocationPrice, 0, len(item.Pricings))
+			for _, p := range item.Pricings {
+				prices = append(prices, hcloudResourceLocationPrice{p.Location, p.Hourly, p.Monthly, p.IncludedTraffic, p.PerTBTraffic})
+			}
+			tfItem, newDiags := newResourceTypePricing(ctx, item.ServerType.ID, item.ServerType.Name, prices)
+			diags.Append(newDiags...)
+			tfItems = append(tfItems, tfItem)
+		}
+		m.ServerTypes, newDiags = types.ListValue((&resourceTypePricingModel{}).tfType(), tfItems)
+		diags.Append(newDiags...)
+	}
+
+	{
+		tfItems := make([]attr.Value, 0, len(hc.LoadBalancerTypes))
+		for _, item := range hc.LoadBalancerTypes {
+			prices := make([]hcloudResourceLocationPrice, 0, len(item.Pricings))
+			for _, p := range item.Pricings {
+				prices = append(prices, hcloudResourceLocationPrice{p.Location, p.Hourly, p.Monthly, p.IncludedTraffic, p.PerTBTraffic})
+			}
+			tfItem, newDiags := newResourceTypePricing(ctx, item.LoadBalancerType.ID, item.LoadBalancerType.Name, prices)
+			diags.Append(newDiags...)
+			tfItems = append(tfItems, tfItem)
+		}
+		m.LoadBalancerTypes, newDiags = types.ListValue((&resourceTypePricingModel{}).tfType(), tfItems)
+		diags.Append(newDiags...)
+	}
+
+	{
+		tfItems := make([]attr.Value, 0, len(hc.PrimaryIPs))
+		for _, item := range hc.PrimaryIPs {
+			tfPrices := make([]attr.Value, 0, len(item.Pricings))
+			for _, p := range item.Pricings {
+				value := ipLocationPriceModel{
+					Location:     types.StringValue(p.Location),
+					PriceHourly:  newPrice(p.Hourly.Net, p.Hourly.Gross),
+					PriceMonthly: newPrice(p.Monthly.Net, p.Monthly.Gross),
+				}
+				tfPrice, newDiags := types.ObjectValueFrom(ctx, value.tfAttributesTypes(), value)
+				diags.Append(newDiags...)
+				tfPrices = append(tfPrices, tfPrice)
+			}
+			tfItem, newDiags := newIPPricing(ctx, item.Type, tfPrices)
+			diags.Append(newDiags...)
+			tfItems = append(tfItems, tfItem)
+		}
+		m.PrimaryIPs, newDiags = types.ListValue((&ipPricingModel{}).tfType(), tfItems)
+		diags.Append(newDiags...)
+	}
+
+	{
+		tfItems := make([]attr.Value, 0, len(hc.FloatingIPs))
+		for _, item := range hc.FloatingIPs {
+			tfPrices := make([]attr.Value, 0, len(item.Pricings))
+			for _, p := range item.Pricings {
+				value := ipLocationPriceModel{
+					Location: types.StringValue(p.Location.Name),
+					// Floating IPs are billed monthly.
+					PriceHourly:  types.ObjectNull(priceAttrTypes),
+					PriceMonthly: newPrice(p.Monthly.Net, p.Monthly.Gross),
+				}
+				tfPrice, newDiags := types.ObjectValueFrom(ctx, value.tfAttributesTypes(), value)
+				diags.Append(newDiags...)
+				tfPrices = append(tfPrices, tfPrice)
+			}
+			tfItem, newDiags := newIPPricing(ctx, string(item.Type), tfPrices)
+			diags.Append(newDiags...)
+			tfItems = append(tfItems, tfItem)
+		}
+		m.FloatingIPs, newDiags = types.ListValue((&ipPricingModel{}).tfType(), tfItems)
+		diags.Append(newDiags...)
+	}
+
+	m.VolumePerGBMonth = newPrice(hc.Volume.PerGBMonthly.Net, hc.Volume.PerGBMonthly.Gross)
+	m.ImagePerGBMonth = newPrice(hc.Image.PerGBMonth.Net, hc.Image.PerGBMonth.Gross)
+	m.ServerBackupPercentage = types.StringValue(hc.ServerBackup.Percentage)
+
+	return diags
+}
+
+var priceAttrTypes = map[string]attr.Type{
+	"net":   types.StringType,
+	"gross": types.StringType,
+}
+
+// newPrice returns a price object. The values are kept as strings, as returned
+// by the API, to not lose any precision.
+func newPrice(net, gross string) types.Object {
+	return types.ObjectValueMust(priceAttrTypes, map[string]attr.Value{
+		"net":   types.StringValue(net),
+		"gross": types.StringValue(gross),
+	})
+}
+
+// hcloudResourceLocationPrice holds the fields shared by the server type and
+// load balancer type location prices.
+type hcloudResourceLocationPrice struct {
+	Location        *hcloud.Location
+	Hourly          hcloud.Price
+	Monthly         hcloud.Price
+	IncludedTraffic uint64
+	PerTBTraffic    hcloud.Price
+}
+
+type resourceTypePricingModel struct {
+	ID     types.Int64  `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Prices types.List   `tfsdk:"prices"`
+}
+
+func (m *resourceTypePricingModel) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":     types.Int64Type,
+		"name":   types.StringType,
+		"prices": types.ListType{ElemType: (&resourceLocationPriceModel{}).tfType()},
+	}
+}
+
+func (m *resourceTypePricingModel) tfType() attr.Type {
+	return basetypes.ObjectType{AttrTypes: m.tfAttributesTypes()}
+}
+
+func newResourceTypePricing(ctx context.Context, id int64, name string, in []hcloudResourceLocationPrice) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	tfPrices := make([]attr.Value, 0, len(in))
+	for _, p := range in {
+		value := resourceLocationPriceModel{
+			Location:          types.StringValue(p.Location.Name),
+			PriceHourly:       newPrice(p.Hourly.Net, p.Hourly.Gross),
+			PriceMonthly:      newPrice(p.Monthly.Net, p.Monthly.Gross),
+			IncludedTraffic:   types.Int64Value(int64(p.IncludedTraffic)), // nolint: gosec
+			PricePerTBTraffic: newPrice(p.PerTBTraffic.Net, p.PerTBTraffic.Gross),
+		}
+		tfPrice, newDiags := types.ObjectValueFrom(ctx, value.tfAttributesTypes(), value)
+		diags.Append(newDiags...)
+		tfPrices = append(tfPrices, tfPrice)
+	}
+
+	value := resourceTypePricingModel{
+		ID:   types.Int64Value(id),
+		Name: types.StringValue(name),
+	}
+	value.Prices, newDiags = types.ListValue((&resourceLocationPriceModel{}).tfType(), tfPrices)
+	diags.Append(newDiags...)
+
+	tfItem, newDiags := types.ObjectValueFrom(ctx, value.tfAttributesTypes(), value)
+	diags.Append(newDiags...)
+
+	return tfItem, diags
+}
+
+type resourceLocationPriceModel struct {
+	Location          types.String `tfsdk:"location"`
+	PriceHourly       types.Object `tfsdk:"price_hourly"`
+	PriceMonthly      types.Object `tfsdk:"price_monthly"`
+	IncludedTraffic   types.Int64  `tfsdk:"included_traffic"`
+	PricePerTBTraffic types.Object `tfsdk:"price_per_tb_traffic"`
+}
+
+func (m *resourceLocationPriceModel) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"location":             types.StringType,
+		"price_hourly":         types.ObjectType{AttrTypes: priceAttrTypes},
+		"price_monthly":        types.ObjectType{AttrTypes: priceAttrTypes},
+		"included_traffic":     types.Int64Type,
+		"price_per_tb_traffic": types.ObjectType{AttrTypes: priceAttrTypes},
+	}
+}
+
+func (m *resourceLocationPriceModel) tfType() attr.Type {
+	return basetypes.ObjectType{AttrTypes: m.tfAttributesTypes()}
+}
+
+type ipPricingModel struct {
+	Type   types.String `tfsdk:"type"`
+	Prices types.List   `tfsdk:"prices"`
+}
+
+func (m *ipPricingModel) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"type":   types.StringType,
+		"prices": types.ListType{ElemType: (&ipLocationPriceModel{}).tfType()},
+	}
+}
+
+func (m *ipPricingModel) tfType() attr.Type {
+	return basetypes.ObjectType{AttrTypes: m.tfAttributesTypes()}
+}
+
+func newIPPricing(ctx context.Context, ipType string, tfPrices []attr.Value) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	value := ipPricingModel{
+		Type: types.StringValue(ipType),
+	}
+	value.Prices, newDiags = types.ListValue((&ipLocationPriceModel{}).tfType(), tfPrices)
+	diags.Append(newDiags...)
+
+	tfItem, newDiags := types.ObjectValueFrom(ctx, value.tfAttributesTypes(), value)
+	diags.Append(newDiags...)
+
+	return tfItem, diags
+}
+
+type ipLocationPriceModel struct {
+	Location     types.String `tfsdk:"location"`
+	PriceHourly  types.Object `tfsdk:"price_hourly"`
+	PriceMonthly types.Object `tfsdk:"price_monthly"`
+}
+
+func (m *ipLocationPriceModel) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"location":      types.StringType,
+		"price_hourly":  types.ObjectType{AttrTypes: priceAttrTypes},
+		"price_monthly": types.ObjectType{AttrTypes: priceAttrTypes},
+	}
+}
+
+func (m *ipLocationPriceModel) tfType() attr.Type {
+	return basetypes.ObjectType{AttrTypes: m.tfAttributesTypes()}
+}
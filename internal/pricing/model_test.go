@@ -0,0 +1,89 @@
+package pricing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func TestModel(t *testing.T) {
+	ctx := t.Context()
+
+	price := func(net, gross string) hcloud.Price {
+		return hcloud.Price{Currency: "EUR", VATRate: "19.00", Net: net, Gross: gross}
+	}
+	fsn1 := &hcloud.Location{ID: 1, Name: "fsn1"}
+
+	in := hcloud.Pricing{
+		Currency: "EUR",
+		VATRate:  "19.00",
+		Image:    hcloud.ImagePricing{PerGBMonth: price("0.0110", "0.0131")},
+		Volume:   hcloud.VolumePricing{PerGBMonthly: price("0.0440", "0.0524")},
+		ServerBackup: hcloud.ServerBackupPricing{
+			Percentage: "20.00",
+		},
+		ServerTypes: []hcloud.ServerTypePricing{{
+			ServerType: &hcloud.ServerType{ID: 109, Name: "cpx22"},
+			Pricings: []hcloud.ServerTypeLocationPricing{{
+				Location:        fsn1,
+				Hourly:          price("0.0104", "0.0124"),
+				Monthly:         price("6.4900", "7.7231"),
+				IncludedTraffic: 21990232555520,
+				PerTBTraffic:    price("1.0000", "1.1900"),
+			}},
+		}},
+		LoadBalancerTypes: []hcloud.LoadBalancerTypePricing{{
+			LoadBalancerType: &hcloud.LoadBalancerType{ID: 1, Name: "lb11"},
+			Pricings: []hcloud.LoadBalancerTypeLocationPricing{{
+				Location:        fsn1,
+				Hourly:          price("0.0090", "0.0107"),
+				Monthly:         price("5.3900", "6.4141"),
+				IncludedTraffic: 21990232555520,
+				PerTBTraffic:    price("1.0000", "1.1900"),
+			}},
+		}},
+		PrimaryIPs: []hcloud.PrimaryIPPricing{{
+			Type: "ipv4",
+			Pricings: []hcloud.PrimaryIPTypePricing{{
+				Location: "fsn1",
+				Hourly:   hcloud.PrimaryIPPrice{Net: "0.0008", Gross: "0.0010"},
+				Monthly:  hcloud.PrimaryIPPrice{Net: "0.5000", Gross: "0.5950"},
+			}},
+		}},
+		FloatingIPs: []hcloud.FloatingIPTypePricing{{
+			Type: hcloud.FloatingIPTypeIPv4,
+			Pricings: []hcloud.FloatingIPTypeLocationPricing{{
+				Location: fsn1,
+				Monthly:  price("3.0000", "3.5700"),
+			}},
+		}},
+	}
+
+	o := &model{}
+	assert.Nil(t, o.FromAPI(ctx, in))
+
+	assert.Equal(t, "EUR", o.Currency.ValueString())
+	assert.Equal(t, "19.00", o.VATRate.ValueString())
+	assert.Equal(t, "20.00", o.ServerBackupPercentage.ValueString())
+	assert.Equal(t, `{"gross":"0.0524","net":"0.0440"}`, o.VolumePerGBMonth.String())
+	assert.Equal(t, `{"gross":"0.0131","net":"0.0110"}`, o.ImagePerGBMonth.String())
+
+	assert.Equal(t,
+		`[{"id":109,"name":"cpx22","prices":[{"included_traffic":21990232555520,"location":"fsn1","price_hourly":{"gross":"0.0124","net":"0.0104"},"price_monthly":{"gross":"7.7231","net":"6.4900"},"price_per_tb_traffic":{"gross":"1.1900","net":"1.0000"}}]}]`,
+		o.ServerTypes.String(),
+	)
+	assert.Equal(t,
+		`[{"id":1,"name":"lb11","prices":[{"included_traffic":21990232555520,"location":"fsn1","price_hourly":{"gross":"0.0107","net":"0.0090"},"price_monthly":{"gross":"6.4141","net":"5.3900"},"price_per_tb_traffic":{"gross":"1.1900","net":"1.0000"}}]}]`,
+		o.LoadBalancerTypes.String(),
+	)
+	assert.Equal(t,
+		`[{"prices":[{"location":"fsn1","price_hourly":{"gross":"0.0010","net":"0.0008"},"price_monthly":{"gross":"0.5950","net":"0.5000"}}],"type":"ipv4"}]`,
+		o.PrimaryIPs.String(),
+	)
+	assert.Equal(t,
+		`[{"prices":[{"location":"fsn1","price_hourly":<null>,"price_monthly":{"gross":"3.5700","net":"3.0000"}}],"type":"ipv4"}]`,
+		o.FloatingIPs.String(),
+	)
+}
@@ -0,0 +1,18 @@
+package pricing
+
+import (
+	"fmt"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testtemplate"
+)
+
+// DData defines the fields for the "testdata/d/hcloud_pricing"
+// template.
+type DData struct {
+	testtemplate.DataCommon
+}
+
+// TFID returns the data source identifier.
+func (d *DData) TFID() string {
+	return fmt.Sprintf("data.%s.%s", DataSourceType, d.RName())
+}
@@ -122,6 +122,7 @@ See our [deprecation](https://docs.hetzner.cloud/changelog#2025-12-16-phasing-ou
 			MarkdownDescription: "Filter results using a [Label Selector](https://docs.hetzner.cloud/reference/cloud#label-selector).",
 			Optional:            true,
 		},
+		"project": datasourceutil.ProjectAttribute(),
 	})
 }
 
@@ -129,6 +130,7 @@ type dataSourceModel struct {
 	model
 
 	WithSelector types.String `tfsdk:"with_selector"`
+	Project      types.String `tfsdk:"project"`
 }
 
 var _ util.ModelFromAPI[*hcloud.PrimaryIP] = &dataSourceModel{}
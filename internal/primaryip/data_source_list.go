@@ -73,6 +73,7 @@ See our [deprecation](https://docs.hetzner.cloud/changelog#2025-12-16-phasing-ou
 			MarkdownDescription: "Filter results using a [Label Selector](https://docs.hetzner.cloud/reference/cloud#label-selector)",
 			Optional:            true,
 		},
+		"project": datasourceutil.ProjectAttribute(),
 	}
 }
 
@@ -81,6 +82,7 @@ type dataSourceListModel struct {
 	PrimaryIPs types.List   `tfsdk:"primary_ips"`
 
 	WithSelector types.String `tfsdk:"with_selector"`
+	Project      types.String `tfsdk:"project"`
 }
 
 var _ util.ModelFromAPI[[]*hcloud.PrimaryIP] = &dataSourceListModel{}
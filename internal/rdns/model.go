@@ -2,9 +2,11 @@ package rdns
 
 import (
 	"context"
+	"fmt"
 	"net"
 
 	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -19,6 +21,9 @@ type model struct {
 	LoadBalancerID types.Int64       `tfsdk:"load_balancer_id"`
 	IPAddress      iptypes.IPAddress `tfsdk:"ip_address"`
 	DNSPtr         types.String      `tfsdk:"dns_ptr"`
+
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *model) FromAPI(_ context.Context, rdns hcloud.RDNSSupporter, ip net.IP, dnsPtr string) diag.Diagnostics {
@@ -47,3 +52,50 @@ func (m *model) FromAPI(_ context.Context, rdns hcloud.RDNSSupporter, ip net.IP,
 
 	return diags
 }
+
+type identityModel struct {
+	ServerID       types.Int64  `tfsdk:"server_id"`
+	PrimaryIPID    types.Int64  `tfsdk:"primary_ip_id"`
+	FloatingIPID   types.Int64  `tfsdk:"floating_ip_id"`
+	LoadBalancerID types.Int64  `tfsdk:"load_balancer_id"`
+	IPAddress      types.String `tfsdk:"ip_address"`
+}
+
+func newIdentity(m model) identityModel {
+	return identityModel{
+		ServerID:       m.ServerID,
+		PrimaryIPID:    m.PrimaryIPID,
+		FloatingIPID:   m.FloatingIPID,
+		LoadBalancerID: m.LoadBalancerID,
+		IPAddress:      types.StringValue(m.IPAddress.ValueString()),
+	}
+}
+
+// ToAPI returns the resource and IP address identified by the identity.
+func (i *identityModel) ToAPI() (hcloud.RDNSSupporter, net.IP, error) {
+	var rdns []hcloud.RDNSSupporter
+
+	if !i.ServerID.IsNull() {
+		rdns = append(rdns, &hcloud.Server{ID: i.ServerID.ValueInt64()})
+	}
+	if !i.PrimaryIPID.IsNull() {
+		rdns = append(rdns, &hcloud.PrimaryIP{ID: i.PrimaryIPID.ValueInt64()})
+	}
+	if !i.FloatingIPID.IsNull() {
+		rdns = append(rdns, &hcloud.FloatingIP{ID: i.FloatingIPID.ValueInt64()})
+	}
+	if !i.LoadBalancerID.IsNull() {
+		rdns = append(rdns, &hcloud.LoadBalancer{ID: i.LoadBalancerID.ValueInt64()})
+	}
+
+	if len(rdns) != 1 {
+		return nil, nil, fmt.Errorf("exactly one of server_id, primary_ip_id, floating_ip_id or load_balancer_id must be set")
+	}
+
+	ip := net.ParseIP(i.IPAddress.ValueString())
+	if ip == nil {
+		return nil, nil, fmt.Errorf("invalid ip_address: %s", i.IPAddress.ValueString())
+	}
+
+	return rdns[0], ip, nil
+}
@@ -5,6 +5,7 @@ import (
 	"net"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
@@ -91,3 +92,35 @@ func TestModel(t *testing.T) {
 	})
 
 }
+
+func TestIdentityModel(t *testing.T) {
+	ip := net.ParseIP("203.0.113.10")
+
+	t.Run("round trip", func(t *testing.T) {
+		ctx := context.Background()
+
+		o := &model{}
+		assert.Nil(t, o.FromAPI(ctx, &hcloud.LoadBalancer{ID: 1234}, ip, "host.example.org"))
+
+		identity := newIdentity(*o)
+		assert.Equal(t, int64(1234), identity.LoadBalancerID.ValueInt64())
+		assert.Equal(t, "203.0.113.10", identity.IPAddress.ValueString())
+
+		rdns, rdnsIP, err := identity.ToAPI()
+		assert.NoError(t, err)
+		assert.Equal(t, "l-1234-203.0.113.10", FormatID(rdns, rdnsIP))
+	})
+
+	t.Run("missing resource", func(t *testing.T) {
+		identity := identityModel{
+			ServerID:       types.Int64Null(),
+			PrimaryIPID:    types.Int64Null(),
+			FloatingIPID:   types.Int64Null(),
+			LoadBalancerID: types.Int64Null(),
+			IPAddress:      types.StringValue("203.0.113.10"),
+		}
+
+		_, _, err := identity.ToAPI()
+		assert.EqualError(t, err, "exactly one of server_id, primary_ip_id, floating_ip_id or load_balancer_id must be set")
+	})
+}
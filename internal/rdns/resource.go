@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -19,6 +20,7 @@ import (
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/validateutil"
 )
 
@@ -27,6 +29,7 @@ const ResourceType = "hcloud_rdns"
 var _ resource.Resource = (*Resource)(nil)
 var _ resource.ResourceWithConfigure = (*Resource)(nil)
 var _ resource.ResourceWithImportState = (*Resource)(nil)
+var _ resource.ResourceWithIdentity = (*Resource)(nil)
 var _ resource.ResourceWithConfigValidators = (*Resource)(nil)
 
 type Resource struct {
@@ -51,7 +54,7 @@ func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, r
 	}
 }
 
-func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema.MarkdownDescription = `
 Provides Hetzner Cloud reverse DNS (rDNS) entries for Servers, Primary IPs, Floating IPs or Load Balancers.
 `
@@ -106,6 +109,38 @@ Provides Hetzner Cloud reverse DNS (rDNS) entries for Servers, Primary IPs, Floa
 			MarkdownDescription: "Domain name `ip_address` should point to.",
 			Required:            true,
 		},
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
+	}
+}
+
+func (r *Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"server_id": identityschema.Int64Attribute{
+				Description:       "ID of the Server.",
+				OptionalForImport: true,
+			},
+			"primary_ip_id": identityschema.Int64Attribute{
+				Description:       "ID of the Primary IP.",
+				OptionalForImport: true,
+			},
+			"floating_ip_id": identityschema.Int64Attribute{
+				Description:       "ID of the Floating IP.",
+				OptionalForImport: true,
+			},
+			"load_balancer_id": identityschema.Int64Attribute{
+				Description:       "ID of the Load Balancer.",
+				OptionalForImport: true,
+			},
+			"ip_address": identityschema.StringAttribute{
+				Description:       "IP address of the reverse DNS entry.",
+				RequiredForImport: true,
+			},
+		},
 	}
 }
 
@@ -128,6 +163,15 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
+
 	ip := net.ParseIP(data.IPAddress.ValueString())
 	if ip == nil {
 		resp.Diagnostics.AddAttributeError(
@@ -212,6 +256,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newIdentity(data))...)
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -295,6 +340,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newIdentity(data))...)
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -306,6 +352,15 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "update", updateTimeout)
+	defer cancel()
+
 	rdns, ip, err := ParseID(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(
@@ -335,7 +390,10 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
+	data.Timeouts = plan.Timeouts
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newIdentity(data))...)
 }
 
 func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -346,6 +404,15 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
+
 	rdns, ip, err := ParseID(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(
@@ -373,5 +440,22 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 }
 
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity identityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		rdns, ip, err := identity.ToAPI()
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid import identity", util.TitleCase(err.Error()))
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), FormatID(rdns, ip))...)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -2,112 +2,438 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"maps"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/actionutil"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
 )
 
 const (
-	PoweronActionType  = "hcloud_server_poweron"
-	PoweroffActionType = "hcloud_server_poweroff"
-	RebootActionType   = "hcloud_server_reboot"
-	ResetActionType    = "hcloud_server_reset"
+	PoweronActionType        = "hcloud_server_poweron"
+	PoweroffActionType       = "hcloud_server_poweroff"
+	RebootActionType         = "hcloud_server_reboot"
+	ResetActionType          = "hcloud_server_reset"
+	RebuildActionType        = "hcloud_server_rebuild"
+	EnableRescueActionType   = "hcloud_server_enable_rescue"
+	DisableRescueActionType  = "hcloud_server_disable_rescue"
+	AttachISOActionType      = "hcloud_server_attach_iso"
+	DetachISOActionType      = "hcloud_server_detach_iso"
+	CreateImageActionType    = "hcloud_server_create_image"
+	ChangeTypeActionType     = "hcloud_server_change_type"
+	RequestConsoleActionType = "hcloud_server_request_console"
 )
 
-var _ action.Action = (*serverAction)(nil)
-var _ action.ActionWithConfigure = (*serverAction)(nil)
+var _ action.Action = (*serverAction[serverActionData])(nil)
+var _ action.ActionWithConfigure = (*serverAction[serverActionData])(nil)
 
 type serverActionData struct {
-	ServerID types.Int64 `tfsdk:"server_id"`
+	ServerID types.Int64  `tfsdk:"server_id"`
+	Project  types.String `tfsdk:"project"`
 }
 
-type serverActionInvoke func(ctx context.Context, client *hcloud.Client, server *hcloud.Server) (*hcloud.Action, error)
+func (d serverActionData) serverID() int64 {
+	return d.ServerID.ValueInt64()
+}
+
+// serverActionModel is implemented by the data of the server actions, the
+// server action data must be embedded in the data of each action.
+type serverActionModel interface {
+	serverID() int64
+}
+
+// serverActionInvoke runs the action on the server and returns the API action
+// to wait for. The returned message is reported to the user once the API action
+// completed.
+type serverActionInvoke[T serverActionModel] func(ctx context.Context, client *hcloud.Client, server *hcloud.Server, data T) (*hcloud.Action, string, error)
 
-type serverAction struct {
+type serverAction[T serverActionModel] struct {
 	client              *hcloud.Client
 	typeName            string
 	markdownDescription string
-	invoke              serverActionInvoke
+	attributes          map[string]actionschema.Attribute
+	invoke              serverActionInvoke[T]
 }
 
 func NewPoweronAction() action.Action {
-	return &serverAction{
+	return &serverAction[serverActionData]{
 		typeName: PoweronActionType,
 		markdownDescription: util.MarkdownDescription(`
 Power on a server in Hetzner Cloud.
 
 See the [Power on a Server documentation](https://docs.hetzner.cloud/reference/cloud#tag/server-actions/poweron_server) for more details.
 `),
-		invoke: func(ctx context.Context, client *hcloud.Client, server *hcloud.Server) (*hcloud.Action, error) {
+		invoke: func(ctx context.Context, client *hcloud.Client, server *hcloud.Server, _ serverActionData) (*hcloud.Action, string, error) {
 			apiAction, _, err := client.Server.Poweron(ctx, server)
-			return apiAction, err
+			return apiAction, "", err
 		},
 	}
 }
 
 func NewPoweroffAction() action.Action {
-	return &serverAction{
+	return &serverAction[serverActionData]{
 		typeName: PoweroffActionType,
 		markdownDescription: util.MarkdownDescription(`
 Power off a server in Hetzner Cloud.
 
 See the [Power off a Server documentation](https://docs.hetzner.cloud/reference/cloud#tag/server-actions/poweroff_server) for more details.
 `),
-		invoke: func(ctx context.Context, client *hcloud.Client, server *hcloud.Server) (*hcloud.Action, error) {
+		invoke: func(ctx context.Context, client *hcloud.Client, server *hcloud.Server, _ serverActionData) (*hcloud.Action, string, error) {
 			apiAction, _, err := client.Server.Poweroff(ctx, server)
-			return apiAction, err
+			return apiAction, "", err
 		},
 	}
 }
 
 func NewRebootAction() action.Action {
-	return &serverAction{
+	return &serverAction[serverActionData]{
 		typeName: RebootActionType,
 		markdownDescription: util.MarkdownDescription(`
 Reboot a server in Hetzner Cloud.
 
 See the [Soft-reboot a Server documentation](https://docs.hetzner.cloud/reference/cloud#tag/server-actions/reboot_server) for more details.
 `),
-		invoke: func(ctx context.Context, client *hcloud.Client, server *hcloud.Server) (*hcloud.Action, error) {
+		invoke: func(ctx context.Context, client *hcloud.Client, server *hcloud.Server, _ serverActionData) (*hcloud.Action, string, error) {
 			apiAction, _, err := client.Server.Reboot(ctx, server)
-			return apiAction, err
+			return apiAction, "", err
 		},
 	}
 }
 
 func NewResetAction() action.Action {
-	return &serverAction{
+	return &serverAction[serverActionData]{
 		typeName: ResetActionType,
 		markdownDescription: util.MarkdownDescription(`
 Reset a server in Hetzner Cloud.
 
 See the [Reset a Server documentation](https://docs.hetzner.cloud/reference/cloud#tag/server-actions/reset_server) for more details.
 `),
-		invoke: func(ctx context.Context, client *hcloud.Client, server *hcloud.Server) (*hcloud.Action, error) {
+		invoke: func(ctx context.Context, client *hcloud.Client, server *hcloud.Server, _ serverActionData) (*hcloud.Action, string, error) {
 			apiAction, _, err := client.Server.Reset(ctx, server)
-			return apiAction, err
+			return apiAction, "", err
+		},
+	}
+}
+
+type serverRebuildActionData struct {
+	serverActionData
+	Image    types.String `tfsdk:"image"`
+	UserData types.String `tfsdk:"user_data"`
+}
+
+func NewRebuildAction() action.Action {
+	return &serverAction[serverRebuildActionData]{
+		typeName: RebuildActionType,
+		markdownDescription: util.MarkdownDescription(`
+Rebuild a server in Hetzner Cloud from an image. All the data on the server disk will be lost.
+
+If the server has no SSH keys, the new root password is reported once the server was rebuilt.
+
+See the [Rebuild a Server from an Image documentation](https://docs.hetzner.cloud/reference/cloud#tag/server-actions/rebuild_server) for more details.
+`),
+		attributes: map[string]actionschema.Attribute{
+			"image": actionschema.StringAttribute{
+				MarkdownDescription: "ID or name of the Image to rebuild the server from.",
+				Required:            true,
+			},
+			"user_data": actionschema.StringAttribute{
+				MarkdownDescription: "Cloud-Init user data to use during the server rebuild.",
+				Optional:            true,
+			},
+		},
+		invoke: func(ctx context.Context, client *hcloud.Client, server *hcloud.Server, data serverRebuildActionData) (*hcloud.Action, string, error) {
+			opts := hcloud.ServerRebuildOpts{}
+
+			id, name := idOrName(data.Image.ValueString())
+			opts.Image = &hcloud.Image{ID: id, Name: name}
+
+			if !data.UserData.IsNull() {
+				opts.UserData = data.UserData.ValueStringPointer()
+			}
+
+			result, _, err := client.Server.RebuildWithResult(ctx, server, opts)
+			if err != nil {
+				return nil, "", err
+			}
+
+			message := ""
+			if result.RootPassword != "" {
+				message = fmt.Sprintf("Server %d rebuilt, root password: %s", server.ID, result.RootPassword)
+			}
+
+			return result.Action, message, nil
+		},
+	}
+}
+
+type serverEnableRescueActionData struct {
+	serverActionData
+	Type    types.String `tfsdk:"type"`
+	SSHKeys types.List   `tfsdk:"ssh_keys"`
+}
+
+func NewEnableRescueAction() action.Action {
+	return &serverAction[serverEnableRescueActionData]{
+		typeName: EnableRescueActionType,
+		markdownDescription: util.MarkdownDescription(`
+Enable the rescue system for a server in Hetzner Cloud. The server must be rebooted or reset to boot into the rescue system.
+
+If no SSH keys are given, the root password of the rescue system is reported once the rescue system was enabled.
+
+See the [Enable Rescue Mode for a Server documentation](https://docs.hetzner.cloud/reference/cloud#tag/server-actions/enable_rescue_mode_for_server) for more details.
+`),
+		attributes: map[string]actionschema.Attribute{
+			"type": actionschema.StringAttribute{
+				MarkdownDescription: "Type of the rescue system. Defaults to `linux64`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(hcloud.ServerRescueTypeLinux64)),
+				},
+			},
+			"ssh_keys": actionschema.ListAttribute{
+				MarkdownDescription: "SSH key IDs or names which should be injected into the rescue system.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+		invoke: func(ctx context.Context, client *hcloud.Client, server *hcloud.Server, data serverEnableRescueActionData) (*hcloud.Action, string, error) {
+			opts := hcloud.ServerEnableRescueOpts{
+				Type: hcloud.ServerRescueTypeLinux64,
+			}
+
+			if !data.Type.IsNull() {
+				opts.Type = hcloud.ServerRescueType(data.Type.ValueString())
+			}
+
+			sshKeys, err := getSSHKeys(ctx, client, data.SSHKeys)
+			if err != nil {
+				return nil, "", err
+			}
+			opts.SSHKeys = sshKeys
+
+			result, _, err := client.Server.EnableRescue(ctx, server, opts)
+			if err != nil {
+				return nil, "", err
+			}
+
+			message := ""
+			if result.RootPassword != "" {
+				message = fmt.Sprintf("Rescue system enabled for server %d, root password: %s", server.ID, result.RootPassword)
+			}
+
+			return result.Action, message, nil
+		},
+	}
+}
+
+func NewDisableRescueAction() action.Action {
+	return &serverAction[serverActionData]{
+		typeName: DisableRescueActionType,
+		markdownDescription: util.MarkdownDescription(`
+Disable the rescue system for a server in Hetzner Cloud.
+
+See the [Disable Rescue Mode for a Server documentation](https://docs.hetzner.cloud/reference/cloud#tag/server-actions/disable_rescue_mode_for_server) for more details.
+`),
+		invoke: func(ctx context.Context, client *hcloud.Client, server *hcloud.Server, _ serverActionData) (*hcloud.Action, string, error) {
+			apiAction, _, err := client.Server.DisableRescue(ctx, server)
+			return apiAction, "", err
+		},
+	}
+}
+
+type serverAttachISOActionData struct {
+	serverActionData
+	ISO types.String `tfsdk:"iso"`
+}
+
+func NewAttachISOAction() action.Action {
+	return &serverAction[serverAttachISOActionData]{
+		typeName: AttachISOActionType,
+		markdownDescription: util.MarkdownDescription(`
+Attach an ISO to a server in Hetzner Cloud.
+
+See the [Attach an ISO to a Server documentation](https://docs.hetzner.cloud/reference/cloud#tag/server-actions/attach_iso_to_server) for more details.
+`),
+		attributes: map[string]actionschema.Attribute{
+			"iso": actionschema.StringAttribute{
+				MarkdownDescription: "ID or name of the ISO to attach to the server.",
+				Required:            true,
+			},
+		},
+		invoke: func(ctx context.Context, client *hcloud.Client, server *hcloud.Server, data serverAttachISOActionData) (*hcloud.Action, string, error) {
+			id, name := idOrName(data.ISO.ValueString())
+
+			apiAction, _, err := client.Server.AttachISO(ctx, server, &hcloud.ISO{ID: id, Name: name})
+			return apiAction, "", err
+		},
+	}
+}
+
+func NewDetachISOAction() action.Action {
+	return &serverAction[serverActionData]{
+		typeName: DetachISOActionType,
+		markdownDescription: util.MarkdownDescription(`
+Detach the ISO from a server in Hetzner Cloud.
+
+See the [Detach an ISO from a Server documentation](https://docs.hetzner.cloud/reference/cloud#tag/server-actions/detach_iso_from_server) for more details.
+`),
+		invoke: func(ctx context.Context, client *hcloud.Client, server *hcloud.Server, _ serverActionData) (*hcloud.Action, string, error) {
+			apiAction, _, err := client.Server.DetachISO(ctx, server)
+			return apiAction, "", err
+		},
+	}
+}
+
+type serverCreateImageActionData struct {
+	serverActionData
+	Type        types.String `tfsdk:"type"`
+	Description types.String `tfsdk:"description"`
+	Labels      types.Map    `tfsdk:"labels"`
+}
+
+func NewCreateImageAction() action.Action {
+	return &serverAction[serverCreateImageActionData]{
+		typeName: CreateImageActionType,
+		markdownDescription: util.MarkdownDescription(`
+Create an Image from a server in Hetzner Cloud.
+
+The ID of the created Image is reported once the Image was created. The created Image is not managed by Terraform.
+
+See the [Create Image from a Server documentation](https://docs.hetzner.cloud/reference/cloud#tag/server-actions/create_image) for more details.
+`),
+		attributes: map[string]actionschema.Attribute{
+			"type": actionschema.StringAttribute{
+				MarkdownDescription: "Type of the Image to create, `snapshot` or `backup`. Defaults to `snapshot`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(hcloud.ImageTypeSnapshot), string(hcloud.ImageTypeBackup)),
+				},
+			},
+			"description": actionschema.StringAttribute{
+				MarkdownDescription: "Description of the Image.",
+				Optional:            true,
+			},
+			"labels": actionschema.MapAttribute{
+				MarkdownDescription: "User-defined [labels](https://docs.hetzner.cloud/reference/cloud#labels) (key-value pairs) for the Image.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+		invoke: func(ctx context.Context, client *hcloud.Client, server *hcloud.Server, data serverCreateImageActionData) (*hcloud.Action, string, error) {
+			opts := &hcloud.ServerCreateImageOpts{
+				Type: hcloud.ImageTypeSnapshot,
+			}
+
+			if !data.Type.IsNull() {
+				opts.Type = hcloud.ImageType(data.Type.ValueString())
+			}
+			if !data.Description.IsNull() {
+				opts.Description = data.Description.ValueStringPointer()
+			}
+			if !data.Labels.IsNull() {
+				labels := make(map[string]string, len(data.Labels.Elements()))
+				if diags := data.Labels.ElementsAs(ctx, &labels, false); diags.HasError() {
+					return nil, "", fmt.Errorf("invalid labels: %v", diags)
+				}
+				opts.Labels = labels
+			}
+
+			result, _, err := client.Server.CreateImage(ctx, server, opts)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return result.Action, fmt.Sprintf("Image %d created from server %d", result.Image.ID, server.ID), nil
+		},
+	}
+}
+
+type serverChangeTypeActionData struct {
+	serverActionData
+	ServerType  types.String `tfsdk:"server_type"`
+	UpgradeDisk types.Bool   `tfsdk:"upgrade_disk"`
+}
+
+func NewChangeTypeAction() action.Action {
+	return &serverAction[serverChangeTypeActionData]{
+		typeName: ChangeTypeActionType,
+		markdownDescription: util.MarkdownDescription(`
+Change the type of a server in Hetzner Cloud. The server must be powered off.
+
+See the [Change the Type of a Server documentation](https://docs.hetzner.cloud/reference/cloud#tag/server-actions/change_server_type) for more details.
+`),
+		attributes: map[string]actionschema.Attribute{
+			"server_type": actionschema.StringAttribute{
+				MarkdownDescription: "ID or name of the Server Type to change the server to.",
+				Required:            true,
+			},
+			"upgrade_disk": actionschema.BoolAttribute{
+				MarkdownDescription: "Whether the disk of the server should be upgraded. If enabled, the server cannot be downgraded later on. Defaults to `false`.",
+				Optional:            true,
+			},
+		},
+		invoke: func(ctx context.Context, client *hcloud.Client, server *hcloud.Server, data serverChangeTypeActionData) (*hcloud.Action, string, error) {
+			id, name := idOrName(data.ServerType.ValueString())
+
+			apiAction, _, err := client.Server.ChangeType(ctx, server, hcloud.ServerChangeTypeOpts{
+				ServerType:  &hcloud.ServerType{ID: id, Name: name},
+				UpgradeDisk: data.UpgradeDisk.ValueBool(),
+			})
+			return apiAction, "", err
 		},
 	}
 }
 
-func (a *serverAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+func NewRequestConsoleAction() action.Action {
+	return &serverAction[serverActionData]{
+		typeName: RequestConsoleActionType,
+		markdownDescription: util.MarkdownDescription(`
+Request a WebSocket VNC console for a server in Hetzner Cloud.
+
+The WebSocket URL and the VNC password are reported once the console was requested. The console is only valid for one minute.
+
+See the [Request Console for a Server documentation](https://docs.hetzner.cloud/reference/cloud#tag/server-actions/request_console_for_server) for more details.
+`),
+		invoke: func(ctx context.Context, client *hcloud.Client, server *hcloud.Server, _ serverActionData) (*hcloud.Action, string, error) {
+			result, _, err := client.Server.RequestConsole(ctx, server)
+			if err != nil {
+				return nil, "", err
+			}
+
+			return result.Action, fmt.Sprintf("Console for server %d: %s (password: %s)", server.ID, result.WSSURL, result.Password), nil
+		},
+	}
+}
+
+// idOrName returns the ID if the value is numeric, or the name otherwise.
+func idOrName(value string) (int64, string) {
+	if id, err := util.ParseID(value); err == nil {
+		return id, ""
+	}
+	return 0, value
+}
+
+func (a *serverAction[T]) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
 	resp.TypeName = a.typeName
 }
 
-func (a *serverAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+func (a *serverAction[T]) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
 	var newDiags diag.Diagnostics
 
 	a.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
 	resp.Diagnostics.Append(newDiags...)
 }
 
-func (a *serverAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+func (a *serverAction[T]) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
 	resp.Schema = actionschema.Schema{
 		MarkdownDescription: a.markdownDescription,
 		Attributes: map[string]actionschema.Attribute{
@@ -115,11 +441,13 @@ func (a *serverAction) Schema(_ context.Context, _ action.SchemaRequest, resp *a
 				MarkdownDescription: "ID of the server to apply the action to.",
 				Required:            true,
 			},
+			"project": actionutil.ProjectAttribute(),
 		},
 	}
+	maps.Copy(resp.Schema.Attributes, a.attributes)
 }
 
-func (a *serverAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+func (a *serverAction[T]) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
 	if a.client == nil {
 		resp.Diagnostics.AddError(
 			"Provider not configured",
@@ -128,19 +456,26 @@ func (a *serverAction) Invoke(ctx context.Context, req action.InvokeRequest, res
 		return
 	}
 
-	var data serverActionData
+	var data T
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	server := &hcloud.Server{ID: data.ServerID.ValueInt64()}
+	server := &hcloud.Server{ID: data.serverID()}
 
-	apiAction, err := a.invoke(ctx, a.client, server)
+	apiAction, message, err := a.invoke(ctx, a.client, server, data)
 	if err != nil {
 		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
 		return
 	}
 
 	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &a.client.Action, apiAction)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if message != "" {
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	}
 }
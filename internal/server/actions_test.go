@@ -114,3 +114,109 @@ func TestAccServerActions(t *testing.T) {
 		},
 	})
 }
+
+func TestAccServerActions_RescueAndISO(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	s := &hcloud.Server{}
+
+	sk := sshkey.NewRData(t, "server-actions-rescue")
+
+	res := &server.RData{
+		Name:         "server-actions-rescue",
+		Type:         teste2e.TestServerType,
+		Image:        teste2e.TestImage,
+		LocationName: teste2e.TestLocationName,
+		SSHKeys:      []string{sk.TFID() + ".id"},
+	}
+	res.SetRName("default")
+
+	resActionEnableRescue := &server.AData{
+		Type:     "enable_rescue",
+		ServerID: res.TFID() + ".id",
+		Raw:      fmt.Sprintf("ssh_keys = [%s.id]", sk.TFID()),
+	}
+	resActionEnableRescue.SetRName("default")
+
+	resActionDisableRescue := &server.AData{
+		Type:     "disable_rescue",
+		ServerID: res.TFID() + ".id",
+	}
+	resActionDisableRescue.SetRName("default")
+
+	resActionAttachISO := &server.AData{
+		Type:     "attach_iso",
+		ServerID: res.TFID() + ".id",
+		Raw:      `iso = "8637"`, // Windows Server 2022 English
+	}
+	resActionAttachISO.SetRName("default")
+
+	resActionDetachISO := &server.AData{
+		Type:     "detach_iso",
+		ServerID: res.TFID() + ".id",
+	}
+	resActionDetachISO.SetRName("default")
+
+	res.Raw = fmt.Sprintf(`
+		lifecycle {
+			action_trigger {
+				events  = [after_create]
+				actions = [
+					%s,
+					%s,
+					%s,
+					%s
+				]
+			}
+		}
+	`, resActionEnableRescue.TFID(), resActionDisableRescue.TFID(), resActionAttachISO.TFID(), resActionDetachISO.TFID())
+
+	resource.ParallelTest(t, resource.TestCase{
+		// Actions are only available in 1.14 and later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_ssh_key", sk,
+					"testdata/r/hcloud_server", res,
+					"testdata/a/hcloud_server", resActionEnableRescue,
+					"testdata/a/hcloud_server", resActionDisableRescue,
+					"testdata/a/hcloud_server", resActionAttachISO,
+					"testdata/a/hcloud_server", resActionDetachISO,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testsupport.CheckAPIResourcePresent(res.TFID(), testsupport.CopyAPIResource(s, server.GetAPIResource())),
+					func(_ *terraform.State) error {
+						client, err := testsupport.CreateClient()
+						if err != nil {
+							return err
+						}
+
+						actions, err := client.Server.Action.AllFor(context.Background(), s, hcloud.ActionListOpts{})
+						if err != nil {
+							return err
+						}
+
+						actionWithCommand := func(command string) func(*hcloud.Action) bool {
+							return func(action *hcloud.Action) bool {
+								return action.Command == command
+							}
+						}
+
+						assert.True(t, slices.ContainsFunc(actions, actionWithCommand("enable_rescue")))
+						assert.True(t, slices.ContainsFunc(actions, actionWithCommand("disable_rescue")))
+						assert.True(t, slices.ContainsFunc(actions, actionWithCommand("attach_iso")))
+						assert.True(t, slices.ContainsFunc(actions, actionWithCommand("detach_iso")))
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
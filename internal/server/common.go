@@ -7,8 +7,6 @@ import (
 	"net"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/control"
@@ -26,7 +24,7 @@ func attachServerToNetwork(ctx context.Context, c *hcloud.Client, srv *hcloud.Se
 		opts.IPRange = ipRange
 	}
 
-	err := control.Retry(control.DefaultRetries, func() error {
+	err := control.Retry(ctx, control.DefaultRetries, func() error {
 		var err error
 
 		action, _, err = c.Server.AttachToNetwork(ctx, srv, opts)
@@ -54,15 +52,12 @@ func attachServerToNetwork(ctx context.Context, c *hcloud.Client, srv *hcloud.Se
 	return nil
 }
 
-func updateServerAliasIPs(ctx context.Context, c *hcloud.Client, s *hcloud.Server, n *hcloud.Network, aliasIPs *schema.Set) error {
+func updateServerAliasIPs(ctx context.Context, c *hcloud.Client, s *hcloud.Server, n *hcloud.Network, aliasIPs []net.IP) error {
 	const op = "hcloud/updateServerAliasIPs"
 
 	opts := hcloud.ServerChangeAliasIPsOpts{
 		Network:  n,
-		AliasIPs: make([]net.IP, aliasIPs.Len()),
-	}
-	for i, v := range aliasIPs.List() {
-		opts.AliasIPs[i] = net.ParseIP(v.(string))
+		AliasIPs: aliasIPs,
 	}
 	action, _, err := c.Server.ChangeAliasIPs(ctx, s, opts)
 	if err != nil {
@@ -78,7 +73,7 @@ func detachServerFromNetwork(ctx context.Context, c *hcloud.Client, s *hcloud.Se
 	const op = "hcloud/detachServerFromNetwork"
 	var action *hcloud.Action
 
-	err := control.Retry(control.DefaultRetries, func() error {
+	err := control.Retry(ctx, control.DefaultRetries, func() error {
 		var err error
 
 		action, _, err = c.Server.DetachFromNetwork(ctx, s, hcloud.ServerDetachFromNetworkOpts{Network: n})
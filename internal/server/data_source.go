@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -204,7 +205,7 @@ func dataSourceHcloudServerRead(ctx context.Context, d *schema.ResourceData, m a
 		if s == nil {
 			return diag.Errorf("no Server found with id %d", id)
 		}
-		setServerSchema(d, s, true)
+		setServerSchema(d, s)
 		return nil
 	}
 
@@ -216,7 +217,7 @@ func dataSourceHcloudServerRead(ctx context.Context, d *schema.ResourceData, m a
 		if s == nil {
 			return diag.Errorf("no Server found with name %s", name)
 		}
-		setServerSchema(d, s, true)
+		setServerSchema(d, s)
 		return nil
 	}
 
@@ -249,7 +250,7 @@ func dataSourceHcloudServerRead(ctx context.Context, d *schema.ResourceData, m a
 		if len(allServers) > 1 {
 			return diag.Errorf("more than one Server found for selector %q", selector)
 		}
-		setServerSchema(d, allServers[0], true)
+		setServerSchema(d, allServers[0])
 		return nil
 	}
 
@@ -281,10 +282,120 @@ func dataSourceHcloudServerListRead(ctx context.Context, d *schema.ResourceData,
 	tfServers := make([]map[string]any, len(allServers))
 	for i, server := range allServers {
 		ids[i] = util.FormatID(server.ID)
-		tfServers[i] = getServerAttributes(d, server, true)
+		tfServers[i] = getServerAttributes(d, server)
 	}
 	d.Set("servers", tfServers)
 	d.SetId(datasourceutil.ListID(ids))
 
 	return nil
 }
+
+func setServerSchema(d *schema.ResourceData, s *hcloud.Server) {
+	util.SetSchemaFromAttributes(d, getServerAttributes(d, s))
+}
+
+func getServerAttributes(d *schema.ResourceData, s *hcloud.Server) map[string]any {
+	firewallIDs := make([]int, len(s.PublicNet.Firewalls))
+	for i, firewall := range s.PublicNet.Firewalls {
+		firewallIDs[i] = util.CastInt(firewall.Firewall.ID)
+	}
+
+	res := map[string]any{
+		"id":                 s.ID,
+		"name":               s.Name,
+		"location":           s.Location.Name,
+		"status":             s.Status,
+		"server_type":        s.ServerType.Name,
+		"ipv6_network":       s.PublicNet.IPv6.Network.String(),
+		"backup_window":      s.BackupWindow,
+		"backups":            s.BackupWindow != "",
+		"labels":             s.Labels,
+		"delete_protection":  s.Protection.Delete,
+		"rebuild_protection": s.Protection.Rebuild,
+		"firewall_ids":       firewallIDs,
+		"primary_disk_size":  s.PrimaryDiskSize,
+	}
+	if s.PublicNet.IPv4.IsUnspecified() {
+		res["ipv4_address"] = nil
+	} else {
+		res["ipv4_address"] = s.PublicNet.IPv4.IP.String()
+	}
+
+	if len(s.PublicNet.IPv6.IP) == 0 {
+		// No IPv6 Primary IP assigned
+		res["ipv6_address"] = nil
+	} else {
+		// Set first IP in assigned subnet range
+		res["ipv6_address"] = s.PublicNet.IPv6.IP.String() + "1"
+	}
+
+	if s.Image != nil {
+		if s.Image.Name != "" && util.FormatID(s.Image.ID) != d.Get("image") {
+			// Only use the image name if the image is official (Name != "")
+			// AND the user did not explicitly specify the image id
+			res["image"] = s.Image.Name
+		} else {
+			res["image"] = fmt.Sprintf("%d", s.Image.ID)
+		}
+	}
+
+	res["network"] = networkToTerraformNetworks(d, s.PrivateNet)
+
+	if s.PlacementGroup != nil {
+		res["placement_group_id"] = util.CastInt(s.PlacementGroup.ID)
+	} else {
+		res["placement_group_id"] = nil
+	}
+
+	return res
+}
+
+func networkToTerraformNetworks(d *schema.ResourceData, privateNetworks []hcloud.ServerPrivateNet) []map[string]any {
+	tfPrivateNetworks := make([]map[string]any, len(privateNetworks))
+	for i, privateNetwork := range privateNetworks {
+		tfPrivateNetwork := make(map[string]any)
+		tfPrivateNetwork["ip"] = privateNetwork.IP.String()
+		tfPrivateNetwork["mac_address"] = privateNetwork.MACAddress
+
+		// Check the user input to preserve the same structure in state
+		if nwSet, ok := d.GetOk("network"); ok {
+			for _, item := range nwSet.(*schema.Set).List() {
+				nwData := item.(map[string]any)
+				configSubnetID, hasSubnetID := nwData["subnet_id"].(string)
+
+				// Match API response to config entry by network_id or subnet_id
+				var matchesNetwork bool
+				var configNetworkID int64
+
+				if hasSubnetID && configSubnetID != "" {
+					if subnetNetwork, _, err := ParseSubnetID(configSubnetID); err == nil {
+						configNetworkID = subnetNetwork.ID
+						matchesNetwork = subnetNetwork.ID == privateNetwork.Network.ID
+					}
+				} else {
+					configNetworkID = util.CastInt64(nwData["network_id"])
+					matchesNetwork = configNetworkID == privateNetwork.Network.ID
+				}
+
+				if matchesNetwork {
+					// Write fields to state based on the user input
+					if configNetworkID != 0 {
+						tfPrivateNetwork["network_id"] = privateNetwork.Network.ID
+					}
+					if hasSubnetID && configSubnetID != "" {
+						tfPrivateNetwork["subnet_id"] = configSubnetID
+					}
+					break
+				}
+			}
+		}
+
+		aliasIPs := make([]string, len(privateNetwork.Aliases))
+		for in, ip := range privateNetwork.Aliases {
+			aliasIPs[in] = ip.String()
+		}
+		tfPrivateNetwork["alias_ips"] = aliasIPs
+		tfPrivateNetworks[i] = tfPrivateNetwork
+	}
+	return tfPrivateNetworks
+}
@@ -0,0 +1,72 @@
+package server_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/server"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/teste2e"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testmux"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testsupport"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testtemplate"
+)
+
+func TestAccServerMetricsDataSource(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	res := &server.RData{
+		Name:  "server-metrics",
+		Type:  teste2e.TestServerType,
+		Image: teste2e.TestImage,
+	}
+	res.SetRName("main")
+
+	cpu := &server.DDataMetrics{
+		ServerID: res.TFID() + ".id",
+		Type:     hcloud.ServerMetricCPU,
+		Start:    `timeadd(plantimestamp(), "-1h")`,
+		End:      `plantimestamp()`,
+		Step:     60,
+	}
+	cpu.SetRName("cpu")
+
+	network := &server.DDataMetrics{
+		ServerID: res.TFID() + ".id",
+		Type:     hcloud.ServerMetricNetwork,
+		Start:    `timeadd(plantimestamp(), "-1h")`,
+		End:      `plantimestamp()`,
+	}
+	network.SetRName("network")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		CheckDestroy:             testsupport.CheckAPIResourceAllAbsent(server.ResourceType, server.GetAPIResource()),
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_server", res,
+				),
+			},
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_server", res,
+					"testdata/d/hcloud_server_metrics", cpu,
+					"testdata/d/hcloud_server_metrics", network,
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(cpu.TFID(), tfjsonpath.New("step"), knownvalue.Int64Exact(60)),
+					statecheck.ExpectKnownValue(cpu.TFID(), tfjsonpath.New("time_series").AtMapKey("cpu").AtMapKey("values"), knownvalue.NotNull()),
+
+					statecheck.ExpectKnownValue(network.TFID(), tfjsonpath.New("step"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(network.TFID(), tfjsonpath.New("time_series").AtMapKey("network.0.bandwidth.in").AtMapKey("values"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,293 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+)
+
+const (
+	// RootPasswordEphemeralResourceType is the type name of the ephemeral
+	// resource resetting the root password of a server.
+	RootPasswordEphemeralResourceType = "hcloud_server_root_password"
+
+	// RescueEphemeralResourceType is the type name of the ephemeral resource
+	// enabling the rescue system of a server.
+	RescueEphemeralResourceType = "hcloud_server_rescue"
+)
+
+// ephemeralProjectAttribute returns the `project` attribute used to select the
+// project the ephemeral resources are opened in.
+func ephemeralProjectAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Name of the project, as configured in the `projects` block of the provider, the ephemeral resource is opened in. Defaults to the project of the provider `token`.",
+		Optional:            true,
+	}
+}
+
+var _ ephemeral.EphemeralResource = (*RootPasswordEphemeralResource)(nil)
+var _ ephemeral.EphemeralResourceWithConfigure = (*RootPasswordEphemeralResource)(nil)
+
+type RootPasswordEphemeralResource struct {
+	client *hcloud.Client
+}
+
+func NewRootPasswordEphemeralResource() ephemeral.EphemeralResource {
+	return &RootPasswordEphemeralResource{}
+}
+
+func (r *RootPasswordEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = RootPasswordEphemeralResourceType
+}
+
+func (r *RootPasswordEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	r.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+}
+
+func (r *RootPasswordEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: util.MarkdownDescription(`
+Resets the root password of a Hetzner Cloud Server, the new root password is never stored in the state.
+
+**Opening the ephemeral resource changes the Server:** The root password is reset every time the ephemeral resource is opened, which happens during each plan and apply, and the previous root password stops working. Only use it for Servers whose root password is not used anywhere else.
+
+The server must be running and the [QEMU guest agent](https://docs.hetzner.com/cloud/servers/getting-started/root-password-reset) must be installed.
+
+See the [Reset root Password of a Server documentation](https://docs.hetzner.cloud/reference/cloud#tag/server-actions/reset_server_password) for more details.
+`),
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the Server.",
+				Required:            true,
+			},
+			"root_password": schema.StringAttribute{
+				MarkdownDescription: "New root password of the Server.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"project": ephemeralProjectAttribute(),
+		},
+	}
+}
+
+type rootPasswordEphemeralResourceModel struct {
+	ServerID     types.Int64  `tfsdk:"server_id"`
+	RootPassword types.String `tfsdk:"root_password"`
+	Project      types.String `tfsdk:"project"`
+}
+
+func (r *RootPasswordEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data rootPasswordEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server := &hcloud.Server{ID: data.ServerID.ValueInt64()}
+
+	result, _, err := r.client.Server.ResetPassword(ctx, server)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, result.Action)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.RootPassword = types.StringValue(result.RootPassword)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+var _ ephemeral.EphemeralResource = (*RescueEphemeralResource)(nil)
+var _ ephemeral.EphemeralResourceWithConfigure = (*RescueEphemeralResource)(nil)
+var _ ephemeral.EphemeralResourceWithClose = (*RescueEphemeralResource)(nil)
+
+const (
+	// rescuePrivateServerIDKey is the key of the private data holding the ID of
+	// the Server the rescue system was enabled for, to disable it on close.
+	rescuePrivateServerIDKey = "server_id"
+
+	// rescuePrivateProjectKey is the key of the private data holding the project
+	// of the Server, as the close request does not include the configuration.
+	rescuePrivateProjectKey = "project"
+)
+
+type RescueEphemeralResource struct {
+	client *hcloud.Client
+}
+
+func NewRescueEphemeralResource() ephemeral.EphemeralResource {
+	return &RescueEphemeralResource{}
+}
+
+func (r *RescueEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = RescueEphemeralResourceType
+}
+
+func (r *RescueEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	r.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+}
+
+func (r *RescueEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: util.MarkdownDescription(`
+Enables the rescue system of a Hetzner Cloud Server, the root password of the rescue system is never stored in the state.
+
+**Opening the ephemeral resource changes the Server:** The rescue system is enabled every time the ephemeral resource is opened, which happens during each plan and apply, and disabled again when the ephemeral resource is closed, at the end of the plan or apply. A Server already booted into the rescue system keeps running it until the next reboot. To enable the rescue system permanently, use the ''rescue'' attribute of the ''hcloud_server'' resource or the ''hcloud_server_enable_rescue'' action instead, and do not combine them with this ephemeral resource.
+
+The server must be rebooted or reset to boot into the rescue system, for example using the ''hcloud_server_reset'' action.
+
+See the [Enable Rescue Mode for a Server documentation](https://docs.hetzner.cloud/reference/cloud#tag/server-actions/enable_rescue_mode_for_server) for more details.
+`),
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the Server.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the rescue system. Defaults to `linux64`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(hcloud.ServerRescueTypeLinux64)),
+				},
+			},
+			"ssh_keys": schema.ListAttribute{
+				MarkdownDescription: "SSH Key IDs or names which should be injected into the rescue system.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"root_password": schema.StringAttribute{
+				MarkdownDescription: "Root password of the rescue system.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"project": ephemeralProjectAttribute(),
+		},
+	}
+}
+
+type rescueEphemeralResourceModel struct {
+	ServerID     types.Int64  `tfsdk:"server_id"`
+	Type         types.String `tfsdk:"type"`
+	SSHKeys      types.List   `tfsdk:"ssh_keys"`
+	RootPassword types.String `tfsdk:"root_password"`
+	Project      types.String `tfsdk:"project"`
+}
+
+func (r *RescueEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data rescueEphemeralResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server := &hcloud.Server{ID: data.ServerID.ValueInt64()}
+
+	opts := hcloud.ServerEnableRescueOpts{
+		Type: hcloud.ServerRescueTypeLinux64,
+	}
+	if !data.Type.IsNull() {
+		opts.Type = hcloud.ServerRescueType(data.Type.ValueString())
+	}
+
+	sshKeys, err := getSSHKeys(ctx, r.client, data.SSHKeys)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+	opts.SSHKeys = sshKeys
+
+	result, _, err := r.client.Server.EnableRescue(ctx, server, opts)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, result.Action)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.RootPassword = types.StringValue(result.RootPassword)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+
+	serverID, err := json.Marshal(server.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to store the Server ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, rescuePrivateServerIDKey, serverID)...)
+
+	if project := hcloudutil.ProjectFromContext(ctx); project != "" {
+		rawProject, err := json.Marshal(project)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to store the project", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, rescuePrivateProjectKey, rawProject)...)
+	}
+}
+
+// Close disables the rescue system enabled when the ephemeral resource was
+// opened, so the Server does not stay in rescue mode.
+func (r *RescueEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	raw, diags := req.Private.GetKey(ctx, rescuePrivateServerIDKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || raw == nil {
+		return
+	}
+
+	var serverID int64
+	if err := json.Unmarshal(raw, &serverID); err != nil {
+		resp.Diagnostics.AddError("Failed to read the Server ID", err.Error())
+		return
+	}
+
+	rawProject, diags := req.Private.GetKey(ctx, rescuePrivateProjectKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if rawProject != nil {
+		var project string
+		if err := json.Unmarshal(rawProject, &project); err != nil {
+			resp.Diagnostics.AddError("Failed to read the project", err.Error())
+			return
+		}
+		ctx = hcloudutil.ContextWithProject(ctx, project)
+	}
+
+	action, _, err := r.client.Server.DisableRescue(ctx, &hcloud.Server{ID: serverID})
+	if err != nil {
+		if hcloud.IsError(err, hcloud.ErrorCodeNotFound) {
+			// The Server was deleted in the meantime.
+			return
+		}
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, action)...)
+}
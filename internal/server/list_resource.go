@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/listresourceutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
+)
+
+var _ list.ListResource = (*ListResource)(nil)
+var _ list.ListResourceWithConfigure = (*ListResource)(nil)
+
+type ListResource struct {
+	client *hcloud.Client
+}
+
+func NewListResource() list.ListResource {
+	return &ListResource{}
+}
+
+func (r *ListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = ResourceType
+}
+
+func (r *ListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	r.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+}
+
+func (r *ListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listresourceutil.WithSelectorSchema()
+	resp.Schema.MarkdownDescription = "Lists the Hetzner Cloud Servers."
+}
+
+func (r *ListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data listresourceutil.WithSelectorModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	opts := hcloud.ServerListOpts{}
+	opts.LabelSelector = data.WithSelector.ValueString()
+
+	result, err := r.client.Server.AllWithOpts(ctx, opts)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(hcloudutil.APIErrorDiagnostics(err))
+		return
+	}
+
+	stream.Results = listresourceutil.Results(ctx, req, result, func(ctx context.Context, in *hcloud.Server, item *list.ListResult) {
+		item.DisplayName = in.Name
+		item.Diagnostics.Append(item.Identity.Set(ctx, resourceutil.NewIDIdentity(types.Int64Value(in.ID)))...)
+
+		if !req.IncludeResource {
+			return
+		}
+
+		var data model
+
+		// Set the defaults of the attributes that are not returned by the API,
+		// the same way the resource does during an import.
+		item.Diagnostics.Append(item.Resource.SetAttribute(ctx, path.Root("id"), in.ID)...)
+		item.Diagnostics.Append(item.Resource.SetAttribute(ctx, path.Root("keep_disk"), false)...)
+		item.Diagnostics.Append(item.Resource.SetAttribute(ctx, path.Root("ignore_remote_firewall_ids"), false)...)
+		item.Diagnostics.Append(item.Resource.SetAttribute(ctx, path.Root("shutdown_before_deletion"), false)...)
+		item.Diagnostics.Append(item.Resource.Get(ctx, &data)...)
+		if item.Diagnostics.HasError() {
+			return
+		}
+
+		item.Diagnostics.Append(data.FromAPI(ctx, in)...)
+		if item.Diagnostics.HasError() {
+			return
+		}
+
+		item.Diagnostics.Append(item.Resource.Set(ctx, &data)...)
+	})
+}
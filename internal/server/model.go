@@ -6,11 +6,16 @@ import (
 	"net"
 
 	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/kit/sliceutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
 )
 
 const datacenterDeprecationMessage = "The datacenter attribute is marked for removal, you must use the location attribute instead. See https://docs.hetzner.cloud/changelog#2026-07-01-removing-datacenters."
@@ -23,6 +28,9 @@ type networkResourceData struct {
 	IP         iptypes.IPAddress `tfsdk:"ip"`
 	AliasIPs   types.Set         `tfsdk:"alias_ips"`
 	MACAddress types.String      `tfsdk:"mac_address"`
+
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func populateNetworkResourceData(
@@ -51,3 +59,324 @@ func populateNetworkResourceData(
 
 	return diags
 }
+
+type model struct {
+	ID                      types.Int64  `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	ServerType              types.String `tfsdk:"server_type"`
+	Image                   types.String `tfsdk:"image"`
+	Location                types.String `tfsdk:"location"`
+	Datacenter              types.String `tfsdk:"datacenter"`
+	UserData                types.String `tfsdk:"user_data"`
+	UserDataWO              types.String `tfsdk:"user_data_wo"`
+	UserDataWOVersion       types.Int64  `tfsdk:"user_data_wo_version"`
+	SSHKeys                 types.List   `tfsdk:"ssh_keys"`
+	KeepDisk                types.Bool   `tfsdk:"keep_disk"`
+	AllowDeprecatedImages   types.Bool   `tfsdk:"allow_deprecated_images"`
+	BackupWindow            types.String `tfsdk:"backup_window"`
+	Backups                 types.Bool   `tfsdk:"backups"`
+	IPv4Address             types.String `tfsdk:"ipv4_address"`
+	IPv6Address             types.String `tfsdk:"ipv6_address"`
+	IPv6Network             types.String `tfsdk:"ipv6_network"`
+	Status                  types.String `tfsdk:"status"`
+	ISO                     types.String `tfsdk:"iso"`
+	Rescue                  types.String `tfsdk:"rescue"`
+	Labels                  types.Map    `tfsdk:"labels"`
+	PublicNet               types.Set    `tfsdk:"public_net"`
+	Network                 types.Set    `tfsdk:"network"`
+	IgnoreRemoteFirewallIDs types.Bool   `tfsdk:"ignore_remote_firewall_ids"`
+	FirewallIDs             types.Set    `tfsdk:"firewall_ids"`
+	PlacementGroupID        types.Int64  `tfsdk:"placement_group_id"`
+	DeleteProtection        types.Bool   `tfsdk:"delete_protection"`
+	RebuildProtection       types.Bool   `tfsdk:"rebuild_protection"`
+	ShutdownBeforeDeletion  types.Bool   `tfsdk:"shutdown_before_deletion"`
+	PrimaryDiskSize         types.Int64  `tfsdk:"primary_disk_size"`
+
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+var _ util.ModelFromAPI[*hcloud.Server] = &model{}
+
+// FromAPI populates the model from the API server. The current values of the model
+// are used as prior values, to preserve the structure of the user configuration for
+// the "image" and "network" attributes.
+func (m *model) FromAPI(ctx context.Context, hc *hcloud.Server) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	m.ID = types.Int64Value(hc.ID)
+	m.Name = types.StringValue(hc.Name)
+	m.ServerType = types.StringValue(hc.ServerType.Name)
+	m.Location = types.StringValue(hc.Location.Name)
+	m.Datacenter = types.StringNull()
+	m.Status = types.StringValue(string(hc.Status))
+	m.BackupWindow = types.StringValue(hc.BackupWindow)
+	m.Backups = types.BoolValue(hc.BackupWindow != "")
+	m.PrimaryDiskSize = types.Int64Value(int64(hc.PrimaryDiskSize))
+
+	if hc.Image != nil {
+		if hc.Image.Name != "" && m.Image.ValueString() != util.FormatID(hc.Image.ID) {
+			// Only use the image name if the image is official (Name != "")
+			// AND the user did not explicitly specify the image id
+			m.Image = types.StringValue(hc.Image.Name)
+		} else {
+			m.Image = types.StringValue(util.FormatID(hc.Image.ID))
+		}
+	} else if m.Image.IsUnknown() {
+		m.Image = types.StringNull()
+	}
+
+	if hc.PublicNet.IPv4.IsUnspecified() {
+		m.IPv4Address = types.StringNull()
+	} else {
+		m.IPv4Address = types.StringValue(hc.PublicNet.IPv4.IP.String())
+	}
+
+	if len(hc.PublicNet.IPv6.IP) == 0 {
+		// No IPv6 Primary IP assigned
+		m.IPv6Address = types.StringNull()
+	} else {
+		// Set first IP in assigned subnet range
+		m.IPv6Address = types.StringValue(hc.PublicNet.IPv6.IP.String() + "1")
+	}
+
+	if hc.PublicNet.IPv6.Network == nil {
+		m.IPv6Network = types.StringNull()
+	} else {
+		m.IPv6Network = types.StringValue(hc.PublicNet.IPv6.Network.String())
+	}
+
+	m.Labels, newDiags = resourceutil.LabelsMapValueFrom(ctx, hc.Labels)
+	diags.Append(newDiags...)
+
+	firewallIDs := sliceutil.Transform(hc.PublicNet.Firewalls, func(o *hcloud.ServerFirewallStatus) int64 { return o.Firewall.ID })
+	m.FirewallIDs, newDiags = types.SetValueFrom(ctx, types.Int64Type, firewallIDs)
+	diags.Append(newDiags...)
+
+	if hc.PlacementGroup != nil {
+		m.PlacementGroupID = types.Int64Value(hc.PlacementGroup.ID)
+	} else {
+		m.PlacementGroupID = types.Int64Null()
+	}
+
+	m.DeleteProtection = types.BoolValue(hc.Protection.Delete)
+	m.RebuildProtection = types.BoolValue(hc.Protection.Rebuild)
+
+	// The "public_net" attribute only reflects the user configuration.
+	if m.PublicNet.IsNull() || m.PublicNet.IsUnknown() {
+		m.PublicNet = types.SetValueMust((&modelPublicNet{}).tfType(), []attr.Value{})
+	}
+
+	// Only write the networks if the model already contains such an entry. This
+	// avoids conflicts with the "hcloud_server_network" resource.
+	if m.Network.IsNull() || (!m.Network.IsUnknown() && len(m.Network.Elements()) == 0) {
+		m.Network = types.SetValueMust((&modelNetwork{}).tfType(), []attr.Value{})
+	} else {
+		prior := modelNetworks{}
+		if !m.Network.IsUnknown() {
+			diags.Append(prior.FromTerraform(ctx, m.Network)...)
+		}
+
+		value := modelNetworks{}
+		diags.Append(value.FromAPI(ctx, hc.PrivateNet)...)
+
+		// Preserve the subnet ID set by the user, it is not returned by the API.
+		for i := range value {
+			if item := prior.find(value[i].NetworkID.ValueInt64()); item != nil {
+				value[i].SubnetID = item.SubnetID
+			}
+		}
+
+		m.Network, newDiags = value.ToTerraform(ctx)
+		diags.Append(newDiags...)
+	}
+
+	return diags
+}
+
+type modelPublicNet struct {
+	IPv4Enabled types.Bool  `tfsdk:"ipv4_enabled"`
+	IPv6Enabled types.Bool  `tfsdk:"ipv6_enabled"`
+	IPv4        types.Int64 `tfsdk:"ipv4"`
+	IPv6        types.Int64 `tfsdk:"ipv6"`
+}
+
+var _ util.ModelFromTerraform[types.Object] = &modelPublicNet{}
+var _ util.ModelToTerraform[types.Object] = &modelPublicNet{}
+
+func (m *modelPublicNet) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"ipv4_enabled": types.BoolType,
+		"ipv6_enabled": types.BoolType,
+		"ipv4":         types.Int64Type,
+		"ipv6":         types.Int64Type,
+	}
+}
+
+func (m *modelPublicNet) tfType() attr.Type {
+	return basetypes.ObjectType{AttrTypes: m.tfAttributesTypes()}
+}
+
+func (m *modelPublicNet) FromTerraform(ctx context.Context, tf types.Object) diag.Diagnostics {
+	return tf.As(ctx, m, basetypes.ObjectAsOptions{})
+}
+
+func (m *modelPublicNet) ToTerraform(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
+}
+
+type modelPublicNets []modelPublicNet
+
+var _ util.ModelFromTerraform[types.Set] = &modelPublicNets{}
+var _ util.ModelToTerraform[types.Set] = &modelPublicNets{}
+
+func (m *modelPublicNets) FromTerraform(ctx context.Context, tf types.Set) diag.Diagnostics {
+	*m = make(modelPublicNets, 0, len(tf.Elements()))
+	return tf.ElementsAs(ctx, m, false)
+}
+
+func (m *modelPublicNets) ToTerraform(ctx context.Context) (tf types.Set, diags diag.Diagnostics) {
+	tfItems := make([]attr.Value, 0, len(*m))
+	for _, value := range *m {
+		tfItem, newDiags := value.ToTerraform(ctx)
+		diags.Append(newDiags...)
+
+		tfItems = append(tfItems, tfItem)
+	}
+
+	tf, newDiags := types.SetValue((&modelPublicNet{}).tfType(), tfItems)
+	diags.Append(newDiags...)
+
+	return tf, diags
+}
+
+type modelNetwork struct {
+	NetworkID  types.Int64       `tfsdk:"network_id"`
+	SubnetID   types.String      `tfsdk:"subnet_id"`
+	IP         iptypes.IPAddress `tfsdk:"ip"`
+	AliasIPs   types.Set         `tfsdk:"alias_ips"`
+	MACAddress types.String      `tfsdk:"mac_address"`
+}
+
+var _ util.ModelFromAPI[hcloud.ServerPrivateNet] = &modelNetwork{}
+var _ util.ModelFromTerraform[types.Object] = &modelNetwork{}
+var _ util.ModelToTerraform[types.Object] = &modelNetwork{}
+
+func (m *modelNetwork) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"network_id":  types.Int64Type,
+		"subnet_id":   types.StringType,
+		"ip":          iptypes.IPAddressType{},
+		"alias_ips":   types.SetType{ElemType: iptypes.IPAddressType{}},
+		"mac_address": types.StringType,
+	}
+}
+
+func (m *modelNetwork) tfType() attr.Type {
+	return basetypes.ObjectType{AttrTypes: m.tfAttributesTypes()}
+}
+
+func (m *modelNetwork) FromAPI(ctx context.Context, hc hcloud.ServerPrivateNet) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	m.NetworkID = types.Int64Value(hc.Network.ID)
+	m.SubnetID = types.StringNull()
+	m.IP = iptypes.NewIPAddressValue(hc.IP.String())
+	m.MACAddress = types.StringValue(hc.MACAddress)
+
+	aliasIPs := sliceutil.Transform(hc.Aliases, func(o net.IP) iptypes.IPAddress { return iptypes.NewIPAddressValue(o.String()) })
+	m.AliasIPs, newDiags = types.SetValueFrom(ctx, iptypes.IPAddressType{}, aliasIPs)
+	diags.Append(newDiags...)
+
+	return diags
+}
+
+func (m *modelNetwork) FromTerraform(ctx context.Context, tf types.Object) diag.Diagnostics {
+	return tf.As(ctx, m, basetypes.ObjectAsOptions{})
+}
+
+func (m *modelNetwork) ToTerraform(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
+}
+
+// resolveNetworkID returns the network ID either from the "network_id" or the
+// "subnet_id" attribute. Returns 0 if the network ID is not known yet.
+func (m *modelNetwork) resolveNetworkID() int64 {
+	if !m.SubnetID.IsNull() && !m.SubnetID.IsUnknown() && m.SubnetID.ValueString() != "" {
+		if subnetNetwork, _, err := ParseSubnetID(m.SubnetID.ValueString()); err == nil {
+			return subnetNetwork.ID
+		}
+		return 0
+	}
+	if !m.NetworkID.IsNull() && !m.NetworkID.IsUnknown() {
+		return m.NetworkID.ValueInt64()
+	}
+	return 0
+}
+
+type modelNetworks []modelNetwork
+
+var _ util.ModelFromAPI[[]hcloud.ServerPrivateNet] = &modelNetworks{}
+var _ util.ModelFromTerraform[types.Set] = &modelNetworks{}
+var _ util.ModelToTerraform[types.Set] = &modelNetworks{}
+
+func (m *modelNetworks) FromAPI(ctx context.Context, hcItems []hcloud.ServerPrivateNet) (diags diag.Diagnostics) {
+	values := make([]modelNetwork, 0, len(hcItems))
+	for _, hcItem := range hcItems {
+		value := modelNetwork{}
+		diags.Append(value.FromAPI(ctx, hcItem)...)
+
+		values = append(values, value)
+	}
+
+	*m = values
+
+	return diags
+}
+
+func (m *modelNetworks) FromTerraform(ctx context.Context, tf types.Set) diag.Diagnostics {
+	*m = make(modelNetworks, 0, len(tf.Elements()))
+	return tf.ElementsAs(ctx, m, false)
+}
+
+func (m *modelNetworks) ToTerraform(ctx context.Context) (tf types.Set, diags diag.Diagnostics) {
+	tfItems := make([]attr.Value, 0, len(*m))
+	for _, value := range *m {
+		tfItem, newDiags := value.ToTerraform(ctx)
+		diags.Append(newDiags...)
+
+		tfItems = append(tfItems, tfItem)
+	}
+
+	tf, newDiags := types.SetValue((&modelNetwork{}).tfType(), tfItems)
+	diags.Append(newDiags...)
+
+	return tf, diags
+}
+
+// find returns the network matching the network ID, or nil if none matches.
+func (m modelNetworks) find(networkID int64) *modelNetwork {
+	if networkID == 0 {
+		return nil
+	}
+	for i := range m {
+		if m[i].resolveNetworkID() == networkID {
+			return &m[i]
+		}
+	}
+	return nil
+}
+
+type networkIdentityModel struct {
+	ServerID  types.Int64 `tfsdk:"server_id"`
+	NetworkID types.Int64 `tfsdk:"network_id"`
+}
+
+func newNetworkIdentity(data networkResourceData) networkIdentityModel {
+	return networkIdentityModel{
+		ServerID:  data.ServerID,
+		NetworkID: data.NetworkID,
+	}
+}
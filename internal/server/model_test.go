@@ -0,0 +1,108 @@
+package server
+
+import (
+	"net"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func TestModel(t *testing.T) {
+	ctx := t.Context()
+
+	_, ipv6Network, err := net.ParseCIDR("2001:db8::/64")
+	require.NoError(t, err)
+
+	in := &hcloud.Server{
+		ID:         42,
+		Name:       "server",
+		Status:     hcloud.ServerStatusRunning,
+		ServerType: &hcloud.ServerType{Name: "cpx22"},
+		Location:   &hcloud.Location{Name: "fsn1"},
+		Image:      &hcloud.Image{ID: 114690387, Name: "debian-13"},
+		PublicNet: hcloud.ServerPublicNet{
+			IPv4: hcloud.ServerPublicNetIPv4{ID: 1, IP: net.ParseIP("131.232.99.42")},
+			IPv6: hcloud.ServerPublicNetIPv6{ID: 2, IP: ipv6Network.IP, Network: ipv6Network},
+			Firewalls: []*hcloud.ServerFirewallStatus{
+				{Firewall: hcloud.Firewall{ID: 10}},
+			},
+		},
+		PrivateNet: []hcloud.ServerPrivateNet{
+			{
+				Network:    &hcloud.Network{ID: 4711},
+				IP:         net.ParseIP("10.0.1.5"),
+				Aliases:    []net.IP{net.ParseIP("10.0.1.6")},
+				MACAddress: "86:00:00:2a:7d:e0",
+			},
+		},
+		PrimaryDiskSize: 80,
+		Labels:          map[string]string{"key": "value"},
+		Protection:      hcloud.ServerProtection{Delete: true},
+	}
+
+	t.Run("without networks", func(t *testing.T) {
+		o := &model{}
+		assert.Nil(t, o.FromAPI(ctx, in))
+		assert.Equal(t, int64(42), o.ID.ValueInt64())
+		assert.Equal(t, "server", o.Name.ValueString())
+		assert.Equal(t, "running", o.Status.ValueString())
+		assert.Equal(t, "cpx22", o.ServerType.ValueString())
+		assert.Equal(t, "fsn1", o.Location.ValueString())
+		assert.True(t, o.Datacenter.IsNull())
+		assert.Equal(t, "debian-13", o.Image.ValueString())
+		assert.Equal(t, "131.232.99.42", o.IPv4Address.ValueString())
+		assert.Equal(t, "2001:db8::1", o.IPv6Address.ValueString())
+		assert.Equal(t, "2001:db8::/64", o.IPv6Network.ValueString())
+		assert.Equal(t, int64(80), o.PrimaryDiskSize.ValueInt64())
+		assert.True(t, o.PlacementGroupID.IsNull())
+		assert.False(t, o.Backups.ValueBool())
+		assert.True(t, o.DeleteProtection.ValueBool())
+		assert.False(t, o.RebuildProtection.ValueBool())
+
+		firewallIDs := []int64{}
+		assert.Nil(t, o.FirewallIDs.ElementsAs(ctx, &firewallIDs, false))
+		assert.Equal(t, []int64{10}, firewallIDs)
+
+		labels := map[string]string{}
+		assert.Nil(t, o.Labels.ElementsAs(ctx, &labels, false))
+		assert.Equal(t, map[string]string{"key": "value"}, labels)
+
+		assert.Empty(t, o.PublicNet.Elements())
+		assert.Empty(t, o.Network.Elements())
+	})
+
+	t.Run("image id", func(t *testing.T) {
+		o := &model{Image: types.StringValue("114690387")}
+		assert.Nil(t, o.FromAPI(ctx, in))
+		assert.Equal(t, "114690387", o.Image.ValueString())
+	})
+
+	t.Run("with networks", func(t *testing.T) {
+		prior := modelNetworks{{
+			NetworkID:  types.Int64Value(4711),
+			SubnetID:   types.StringValue("4711-10.0.1.0/24"),
+			IP:         iptypes.NewIPAddressUnknown(),
+			AliasIPs:   types.SetUnknown(iptypes.IPAddressType{}),
+			MACAddress: types.StringUnknown(),
+		}}
+
+		o := &model{}
+		o.Network, _ = prior.ToTerraform(ctx)
+		assert.Nil(t, o.FromAPI(ctx, in))
+
+		networks := modelNetworks{}
+		assert.Nil(t, networks.FromTerraform(ctx, o.Network))
+		require.Len(t, networks, 1)
+		assert.Equal(t, int64(4711), networks[0].NetworkID.ValueInt64())
+		assert.Equal(t, "4711-10.0.1.0/24", networks[0].SubnetID.ValueString())
+		assert.Equal(t, "10.0.1.5", networks[0].IP.ValueString())
+		assert.Equal(t, []attr.Value{iptypes.NewIPAddressValue("10.0.1.6")}, networks[0].AliasIPs.Elements())
+		assert.Equal(t, "86:00:00:2a:7d:e0", networks[0].MACAddress.ValueString())
+	})
+}
@@ -6,950 +6,1041 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
-	"log"
 	"net"
+	"strconv"
 	"strings"
 	"time"
 
-	"github.com/hashicorp/go-cty/cty"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/deprecationutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/kit/sliceutil"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/primaryip"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/control"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/validateutil"
 )
 
 // ResourceType is the type name of the Hetzner Cloud Server resource.
 const ResourceType = "hcloud_server"
 
-// Resource creates a Terraform schema for the hcloud_server resource.
-func Resource() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceServerCreate,
-		ReadContext:   resourceServerRead,
-		UpdateContext: resourceServerUpdate,
-		DeleteContext: resourceServerDelete,
-		CustomizeDiff: resourceServerCustomizeDiff,
-
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(90 * time.Minute),
-		},
+const ChangeDeprecatedServerTypeMessage = `Existing servers of that plan will ` +
+	`continue to work as before and no action is required on your part. ` +
+	`It is possible to migrate this Server to another Server Type by using ` +
+	`the "hcloud server change-type" command.`
 
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"server_type": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"image": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
-				ValidateFunc: func(val any, key string) (i []string, errors []error) {
-					image := val.(string)
-					if len(image) == 0 {
-						errors = append(errors, fmt.Errorf("%q must have more than 0 characters. Have you set the name instead of an ID?", key))
-					}
-					return
-				},
-			},
-			"location": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Computed: true,
-			},
-			"datacenter": {
-				Type:       schema.TypeString,
-				Optional:   true,
-				Computed:   true,
-				Deprecated: datacenterDeprecationMessage,
-				ValidateDiagFunc: func(_ any, path cty.Path) diag.Diagnostics {
-					return diag.Diagnostics{diag.Diagnostic{
-						AttributePath: path,
-						Severity:      diag.Error,
-						Summary:       "Removed Attribute",
-						Detail:        datacenterDeprecationMessage,
-					}}
-				},
-			},
-			"user_data": {
-				Type:             schema.TypeString,
-				Optional:         true,
-				ForceNew:         true,
-				DiffSuppressFunc: userDataDiffSuppress,
-				StateFunc: func(v any) string {
-					switch x := v.(type) {
-					case string:
-						return userDataHashSum(x)
-					default:
-						return ""
-					}
-				},
-			},
-			"ssh_keys": {
-				Type:     schema.TypeList,
-				Optional: true,
-				Elem: &schema.Schema{Type: schema.TypeString,
-					ValidateDiagFunc: func(i any, _ cty.Path) diag.Diagnostics {
-						s := i.(string)
-						if len(s) == 0 {
-							return diag.Errorf("Invalid ssh key passed. You need to pass a string with at least 1 character")
-						}
-
-						return nil
-					}},
-				ForceNew: true,
-			},
-			"keep_disk": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
-			"allow_deprecated_images": {
-				Deprecated: "Unused attribute, consider removing it from your configuration.",
-				Type:       schema.TypeBool,
-				Optional:   true,
+var _ resource.Resource = (*Resource)(nil)
+var _ resource.ResourceWithConfigure = (*Resource)(nil)
+var _ resource.ResourceWithConfigValidators = (*Resource)(nil)
+var _ resource.ResourceWithValidateConfig = (*Resource)(nil)
+var _ resource.ResourceWithModifyPlan = (*Resource)(nil)
+var _ resource.ResourceWithImportState = (*Resource)(nil)
+var _ resource.ResourceWithIdentity = (*Resource)(nil)
+var _ resource.ResourceWithUpgradeState = (*Resource)(nil)
+
+type Resource struct {
+	client *hcloud.Client
+}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+func (r *Resource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = ResourceType
+}
+
+func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	r.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.Version = 1
+	resp.Schema.MarkdownDescription = util.MarkdownDescription(`
+Provides an Hetzner Cloud server resource. This can be used to create, modify, and delete servers.
+
+See the [Servers API documentation](https://docs.hetzner.cloud/reference/cloud#tag/servers) for more details.
+`)
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Server.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
 			},
-			"backup_window": {
-				Type:       schema.TypeString,
-				Deprecated: "You should remove this property from your terraform configuration.",
-				Computed:   true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the Server.",
+			Required:            true,
+		},
+		"server_type": schema.StringAttribute{
+			MarkdownDescription: "Name of the Server Type of the Server.",
+			Required:            true,
+		},
+		"image": schema.StringAttribute{
+			MarkdownDescription: "Name or ID of the Image the Server is created from.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
 			},
-			"backups": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+			Validators: []validator.String{
+				stringvalidator.LengthAtLeast(1),
 			},
-			"ipv4_address": {
-				Type:     schema.TypeString,
-				Computed: true,
+		},
+		"location": schema.StringAttribute{
+			MarkdownDescription: "Name of the Location to create the Server in.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
 			},
-			"ipv6_address": {
-				Type:     schema.TypeString,
-				Computed: true,
+		},
+		"datacenter": schema.StringAttribute{
+			MarkdownDescription: "Name of the Datacenter to create the Server in.",
+			Optional:            true,
+			Computed:            true,
+			DeprecationMessage:  datacenterDeprecationMessage,
+			Validators:          []validator.String{validateutil.IsRemoved(datacenterDeprecationMessage)},
+		},
+		"user_data": schema.StringAttribute{
+			MarkdownDescription: "Cloud-Init user data to use during Server creation. This field is limited to 32KiB. Only a hash of the user data is stored in the state.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIf(
+					userDataRequiresReplace,
+					"Requires a replacement of the Server, unless the user data is equivalent to the user data in the state.",
+					"Requires a replacement of the Server, unless the user data is equivalent to the user data in the state.",
+				),
 			},
-			"ipv6_network": {
-				Type:     schema.TypeString,
-				Computed: true,
+		},
+		"user_data_wo": schema.StringAttribute{
+			MarkdownDescription: "Cloud-Init user data to use during Server creation, the value is never stored in the state. This field is limited to 32KiB. Must be used together with `user_data_wo_version`.",
+			Optional:            true,
+			Sensitive:           true,
+			WriteOnly:           true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("user_data_wo_version")),
 			},
-			"status": {
-				Type:     schema.TypeString,
-				Computed: true,
+		},
+		"user_data_wo_version": schema.Int64Attribute{
+			MarkdownDescription: "Version of the `user_data_wo` attribute. Changing the version recreates the Server.",
+			Optional:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
 			},
-			"iso": {
-				Type:     schema.TypeString,
-				Optional: true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("user_data_wo")),
 			},
-			"rescue": {
-				Type:     schema.TypeString,
-				Optional: true,
+		},
+		"ssh_keys": schema.ListAttribute{
+			MarkdownDescription: "SSH Key IDs or names which should be injected into the Server at creation time.",
+			ElementType:         types.StringType,
+			Optional:            true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.RequiresReplace(),
 			},
-			"labels": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				ValidateDiagFunc: func(i any, path cty.Path) diag.Diagnostics { // nolint:revive
-					if ok, err := hcloud.ValidateResourceLabels(i.(map[string]any)); !ok {
-						return diag.FromErr(err)
-					}
-					return nil
-				},
+		},
+		"keep_disk": schema.BoolAttribute{
+			MarkdownDescription: "If true, do not upgrade the disk. This allows downgrading the Server Type later.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"allow_deprecated_images": schema.BoolAttribute{
+			MarkdownDescription: "Unused attribute, consider removing it from your configuration.",
+			Optional:            true,
+			DeprecationMessage:  "Unused attribute, consider removing it from your configuration.",
+		},
+		"backup_window": schema.StringAttribute{
+			MarkdownDescription: "The backup window of the Server, if enabled.",
+			Computed:            true,
+			DeprecationMessage:  "You should remove this property from your terraform configuration.",
+		},
+		"backups": schema.BoolAttribute{
+			MarkdownDescription: "Whether backups are enabled.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"ipv4_address": schema.StringAttribute{
+			MarkdownDescription: "The IPv4 address of the Server.",
+			Computed:            true,
+		},
+		"ipv6_address": schema.StringAttribute{
+			MarkdownDescription: "The first IPv6 address of the assigned IPv6 network.",
+			Computed:            true,
+		},
+		"ipv6_network": schema.StringAttribute{
+			MarkdownDescription: "The IPv6 network of the Server.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The status of the Server.",
+			Computed:            true,
+		},
+		"iso": schema.StringAttribute{
+			MarkdownDescription: "ID or Name of an ISO image to mount.",
+			Optional:            true,
+		},
+		"rescue": schema.StringAttribute{
+			MarkdownDescription: "Enable and boot in to the specified rescue system.",
+			Optional:            true,
+		},
+		"labels": resourceutil.LabelsSchema(),
+		"ignore_remote_firewall_ids": schema.BoolAttribute{
+			MarkdownDescription: "Ignores any updates to the `firewall_ids` argument which were received from the Server.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"firewall_ids": schema.SetAttribute{
+			MarkdownDescription: "Firewall IDs the Server should be attached to.",
+			ElementType:         types.Int64Type,
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Set{
+				setplanmodifier.UseStateForUnknown(),
 			},
-			"public_net": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				DiffSuppressFunc: func(_, _, _ string, d *schema.ResourceData) bool {
-					// Diff is only valid if "public_net" resource is set in
-					// terraform configuration.
-					_, ok := d.GetOk("public_net")
-					return !ok // Negate because we do **not** want to suppress the diff.
-				},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"ipv4_enabled": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  true,
-						},
-						"ipv6_enabled": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  true,
-						},
-						"ipv4": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Computed: true,
-						},
-						"ipv6": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Computed: true,
-						},
+		},
+		"placement_group_id": schema.Int64Attribute{
+			MarkdownDescription: "Placement Group ID the Server is added to.",
+			Optional:            true,
+		},
+		"delete_protection": schema.BoolAttribute{
+			MarkdownDescription: "Whether delete protection is enabled.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"rebuild_protection": schema.BoolAttribute{
+			MarkdownDescription: "Whether rebuild protection is enabled.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"shutdown_before_deletion": schema.BoolAttribute{
+			MarkdownDescription: "Whether to try shutting the Server down gracefully before deleting it.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"primary_disk_size": schema.Int64Attribute{
+			MarkdownDescription: "The size of the primary disk in GB.",
+			Computed:            true,
+		},
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
+		"public_net": schema.SetNestedBlock{
+			MarkdownDescription: "Enable or disable the public IPv4 and IPv6 of the Server, or link existing Primary IPs. If this block is not defined, two Primary IPs (IPv4 & IPv6) are auto generated.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"ipv4_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the Server has a public IPv4. Defaults to `true`.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"ipv6_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the Server has a public IPv6. Defaults to `true`.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"ipv4": schema.Int64Attribute{
+						MarkdownDescription: "ID of the IPv4 Primary IP to assign to the Server.",
+						Optional:            true,
+					},
+					"ipv6": schema.Int64Attribute{
+						MarkdownDescription: "ID of the IPv6 Primary IP to assign to the Server.",
+						Optional:            true,
 					},
 				},
 			},
-			"network": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				DiffSuppressFunc: func(_, _, _ string, d *schema.ResourceData) bool {
-					// Diff is only valid if "network" resource is set in
-					// terraform configuration.
-					_, ok := d.GetOk("network")
-					return !ok // Negate because we do **not** want to suppress the diff.
-				},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"network_id": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Computed: true,
-						},
-						"subnet_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-						},
-						"ip": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Optional: true,
-						},
-						"alias_ips": {
-							Type:     schema.TypeSet,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-							Computed: true,
-							Optional: true,
+		},
+		"network": schema.SetNestedBlock{
+			MarkdownDescription: "Network the Server should be attached to. Can be specified multiple times.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"network_id": schema.Int64Attribute{
+						MarkdownDescription: "ID of the Network to attach the Server to. Using `subnet_id` is preferred. Required if `subnet_id` is not set.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"subnet_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the Subnet to attach the Server to. Required if `network_id` is not set.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"ip": schema.StringAttribute{
+						CustomType:          iptypes.IPAddressType{},
+						MarkdownDescription: "IP to assign to the Server in the Network.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							validateutil.IP(),
 						},
-						"mac_address": {
-							Type:     schema.TypeString,
-							Computed: true,
+					},
+					"alias_ips": schema.SetAttribute{
+						MarkdownDescription: "Additional IPs to assign to the Server in the Network.",
+						ElementType:         iptypes.IPAddressType{},
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(validateutil.IP()),
 						},
 					},
+					"mac_address": schema.StringAttribute{
+						MarkdownDescription: "MAC address of the Server in the Network.",
+						Computed:            true,
+					},
 				},
 			},
-			"ignore_remote_firewall_ids": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
-			"firewall_ids": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Computed: true,
-				Elem:     &schema.Schema{Type: schema.TypeInt},
-				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool { // nolint:revive
-					sup := d.Get("ignore_remote_firewall_ids").(bool)
-					if sup && oldValue != "" && newValue != "" {
-						return true
-					}
-					return false
-				},
-			},
-			"placement_group_id": {
-				Type:     schema.TypeInt,
-				Optional: true,
-			},
-			"delete_protection": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
-			"rebuild_protection": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
-			"shutdown_before_deletion": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
-			"primary_disk_size": {
-				Type:     schema.TypeInt,
-				Computed: true,
-			},
 		},
 	}
 }
 
-func userDataHashSum(userData string) string {
-	sum := sha1.Sum([]byte(userData)) // nolint: gosec
-	return base64.StdEncoding.EncodeToString(sum[:])
+func (r *Resource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("user_data"),
+			path.MatchRoot("user_data_wo"),
+		),
+	}
+}
+
+func (r *Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data model
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.SSHKeys.IsNull() && !data.SSHKeys.IsUnknown() {
+		for i, item := range data.SSHKeys.Elements() {
+			if value, ok := item.(types.String); ok && !value.IsUnknown() && value.ValueString() == "" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("ssh_keys").AtListIndex(i),
+					"Invalid ssh key passed",
+					"You need to pass a string with at least 1 character.",
+				)
+			}
+		}
+	}
+
+	if !data.Network.IsNull() && !data.Network.IsUnknown() {
+		networks := modelNetworks{}
+		resp.Diagnostics.Append(networks.FromTerraform(ctx, data.Network)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		uniqueNetworkIDs := make(map[int64]bool, len(networks))
+
+		for _, item := range networks {
+			if item.NetworkID.IsNull() && item.SubnetID.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("network"),
+					"Invalid Attribute Combination",
+					"Each network block must specify either network_id or subnet_id.",
+				)
+				continue
+			}
+
+			// The network ID is 0 if the network will be created in the same apply, we are
+			// unable to reliably detect if the "to-be-created" networks are the same.
+			var networkID int64
+
+			if !item.SubnetID.IsNull() && !item.SubnetID.IsUnknown() {
+				subnetNetwork, subnetIPRange, err := ParseSubnetID(item.SubnetID.ValueString())
+				if err != nil {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("network"),
+						"Invalid Subnet ID",
+						util.TitleCase(err.Error()),
+					)
+					continue
+				}
+
+				// If the user specified both network_id and subnet_id, they must match.
+				if !item.NetworkID.IsNull() && !item.NetworkID.IsUnknown() && subnetNetwork.ID != item.NetworkID.ValueInt64() {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("network"),
+						"Invalid Attribute Combination",
+						fmt.Sprintf("The subnet_id (%s) does not belong to the specified network_id (%d).", item.SubnetID.ValueString(), item.NetworkID.ValueInt64()),
+					)
+					continue
+				}
+
+				networkID = subnetNetwork.ID
+
+				// Check if the server IP is within the subnet IP range
+				if !item.IP.IsNull() && !item.IP.IsUnknown() {
+					ip := net.ParseIP(item.IP.ValueString())
+					if ip != nil && !subnetIPRange.Contains(ip) {
+						resp.Diagnostics.AddAttributeError(
+							path.Root("network"),
+							"Invalid Attribute Value",
+							fmt.Sprintf("The server IP (%s) is outside subnet IP range (%s).", ip.String(), subnetIPRange.String()),
+						)
+						continue
+					}
+				}
+			} else if !item.NetworkID.IsUnknown() {
+				networkID = item.NetworkID.ValueInt64()
+			}
+
+			if networkID == 0 {
+				continue
+			}
+
+			if uniqueNetworkIDs[networkID] {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("network"),
+					"Invalid Attribute Value",
+					fmt.Sprintf("A server is only allowed to be attached to each network once: %d", networkID),
+				)
+				continue
+			}
+			uniqueNetworkIDs[networkID] = true
+		}
+	}
 }
 
-func userDataDiffSuppress(k, oldValue, newValue string, d *schema.ResourceData) bool {
-	userData := d.Get(k).(string)
-	if newValue != "" && userData != "" {
-		if _, err := base64.StdEncoding.DecodeString(oldValue); err != nil {
-			return userDataHashSum(oldValue) == newValue
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Do not modify on resource destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan model
+	var state *model
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		state = &model{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The public_net and network blocks are rebuilt from the configuration, as the
+	// plan proposed by Terraform cannot correlate set elements with computed
+	// attributes.
+	if !config.PublicNet.IsUnknown() {
+		publicNets := modelPublicNets{}
+		resp.Diagnostics.Append(publicNets.FromTerraform(ctx, config.PublicNet)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for i := range publicNets {
+			if publicNets[i].IPv4Enabled.IsNull() {
+				publicNets[i].IPv4Enabled = types.BoolValue(true)
+			}
+			if publicNets[i].IPv6Enabled.IsNull() {
+				publicNets[i].IPv6Enabled = types.BoolValue(true)
+			}
 		}
+
+		value, newDiags := publicNets.ToTerraform(ctx)
+		resp.Diagnostics.Append(newDiags...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("public_net"), value)...)
+	}
+
+	if !config.Network.IsUnknown() {
+		networks := modelNetworks{}
+		resp.Diagnostics.Append(networks.FromTerraform(ctx, config.Network)...)
+
+		priorNetworks := modelNetworks{}
+		if state != nil && !state.Network.IsNull() {
+			resp.Diagnostics.Append(priorNetworks.FromTerraform(ctx, state.Network)...)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for i := range networks {
+			item := &networks[i]
+			networkID := item.resolveNetworkID()
+			prior := priorNetworks.find(networkID)
+
+			if item.NetworkID.IsNull() {
+				if networkID != 0 {
+					item.NetworkID = types.Int64Value(networkID)
+				} else {
+					item.NetworkID = types.Int64Unknown()
+				}
+			}
+			if item.SubnetID.IsNull() && prior != nil {
+				item.SubnetID = prior.SubnetID
+			}
+			if item.IP.IsNull() {
+				if prior != nil && !prior.IP.IsNull() {
+					item.IP = prior.IP
+				} else {
+					item.IP = iptypes.NewIPAddressUnknown()
+				}
+			}
+			if item.AliasIPs.IsNull() {
+				if prior != nil && !prior.AliasIPs.IsNull() {
+					item.AliasIPs = prior.AliasIPs
+				} else {
+					item.AliasIPs = types.SetUnknown(iptypes.IPAddressType{})
+				}
+			}
+			if prior != nil && item.IP.Equal(prior.IP) {
+				item.MACAddress = prior.MACAddress
+			} else {
+				item.MACAddress = types.StringUnknown()
+			}
+		}
+
+		value, newDiags := networks.ToTerraform(ctx)
+		resp.Diagnostics.Append(newDiags...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("network"), value)...)
+	}
+
+	// Only a hash of the user data is stored in the state, keep it as long as the
+	// configured user data does not change.
+	if state != nil && userDataEquivalent(state.UserData, config.UserData) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("user_data"), state.UserData)...)
+	}
+
+	// Ignore the changes to the firewall IDs, they are managed by the
+	// hcloud_firewall_attachment resource.
+	if state != nil &&
+		plan.IgnoreRemoteFirewallIDs.ValueBool() &&
+		!config.FirewallIDs.IsNull() &&
+		!state.FirewallIDs.IsNull() && len(state.FirewallIDs.Elements()) > 0 {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("firewall_ids"), state.FirewallIDs)...)
 	}
-	return strings.TrimSpace(oldValue) == strings.TrimSpace(newValue)
 }
 
-const ChangeDeprecatedServerTypeMessage = `Existing servers of that plan will ` +
-	`continue to work as before and no action is required on your part. ` +
-	`It is possible to migrate this Server to another Server Type by using ` +
-	`the "hcloud server change-type" command.`
+func (r *Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceutil.IDIdentitySchema("ID of the Server.")
+}
 
-func resourceServerCreate(ctx context.Context, d *schema.ResourceData, m any) (diags diag.Diagnostics) {
-	c := m.(*hcloud.Client)
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data model
+	var userDataWO types.String
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("user_data_wo"), &userDataWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 90*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
 
 	// Get server type to select correct image (based on arch)
-	serverType, _, err := c.ServerType.Get(ctx, d.Get("server_type").(string))
+	serverType, _, err := r.client.ServerType.Get(ctx, data.ServerType.ValueString())
 	if err != nil {
-		diags = append(diags, hcloudutil.ErrorToDiag(err)...)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
 		return
 	}
 	if serverType == nil {
-		diags = append(diags, diag.Errorf("server type %s not found", d.Get("server_type"))...)
+		resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("server type", "name", data.ServerType.ValueString()))
 		return
 	}
 
-	imageNameOrID := d.Get("image").(string)
-	image, _, err := c.Image.GetForArchitecture(ctx, imageNameOrID, serverType.Architecture)
+	image, _, err := r.client.Image.GetForArchitecture(ctx, data.Image.ValueString(), serverType.Architecture)
 	if err != nil {
-		diags = append(diags, hcloudutil.ErrorToDiag(err)...)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
 		return
 	}
-
 	if image == nil {
-		diags = append(diags, diag.Errorf("image %s for architecture %s not found", imageNameOrID, serverType.Architecture)...)
+		resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("image", "name", data.Image.ValueString(), "architecture", serverType.Architecture))
 		return
 	}
 
 	if message, unavailable := deprecationutil.ImageMessage(image); message != "" {
 		if unavailable {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  "Image Unavailable",
-				Detail:   message + ".",
-			})
+			resp.Diagnostics.AddError("Image Unavailable", message+".")
 			return
 		}
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Warning,
-			Summary:  "Image Deprecated",
-			Detail:   message + ".",
-		})
+		resp.Diagnostics.AddWarning("Image Deprecated", message+".")
 	}
 
 	opts := hcloud.ServerCreateOpts{
-		Name: d.Get("name").(string),
-		ServerType: &hcloud.ServerType{
-			Name: d.Get("server_type").(string),
-		},
-		Image:    image,
-		UserData: d.Get("user_data").(string),
+		Name:       data.Name.ValueString(),
+		ServerType: &hcloud.ServerType{Name: data.ServerType.ValueString()},
+		Image:      image,
+	}
+
+	switch {
+	case !userDataWO.IsNull():
+		opts.UserData = userDataWO.ValueString()
+	case !data.UserData.IsNull():
+		opts.UserData = data.UserData.ValueString()
 	}
 
-	locationName := ""
-	if location, ok := d.GetOk("location"); ok {
-		locationName = location.(string)
-		opts.Location = &hcloud.Location{Name: locationName}
+	if !data.Location.IsUnknown() && !data.Location.IsNull() {
+		opts.Location = &hcloud.Location{Name: data.Location.ValueString()}
 	}
 
 	serverTypeDeprecationPrinted := false
-	if message, isUnavailable := deprecationutil.ServerTypeMessage(serverType, locationName); message != "" {
+	if message, unavailable := deprecationutil.ServerTypeMessage(serverType, data.Location.ValueString()); message != "" {
 		serverTypeDeprecationPrinted = true
-		deprecationDiag := diag.Diagnostic{
-			Severity: diag.Warning,
-			Summary:  message,
-			Detail:   ChangeDeprecatedServerTypeMessage,
-		}
-		if isUnavailable {
-			deprecationDiag.Severity = diag.Error
-			diags = append(diags, deprecationDiag)
+		if unavailable {
+			resp.Diagnostics.AddError(message, ChangeDeprecatedServerTypeMessage)
 			return
 		}
-
-		diags = append(diags, deprecationDiag)
+		resp.Diagnostics.AddWarning(message, ChangeDeprecatedServerTypeMessage)
 	}
 
-	opts.SSHKeys, err = getSSHkeys(ctx, c, d)
+	opts.SSHKeys, err = getSSHKeys(ctx, r.client, data.SSHKeys)
 	if err != nil {
-		diags = append(diags, hcloudutil.ErrorToDiag(err)...)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
 		return
 	}
 
-	if labels, ok := d.GetOk("labels"); ok {
-		tmpLabels := make(map[string]string)
-		for k, v := range labels.(map[string]any) {
-			tmpLabels[k] = v.(string)
+	resp.Diagnostics.Append(hcloudutil.TerraformLabelsToHCloud(ctx, data.Labels, &opts.Labels)...)
+
+	if !data.FirewallIDs.IsUnknown() && !data.FirewallIDs.IsNull() {
+		firewallIDs := make([]int64, 0, len(data.FirewallIDs.Elements()))
+		resp.Diagnostics.Append(data.FirewallIDs.ElementsAs(ctx, &firewallIDs, false)...)
+
+		for _, firewallID := range firewallIDs {
+			opts.Firewalls = append(opts.Firewalls, &hcloud.ServerCreateFirewall{Firewall: hcloud.Firewall{ID: firewallID}})
 		}
-		opts.Labels = tmpLabels
 	}
 
-	if firewallIDs, ok := d.GetOk("firewall_ids"); ok {
-		for _, firewallID := range firewallIDs.(*schema.Set).List() {
-			opts.Firewalls = append(opts.Firewalls, &hcloud.ServerCreateFirewall{Firewall: hcloud.Firewall{ID: util.CastInt64(firewallID)}})
-		}
+	publicNets := modelPublicNets{}
+	resp.Diagnostics.Append(publicNets.FromTerraform(ctx, data.PublicNet)...)
+
+	networks := modelNetworks{}
+	resp.Diagnostics.Append(networks.FromTerraform(ctx, data.Network)...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if placementGroupID, ok := d.GetOk("placement_group_id"); ok {
-		placementGroup, err := getPlacementGroup(ctx, c, util.CastInt64(placementGroupID))
+	if !data.PlacementGroupID.IsNull() {
+		opts.PlacementGroup, err = getPlacementGroup(ctx, r.client, data.PlacementGroupID.ValueInt64())
 		if err != nil {
-			diags = append(diags, hcloudutil.ErrorToDiag(err)...)
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
 			return
 		}
-
-		opts.PlacementGroup = placementGroup
 	}
 
-	if publicNet, ok := d.GetOk("public_net"); ok {
-		createPublicNet := hcloud.ServerCreatePublicNet{}
-		for _, publicNetBlock := range publicNet.(*schema.Set).List() {
-			publicNetEntry := publicNetBlock.(map[string]any)
-			if enableIPv4, err := ToPublicNetField[bool](publicNetEntry, "ipv4_enabled"); err == nil {
-				createPublicNet.EnableIPv4 = enableIPv4
-			}
-			if enableIPv6, err := ToPublicNetField[bool](publicNetEntry, "ipv6_enabled"); err == nil {
-				createPublicNet.EnableIPv6 = enableIPv6
-			}
-			if ipv4, err := ToPublicNetField[int](publicNetEntry, "ipv4"); err == nil && ipv4 != 0 {
-				createPublicNet.EnableIPv4 = true
-				createPublicNet.IPv4 = &hcloud.PrimaryIP{ID: util.CastInt64(ipv4)}
+	// If the server has no public net and is attached to a network, it has to be
+	// created without starting it, and powered on after the network attachment.
+	createWithoutPublicNet := false
+
+	if len(publicNets) > 0 {
+		opts.PublicNet = &hcloud.ServerCreatePublicNet{}
+		for _, item := range publicNets {
+			opts.PublicNet.EnableIPv4 = item.IPv4Enabled.ValueBool()
+			opts.PublicNet.EnableIPv6 = item.IPv6Enabled.ValueBool()
+
+			if item.IPv4.ValueInt64() != 0 {
+				opts.PublicNet.EnableIPv4 = true
+				opts.PublicNet.IPv4 = &hcloud.PrimaryIP{ID: item.IPv4.ValueInt64()}
 			}
-			if ipv6, err := ToPublicNetField[int](publicNetEntry, "ipv6"); err == nil && ipv6 != 0 {
-				createPublicNet.EnableIPv6 = true
-				createPublicNet.IPv6 = &hcloud.PrimaryIP{ID: util.CastInt64(ipv6)}
+			if item.IPv6.ValueInt64() != 0 {
+				opts.PublicNet.EnableIPv6 = true
+				opts.PublicNet.IPv6 = &hcloud.PrimaryIP{ID: item.IPv6.ValueInt64()}
 			}
 		}
-		opts.PublicNet = &createPublicNet
-		// if the server has no public net, it has to be created without starting it
-		if err := onServerCreateWithoutPublicNet(&opts, d, func(opts *hcloud.ServerCreateOpts) error {
+
+		if len(networks) > 0 && !opts.PublicNet.EnableIPv4 && !opts.PublicNet.EnableIPv6 {
+			createWithoutPublicNet = true
 			opts.StartAfterCreate = new(false)
-			return nil
-		}); err != nil {
-			diags = append(diags, err...)
-			return
 		}
 	}
-	res, _, err := c.Server.Create(ctx, opts)
+
+	result, _, err := r.client.Server.Create(ctx, opts)
 	if err != nil {
-		diags = append(diags, hcloudutil.ErrorToDiag(err)...)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
 		return
 	}
-	d.SetId(util.FormatID(res.Server.ID))
+
+	// Make sure to save the ID immediately so we can recover if the process stops after
+	// this call. Terraform marks the resource as "tainted", so it can be deleted and no
+	// surprise "duplicate resource" errors happen.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(result.Server.ID))...)
 
 	if !serverTypeDeprecationPrinted {
 		// We now know the server location and can check the server type deprecation again.
-		if message, _ := deprecationutil.ServerTypeMessage(res.Server.ServerType, res.Server.Location.Name); message != "" {
-			deprecationDiag := diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  message,
-				Detail:   ChangeDeprecatedServerTypeMessage,
-			}
-			diags = append(diags, deprecationDiag)
+		if message, _ := deprecationutil.ServerTypeMessage(result.Server.ServerType, result.Server.Location.Name); message != "" {
+			resp.Diagnostics.AddWarning(message, ChangeDeprecatedServerTypeMessage)
 		}
 	}
 
-	if err = c.Action.WaitFor(ctx, res.Action); err != nil {
-		diags = append(diags, hcloudutil.ErrorToDiag(err)...)
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, append([]*hcloud.Action{result.Action}, result.NextActions...)...)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	for _, nextAction := range res.NextActions {
-		if err = c.Action.WaitFor(ctx, nextAction); err != nil {
-			diags = append(diags, hcloudutil.ErrorToDiag(err)...)
+
+	server := result.Server
+
+	for _, item := range networks {
+		if err := attachServerToNetworkFromModel(ctx, r.client, server, item); err != nil {
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
 			return
 		}
 	}
 
-	if nwSet, ok := d.GetOk("network"); ok {
-		for _, item := range nwSet.(*schema.Set).List() {
-			nwData := item.(map[string]any)
-			if err := inlineAttachServerToNetwork(ctx, c, res.Server, nwData); err != nil {
-				diags = append(diags, hcloudutil.ErrorToDiag(err)...)
-				return
-			}
-		}
-		// if the server was created without public net, the server is now still offline and has to be powered on after
-		// network assignment
-		if err := onServerCreateWithoutPublicNet(&opts, d, func(_ *hcloud.ServerCreateOpts) error {
-			return powerOnServer(ctx, c, res.Server)
-		}); err != nil {
-			diags = append(diags, err...)
+	if createWithoutPublicNet {
+		if err := powerOnServer(ctx, r.client, server); err != nil {
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
 			return
 		}
 	}
 
-	backups := d.Get("backups").(bool)
-	if err := setBackups(ctx, c, res.Server, backups); err != nil {
-		diags = append(diags, hcloudutil.ErrorToDiag(err)...)
+	if err := setBackups(ctx, r.client, server, data.Backups.ValueBool()); err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
 		return
 	}
 
-	if iso, ok := d.GetOk("iso"); ok {
-		if err := setISO(ctx, c, res.Server, iso.(string)); err != nil {
-			diags = append(diags, hcloudutil.ErrorToDiag(err)...)
+	if data.ISO.ValueString() != "" {
+		if err := setISO(ctx, r.client, server, data.ISO.ValueString()); err != nil {
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
 			return
 		}
 	}
 
-	if rescue, ok := d.GetOk("rescue"); ok {
-		if err := setRescue(ctx, c, res.Server, rescue.(string), opts.SSHKeys); err != nil {
-			diags = append(diags, hcloudutil.ErrorToDiag(err)...)
+	if data.Rescue.ValueString() != "" {
+		if err := setRescue(ctx, r.client, server, data.Rescue.ValueString(), opts.SSHKeys); err != nil {
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
 			return
 		}
 	}
 
-	deleteProtection := d.Get("delete_protection").(bool)
-	rebuildProtection := d.Get("rebuild_protection").(bool)
-	if deleteProtection || rebuildProtection {
-		if err := setProtection(ctx, c, res.Server, deleteProtection, rebuildProtection); err != nil {
-			diags = append(diags, hcloudutil.ErrorToDiag(err)...)
+	if data.DeleteProtection.ValueBool() || data.RebuildProtection.ValueBool() {
+		if err := setProtection(ctx, r.client, server, data.DeleteProtection.ValueBool(), data.RebuildProtection.ValueBool()); err != nil {
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
 			return
 		}
 	}
 
-	diags = append(diags, resourceServerRead(ctx, d, m)...)
+	// Fetch fresh data from the API
+	server, _, err = r.client.Server.GetByID(ctx, server.ID)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+	if server == nil {
+		resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("server", "id", result.Server.ID))
+		return
+	}
 
-	return
+	resp.Diagnostics.Append(data.FromAPI(ctx, server)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Never store the user data in the state.
+	data.UserData = userDataHash(data.UserData)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func resourceServerRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data model
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	server, _, err := client.Server.Get(ctx, d.Id())
+	server, _, err := r.client.Server.GetByID(ctx, data.ID.ValueInt64())
 	if err != nil {
-		if resourceServerIsNotFound(err, d) {
-			return nil
-		}
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
 	if server == nil {
-		d.SetId("")
-		return nil
+		resp.State.RemoveResource(ctx)
+		return
 	}
-	setServerSchema(d, server, false)
 
-	d.SetConnInfo(map[string]string{
-		"type": "ssh",
-		"host": server.PublicNet.IPv4.IP.String(),
-	})
+	resp.Diagnostics.Append(data.FromAPI(ctx, server)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	return nil
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func resourceServerUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	c := m.(*hcloud.Client)
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, plan model
 
-	server, _, err := c.Server.Get(ctx, d.Id())
-	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if server == nil {
-		d.SetId("")
-		return nil
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	d.Partial(true)
-	if d.HasChange("name") {
-		newName := d.Get("name")
-		_, _, err := c.Server.Update(ctx, server, hcloud.ServerUpdateOpts{
-			Name: newName.(string),
-		})
-		if err != nil {
-			if resourceServerIsNotFound(err, d) {
-				return nil
-			}
-			return hcloudutil.ErrorToDiag(err)
-		}
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "update", updateTimeout)
+	defer cancel()
+
+	server, _, err := r.client.Server.GetByID(ctx, data.ID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	if d.HasChange("labels") {
-		labels := d.Get("labels")
-		tmpLabels := make(map[string]string)
-		for k, v := range labels.(map[string]any) {
-			tmpLabels[k] = v.(string)
-		}
-		_, _, err := c.Server.Update(ctx, server, hcloud.ServerUpdateOpts{
-			Labels: tmpLabels,
-		})
-		if err != nil {
-			if resourceServerIsNotFound(err, d) {
-				return nil
-			}
-			return hcloudutil.ErrorToDiag(err)
-		}
+	if server == nil {
+		resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("server", "id", data.ID.ValueInt64()))
+		return
 	}
-	if d.HasChange("server_type") {
-		serverType := d.Get("server_type").(string)
-		keepDisk := d.Get("keep_disk").(bool)
 
+	// Action: Change Type
+	if !plan.ServerType.Equal(data.ServerType) {
 		if server.Status == hcloud.ServerStatusRunning {
-			action, _, err := c.Server.Poweroff(ctx, server)
+			action, _, err := r.client.Server.Poweroff(ctx, server)
 			if err != nil {
-				return hcloudutil.ErrorToDiag(err)
+				resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+				return
 			}
-			if err = c.Action.WaitFor(ctx, action); err != nil {
-				return hcloudutil.ErrorToDiag(err)
+
+			resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, action)...)
+			if resp.Diagnostics.HasError() {
+				return
 			}
 		}
 
-		action, _, err := c.Server.ChangeType(ctx, server, hcloud.ServerChangeTypeOpts{
-			ServerType:  &hcloud.ServerType{Name: serverType},
-			UpgradeDisk: !keepDisk,
+		action, _, err := r.client.Server.ChangeType(ctx, server, hcloud.ServerChangeTypeOpts{
+			ServerType:  &hcloud.ServerType{Name: plan.ServerType.ValueString()},
+			UpgradeDisk: !plan.KeepDisk.ValueBool(),
 		})
 		if err != nil {
-			return hcloudutil.ErrorToDiag(err)
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
 		}
-		if err = c.Action.WaitFor(ctx, action); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+
+		resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, action)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	if d.HasChange("backups") {
-		backups := d.Get("backups").(bool)
-		if err := setBackups(ctx, c, server, backups); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+	// Action: Backups
+	if !plan.Backups.IsUnknown() && !plan.Backups.Equal(data.Backups) {
+		if err := setBackups(ctx, r.client, server, plan.Backups.ValueBool()); err != nil {
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
 		}
 	}
 
-	if d.HasChange("iso") {
-		iso := d.Get("iso").(string)
-		if err := setISO(ctx, c, server, iso); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+	// Action: ISO
+	if !plan.ISO.Equal(data.ISO) {
+		if err := setISO(ctx, r.client, server, plan.ISO.ValueString()); err != nil {
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
 		}
 	}
 
-	if d.HasChange("rescue") {
-		rescue := d.Get("rescue").(string)
-		sshKeys, err := getSSHkeys(ctx, c, d)
+	// Action: Rescue
+	if !plan.Rescue.Equal(data.Rescue) {
+		sshKeys, err := getSSHKeys(ctx, r.client, plan.SSHKeys)
 		if err != nil {
-			return hcloudutil.ErrorToDiag(err)
-		}
-		if err := setRescue(ctx, c, server, rescue, sshKeys); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
 		}
-	}
-
-	if d.HasChange("network") {
-		data := d.Get("network").(*schema.Set)
-		if err := updateServerInlineNetworkAttachments(ctx, c, data, server); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+		if err := setRescue(ctx, r.client, server, plan.Rescue.ValueString(), sshKeys); err != nil {
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
 		}
 	}
 
-	if d.HasChange("firewall_ids") {
-		firewallIDs := d.Get("firewall_ids").(*schema.Set).List()
-		for _, f := range server.PublicNet.Firewalls {
-			found := false
-			for _, i := range firewallIDs {
-				fID := util.CastInt64(i)
-				if f.Firewall.ID == fID {
-					found = true
-
-					break
-				}
-			}
-
-			if !found {
-				actions, _, err := c.Firewall.RemoveResources(ctx,
-					&f.Firewall,
-					[]hcloud.FirewallResource{
-						{
-							Type:   hcloud.FirewallResourceTypeServer,
-							Server: &hcloud.FirewallResourceServer{ID: server.ID},
-						},
-					},
-				)
-				if err != nil {
-					return hcloudutil.ErrorToDiag(err)
-				}
-				err = c.Action.WaitFor(ctx, actions...)
-				if err != nil {
-					return hcloudutil.ErrorToDiag(err)
-				}
-			}
+	// Action: Networks
+	if !plan.Network.Equal(data.Network) {
+		networks := modelNetworks{}
+		resp.Diagnostics.Append(networks.FromTerraform(ctx, plan.Network)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 
-		for _, i := range firewallIDs {
-			fID := util.CastInt64(i)
-			found := false
-			for _, f := range server.PublicNet.Firewalls {
-				if f.Firewall.ID == fID {
-					found = true
-
-					break
-				}
-			}
-
-			if !found {
-				actions, _, err := c.Firewall.ApplyResources(ctx,
-					&hcloud.Firewall{ID: fID},
-					[]hcloud.FirewallResource{
-						{
-							Type:   hcloud.FirewallResourceTypeServer,
-							Server: &hcloud.FirewallResourceServer{ID: server.ID},
-						},
-					},
-				)
-				if err != nil {
-					return hcloudutil.ErrorToDiag(err)
-				}
-				err = c.Action.WaitFor(ctx, actions...)
-				if err != nil {
-					return hcloudutil.ErrorToDiag(err)
-				}
-			}
+		resp.Diagnostics.Append(updateServerInlineNetworkAttachments(ctx, r.client, server, networks)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	if d.HasChange("public_net") {
-		o, n := d.GetChange("public_net")
-		if err := updatePublicNet(ctx, o, n, c, server); err != nil {
-			return err
+	// Action: Firewalls
+	if !plan.FirewallIDs.IsUnknown() && !plan.FirewallIDs.Equal(data.FirewallIDs) {
+		firewallIDs := make([]int64, 0, len(plan.FirewallIDs.Elements()))
+		resp.Diagnostics.Append(plan.FirewallIDs.ElementsAs(ctx, &firewallIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-	}
 
-	if d.HasChange("placement_group_id") {
-		placementGroupID := util.CastInt64(d.Get("placement_group_id"))
-		if err := setPlacementGroup(ctx, c, server, placementGroupID); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(updateServerFirewalls(ctx, r.client, server, firewallIDs)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	if d.HasChange("delete_protection") || d.HasChange("rebuild_protection") {
-		deletionProtection := d.Get("delete_protection").(bool)
-		rebuild := d.Get("rebuild_protection").(bool)
-		if err := setProtection(ctx, c, server, deletionProtection, rebuild); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+	// Action: Public Net
+	if !plan.PublicNet.Equal(data.PublicNet) {
+		var oldPublicNets, newPublicNets modelPublicNets
+		resp.Diagnostics.Append(oldPublicNets.FromTerraform(ctx, data.PublicNet)...)
+		resp.Diagnostics.Append(newPublicNets.FromTerraform(ctx, plan.PublicNet)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-	}
-
-	d.Partial(false)
-	return resourceServerRead(ctx, d, m)
-}
 
-func updatePublicNet(ctx context.Context, o any, n any, c *hcloud.Client, server *hcloud.Server) diag.Diagnostics {
-	diffToRemove := o.(*schema.Set).Difference(n.(*schema.Set))
-	diffToAdd := n.(*schema.Set).Difference(o.(*schema.Set))
-
-	var ipv4IDToRemove int64
-	var ipv6IDToRemove int64
-	ipv4EnabledInRemoveDiff := true
-	ipv6EnabledInRemoveDiff := true
-	// collect ip IDs which got removed
-	for _, d := range diffToRemove.List() {
-		fields := d.(map[string]any)
-		ipv4IDToRemove, ipv6IDToRemove = collectPrimaryIPIDs(fields)
-		if ipv4Enabled, err := ToPublicNetField[bool](fields, "ipv4_enabled"); err == nil {
-			ipv4EnabledInRemoveDiff = ipv4Enabled
-		}
-		if ipv6Enabled, err := ToPublicNetField[bool](fields, "ipv6_enabled"); err == nil {
-			ipv6EnabledInRemoveDiff = ipv6Enabled
+		resp.Diagnostics.Append(updatePublicNet(ctx, r.client, server, oldPublicNets, newPublicNets)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	poweroffAction, _, err := c.Server.Poweroff(ctx, &hcloud.Server{ID: server.ID})
-	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
-	}
-
-	if err = c.Action.WaitFor(ctx, poweroffAction); err != nil {
-		return hcloudutil.ErrorToDiag(err)
+	// Action: Placement Group
+	if !plan.PlacementGroupID.Equal(data.PlacementGroupID) {
+		resp.Diagnostics.Append(setPlacementGroup(ctx, r.client, server, plan.PlacementGroupID.ValueInt64())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
-	// This block handles the case where the full `public_net` block was removed.
-	// In this case, we want to unassign any primary IPs that were explicitly assigned to the server previously,
-	// and generate new random primary ips to replace them.
-	if diffToAdd.Len() == 0 {
-		publicNetBlockHadExplicitIPv4IDConfigured := ipv4IDToRemove != 0
-		if server.PublicNet.IPv4.ID != 0 && publicNetBlockHadExplicitIPv4IDConfigured {
-			if server.PublicNet.IPv4.ID != ipv4IDToRemove {
-				return diag.Errorf("Assigned IPv4 changed between plan and apply, please check and generate a new plan")
-			}
-
-			if err := primaryip.UnassignPrimaryIP(ctx, c, server.PublicNet.IPv4.ID); err != nil {
-				return err
-			}
-			if err := primaryip.CreateRandomPrimaryIP(ctx, c, server, hcloud.PrimaryIPTypeIPv4); err != nil {
-				return err
-			}
+	// Action: Protection
+	if !plan.DeleteProtection.Equal(data.DeleteProtection) || !plan.RebuildProtection.Equal(data.RebuildProtection) {
+		if err := setProtection(ctx, r.client, server, plan.DeleteProtection.ValueBool(), plan.RebuildProtection.ValueBool()); err != nil {
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
 		}
+	}
 
-		publicNetBlockHadExplicitIPv6IDConfigured := ipv6IDToRemove != 0
-		if server.PublicNet.IPv6.ID != 0 && publicNetBlockHadExplicitIPv6IDConfigured {
-			if server.PublicNet.IPv6.ID != ipv6IDToRemove {
-				return diag.Errorf("Assigned IPv6 changed between plan and apply, please check and generate a new plan")
-			}
+	// Update fields on resource
+	opts := hcloud.ServerUpdateOpts{}
 
-			if err := primaryip.UnassignPrimaryIP(ctx, c, server.PublicNet.IPv6.ID); err != nil {
-				return err
-			}
-			if err := primaryip.CreateRandomPrimaryIP(ctx, c, server, hcloud.PrimaryIPTypeIPv6); err != nil {
-				return err
-			}
-		}
+	if !plan.Name.Equal(data.Name) {
+		opts.Name = plan.Name.ValueString()
 	}
 
-	// Check ip bool together with IDs to trigger the right actions
-	for _, d := range diffToAdd.List() {
-		fields := d.(map[string]any)
-		ipv4IDToAdd, ipv6IDToAdd := collectPrimaryIPIDs(fields)
-
-		if ipv4Enabled, err := ToPublicNetField[bool](fields, "ipv4_enabled"); err == nil {
-			if err := publicNetUpdateDecision(ctx,
-				c,
-				ipv4Enabled,
-				ipv4EnabledInRemoveDiff,
-				ipv4IDToAdd,
-				ipv4IDToRemove,
-				server,
-				server.PublicNet.IPv4.ID,
-				hcloud.PrimaryIPTypeIPv4); err != nil {
-				return err
-			}
-		}
-		if ipv6Enabled, err := ToPublicNetField[bool](fields, "ipv6_enabled"); err == nil {
-			if err := publicNetUpdateDecision(ctx,
-				c, ipv6Enabled,
-				ipv6EnabledInRemoveDiff,
-				ipv6IDToAdd,
-				ipv6IDToRemove,
-				server,
-				server.PublicNet.IPv6.ID,
-				hcloud.PrimaryIPTypeIPv6); err != nil {
-				return err
-			}
+	if !plan.Labels.IsUnknown() && !plan.Labels.Equal(data.Labels) {
+		resp.Diagnostics.Append(hcloudutil.TerraformLabelsToHCloud(ctx, plan.Labels, &opts.Labels)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	if err := powerOnServer(ctx, c, server); err != nil {
-		return hcloudutil.ErrorToDiag(err)
+	// Always perform the update call last, even when empty, to populate the state with fresh data returned by
+	// the update.
+	server, _, err = r.client.Server.Update(ctx, server, opts)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
 
-	return nil
-}
-
-func publicNetUpdateDecision(ctx context.Context,
-	c *hcloud.Client,
-	ipEnabled bool,
-	ipEnabledInRemoveDiff bool,
-	ipID int64,
-	ipIDInRemoveDiff int64,
-	server *hcloud.Server,
-	serverIPID int64,
-	ipType hcloud.PrimaryIPType) diag.Diagnostics {
-	switch {
-	// if ip set true + ip id, remove all previous assigned ipv4 + assign new
-	case ipEnabled && ipID != 0:
-		if serverIPID != 0 {
-			// if primary ip is managed + unassigned before, this might throw an error
-			if err := primaryip.UnassignPrimaryIP(ctx, c, serverIPID); err != nil {
-				return err
-			}
-			if ipIDInRemoveDiff == 0 {
-				if err := primaryip.DeletePrimaryIP(ctx, c, &hcloud.PrimaryIP{ID: serverIPID}); err != nil {
-					if err := powerOnServer(ctx, c, server); err != nil {
-						return hcloudutil.ErrorToDiag(err)
-					}
-					return err
-				}
-			}
-		}
-		if err := primaryip.AssignPrimaryIP(ctx, c, ipID, server.ID, "server"); err != nil {
-			if err := powerOnServer(ctx, c, server); err != nil {
-				return hcloudutil.ErrorToDiag(err)
-			}
-			return err
-		}
-	// if ip set from true -> false + no ip id, unassign + delete PrimaryIP
-	case !ipEnabled && ipID == 0:
-		if serverIPID != 0 {
-			if err := primaryip.UnassignPrimaryIP(ctx, c, serverIPID); err != nil {
-				if err := powerOnServer(ctx, c, server); err != nil {
-					return hcloudutil.ErrorToDiag(err)
-				}
-				return err
-			}
-			if ipIDInRemoveDiff == 0 {
-				if err := primaryip.DeletePrimaryIP(ctx, c, &hcloud.PrimaryIP{ID: serverIPID}); err != nil {
-					if err := powerOnServer(ctx, c, server); err != nil {
-						return hcloudutil.ErrorToDiag(err)
-					}
-					return err
-				}
-			}
-		}
-
-	// if ip set from false -> true, create & assign auto generated primary ip
-	case ipEnabled && ipID == 0:
-		// unassign managed ip when id is removed
-		if ipEnabledInRemoveDiff && ipIDInRemoveDiff != 0 {
-			if err := primaryip.UnassignPrimaryIP(ctx, c, ipIDInRemoveDiff); err != nil {
-				if err := powerOnServer(ctx, c, server); err != nil {
-					return hcloudutil.ErrorToDiag(err)
-				}
-				return err
-			}
-		}
-		if !ipEnabledInRemoveDiff && ipIDInRemoveDiff == 0 ||
-			ipEnabledInRemoveDiff && ipIDInRemoveDiff != 0 {
-			if err := primaryip.CreateRandomPrimaryIP(ctx, c, server, ipType); err != nil {
-				if err := powerOnServer(ctx, c, server); err != nil {
-					return hcloudutil.ErrorToDiag(err)
-				}
-				return err
-			}
-		}
-
-	// error on ip set from true -> false + ipv4 ID provided
-	case !ipEnabled && ipID != 0:
-		if err := powerOnServer(ctx, c, server); err != nil {
-			return hcloudutil.ErrorToDiag(err)
-		}
-		return hcloudutil.ErrorToDiag(
-			fmt.Errorf("this operation is not allowed: ipv4_enabled = false | ipv4 = %d", ipID))
+	// Configuration-only attributes are not returned by the API. The planned
+	// user data is the hash stored in the state, see ModifyPlan.
+	data.UserData = plan.UserData
+	data.UserDataWOVersion = plan.UserDataWOVersion
+	data.SSHKeys = plan.SSHKeys
+	data.KeepDisk = plan.KeepDisk
+	data.AllowDeprecatedImages = plan.AllowDeprecatedImages
+	data.ISO = plan.ISO
+	data.Rescue = plan.Rescue
+	data.PublicNet = plan.PublicNet
+	data.Network = plan.Network
+	data.IgnoreRemoteFirewallIDs = plan.IgnoreRemoteFirewallIDs
+	data.ShutdownBeforeDeletion = plan.ShutdownBeforeDeletion
+	data.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, server)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	return nil
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func resourceServerDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data model
 
-	serverID, err := util.ParseID(d.Id())
-	if err != nil {
-		log.Printf("[WARN] invalid server id (%s), removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	var warnings diag.Diagnostics
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
 
-	if d.Get("shutdown_before_deletion").(bool) {
+	server := &hcloud.Server{ID: data.ID.ValueInt64()}
+
+	if data.ShutdownBeforeDeletion.ValueBool() {
 		// Try shutting down the server
-		shutdownAction, _, err := client.Server.Shutdown(ctx, &hcloud.Server{ID: serverID})
+		action, _, err := r.client.Server.Shutdown(ctx, server)
 		if err != nil {
-			return hcloudutil.ErrorToDiag(err)
+			if hcloudutil.APIErrorIsNotFound(err) {
+				return
+			}
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
 		}
 
-		if err = client.Action.WaitFor(ctx, shutdownAction); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, action)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 
 		// Give the server some time to shut down
-		err = control.Retry(control.DefaultRetries, func() error {
-			server, _, err := client.Server.GetByID(ctx, serverID)
+		err = control.Retry(ctx, control.DefaultRetries, func() error {
+			result, _, err := r.client.Server.GetByID(ctx, server.ID)
 
 			// If it is not possible to get the server status, it is probably futile to retry
 			if err != nil {
 				return control.AbortRetry(err)
 			}
 
-			if server.Status != hcloud.ServerStatusOff {
+			if result.Status != hcloud.ServerStatusOff {
 				return fmt.Errorf("server has not shut down yet")
 			}
 
@@ -958,33 +1049,97 @@ func resourceServerDelete(ctx context.Context, d *schema.ResourceData, m any) di
 		})
 		if err != nil {
 			// If shutting down does not work, add a warning and move on with deletion
-			warnings = append(warnings, diag.Diagnostic{
-				Severity: diag.Warning,
-				Summary:  fmt.Sprintf("Server id %d did not finish shutting down gracefully in time, deleting it anyways.", serverID),
-			})
+			resp.Diagnostics.AddWarning(
+				fmt.Sprintf("Server id %d did not finish shutting down gracefully in time, deleting it anyways.", server.ID),
+				"",
+			)
 		}
 	}
 
-	result, _, err := client.Server.DeleteWithResult(ctx, &hcloud.Server{ID: serverID})
+	result, _, err := r.client.Server.DeleteWithResult(ctx, server)
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		if hcloudutil.APIErrorIsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
 
-	err = client.Action.WaitFor(ctx, result.Action)
-	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, result.Action)...)
+}
+
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity resourceutil.IDIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+	} else {
+		id, err := strconv.ParseInt(req.ID, 10, 64)
+		if err != nil {
+			resp.Diagnostics.Append(util.InvalidImportID("$SERVER_ID", req.ID))
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 	}
 
-	return warnings
+	// Set the defaults of the attributes that are not returned by the API.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("keep_disk"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ignore_remote_firewall_ids"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("shutdown_before_deletion"), false)...)
+}
+
+// userDataRequiresReplace requires a replacement of the Server, unless the
+// user data stored in the state is equivalent to the configured user data.
+func userDataRequiresReplace(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = !userDataEquivalent(req.StateValue, req.ConfigValue)
 }
 
-func resourceServerIsNotFound(err error, d *schema.ResourceData) bool {
-	if hcloud.IsError(err, hcloud.ErrorCodeNotFound) {
-		log.Printf("[WARN] Server (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return true
+// userDataEquivalent returns whether the user data stored in the state is
+// equivalent to the configured user data. The state holds a hash of the user
+// data, or the user data itself for states written before it was hashed.
+func userDataEquivalent(state, config types.String) bool {
+	if state.IsNull() || config.IsNull() || config.IsUnknown() {
+		return false
 	}
-	return false
+
+	return state.ValueString() == userDataHashSum(config.ValueString()) ||
+		strings.TrimSpace(state.ValueString()) == strings.TrimSpace(config.ValueString())
+}
+
+// userDataHash returns the hash of the user data, which is stored in the state
+// instead of the user data.
+func userDataHash(userData types.String) types.String {
+	if userData.IsNull() || userData.IsUnknown() {
+		return userData
+	}
+	return types.StringValue(userDataHashSum(userData.ValueString()))
+}
+
+func userDataHashSum(userData string) string {
+	sum := sha1.Sum([]byte(userData)) // nolint: gosec
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func getSSHKeys(ctx context.Context, client *hcloud.Client, value types.List) ([]*hcloud.SSHKey, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	sshKeys := make([]*hcloud.SSHKey, 0, len(value.Elements()))
+	for _, item := range value.Elements() {
+		sshKeyIDOrName := item.(types.String).ValueString()
+
+		sshKey, _, err := client.SSHKey.Get(ctx, sshKeyIDOrName)
+		if err != nil {
+			return nil, err
+		}
+		if sshKey == nil {
+			return nil, fmt.Errorf("SSH key not found: %s", sshKeyIDOrName)
+		}
+		sshKeys = append(sshKeys, sshKey)
+	}
+	return sshKeys, nil
 }
 
 func setBackups(ctx context.Context, c *hcloud.Client, server *hcloud.Server, backups bool) error {
@@ -1075,7 +1230,7 @@ func setRescue(ctx context.Context, c *hcloud.Client, server *hcloud.Server, res
 	}
 	if rescue != "" {
 		rescueChanged = true
-		err := control.Retry(control.DefaultRetries, func() error {
+		err := control.Retry(ctx, control.DefaultRetries, func() error {
 			res, _, err := c.Server.EnableRescue(ctx, server, hcloud.ServerEnableRescueOpts{
 				Type:    hcloud.ServerRescueType(rescue),
 				SSHKeys: sshKeys,
@@ -1090,7 +1245,7 @@ func setRescue(ctx context.Context, c *hcloud.Client, server *hcloud.Server, res
 		}
 	}
 	if rescueChanged {
-		err := control.Retry(control.DefaultRetries*2, func() error {
+		err := control.Retry(ctx, control.DefaultRetries*2, func() error {
 			action, _, err := c.Server.Reset(ctx, server)
 			if err != nil {
 				return fmt.Errorf("%s: %w", op, err)
@@ -1104,86 +1259,60 @@ func setRescue(ctx context.Context, c *hcloud.Client, server *hcloud.Server, res
 	return nil
 }
 
-func getSSHkeys(ctx context.Context, client *hcloud.Client, d *schema.ResourceData) (sshKeys []*hcloud.SSHKey, err error) {
-	for _, sshKeyValue := range d.Get("ssh_keys").([]any) {
-		sshKeyIDOrName := sshKeyValue.(string)
-		var sshKey *hcloud.SSHKey
-		sshKey, _, err = client.SSHKey.Get(ctx, sshKeyIDOrName)
-		if err != nil {
-			return
-		}
-		if sshKey == nil {
-			err = fmt.Errorf("SSH key not found: %s", sshKeyIDOrName)
-			return
-		}
-		sshKeys = append(sshKeys, sshKey)
-	}
-	return
-}
-
-func inlineAttachServerToNetwork(ctx context.Context, c *hcloud.Client, s *hcloud.Server, nwData map[string]any) error {
-	const op = "hcloud/inlineAttachServerToNetwork"
+func attachServerToNetworkFromModel(ctx context.Context, c *hcloud.Client, s *hcloud.Server, item modelNetwork) error {
+	const op = "hcloud/attachServerToNetworkFromModel"
 
 	// Extract network from network_id or subnet_id
 	var nw *hcloud.Network
 	var ipRange *net.IPNet
 
-	if subnetID, ok := nwData["subnet_id"].(string); ok && subnetID != "" {
+	if item.SubnetID.ValueString() != "" {
 		var err error
-		nw, ipRange, err = ParseSubnetID(subnetID)
+		nw, ipRange, err = ParseSubnetID(item.SubnetID.ValueString())
 		if err != nil {
 			return fmt.Errorf("%s: %w", op, err)
 		}
 	} else {
-		networkID := util.CastInt64(nwData["network_id"])
+		networkID := item.NetworkID.ValueInt64()
 		if networkID == 0 {
 			return fmt.Errorf("%s: either subnet_id or network_id must be set", op)
 		}
 		nw = &hcloud.Network{ID: networkID}
 	}
 
-	ip := net.ParseIP(nwData["ip"].(string))
+	ip := net.ParseIP(item.IP.ValueString())
 
-	aliasIPs := make([]net.IP, 0, nwData["alias_ips"].(*schema.Set).Len())
-	for _, v := range nwData["alias_ips"].(*schema.Set).List() {
-		aliasIP := net.ParseIP(v.(string))
-		aliasIPs = append(aliasIPs, aliasIP)
-	}
-	if err := attachServerToNetwork(ctx, c, s, nw, ip, aliasIPs, ipRange); err != nil {
+	if err := attachServerToNetwork(ctx, c, s, nw, ip, aliasIPsFromModel(item), ipRange); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
 	return nil
 }
 
-func updateServerInlineNetworkAttachments(ctx context.Context, c *hcloud.Client, data *schema.Set, s *hcloud.Server) error {
-	const op = "hcloud/updateServerInlineNetworkAttachments"
-
-	log.Printf("[INFO] Updating inline network attachments for server %d", s.ID)
-
-	cfgNetworks := make(map[int64]map[string]any, data.Len())
-	for _, v := range data.List() {
-		nwData := v.(map[string]any)
+func aliasIPsFromModel(item modelNetwork) []net.IP {
+	aliasIPs := make([]net.IP, 0, len(item.AliasIPs.Elements()))
+	for _, value := range item.AliasIPs.Elements() {
+		aliasIPs = append(aliasIPs, net.ParseIP(value.(iptypes.IPAddress).ValueString()))
+	}
+	return aliasIPs
+}
 
-		var nwID int64
-		if subnetIDStr, ok := nwData["subnet_id"].(string); ok && subnetIDStr != "" {
-			if subnetNetwork, _, err := ParseSubnetID(subnetIDStr); err == nil {
-				nwID = subnetNetwork.ID
-			}
-		} else {
-			nwID = util.CastInt64(nwData["network_id"])
-		}
+func updateServerInlineNetworkAttachments(ctx context.Context, c *hcloud.Client, s *hcloud.Server, networks modelNetworks) diag.Diagnostics {
+	var diags diag.Diagnostics
 
-		cfgNetworks[nwID] = nwData
+	cfgNetworks := make(map[int64]modelNetwork, len(networks))
+	for _, item := range networks {
+		cfgNetworks[item.resolveNetworkID()] = item
 	}
 
 	for _, n := range s.PrivateNet {
-		nwData, ok := cfgNetworks[n.Network.ID]
+		item, ok := cfgNetworks[n.Network.ID]
 		if !ok {
 			// The server should no longer be a member of this network.
 			// Detach it.
 			if err := detachServerFromNetwork(ctx, c, s, n.Network); err != nil {
-				return fmt.Errorf("%s: %w", op, err)
+				diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+				return diags
 			}
 			continue
 		}
@@ -1191,166 +1320,302 @@ func updateServerInlineNetworkAttachments(ctx context.Context, c *hcloud.Client,
 		// handle it right now.
 		delete(cfgNetworks, n.Network.ID)
 
-		if nwData["ip"].(string) != n.IP.String() {
+		if !item.IP.IsUnknown() && item.IP.ValueString() != n.IP.String() {
 			// IP changed. Our API provides now way to change this. So we
 			// need to detach and re-attach. Alias IPs are updated, too. This
 			// saves us from the next step.
 			if err := detachServerFromNetwork(ctx, c, s, n.Network); err != nil {
-				return fmt.Errorf("%s: %w", op, err)
+				diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+				return diags
 			}
-			if err := inlineAttachServerToNetwork(ctx, c, s, nwData); err != nil {
-				return fmt.Errorf("%s: %w", op, err)
+			if err := attachServerToNetworkFromModel(ctx, c, s, item); err != nil {
+				diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+				return diags
 			}
 			continue
 		}
-		cfgAliasIPs := nwData["alias_ips"].(*schema.Set)
-		curAliasIPs := newIPSet(cfgAliasIPs.F, n.Aliases)
-		if !cfgAliasIPs.Equal(curAliasIPs) {
-			if err := updateServerAliasIPs(ctx, c, s, n.Network, cfgAliasIPs); err != nil {
-				return fmt.Errorf("%s: %w", op, err)
+
+		if !item.AliasIPs.IsUnknown() {
+			cfgAliasIPs := sliceutil.Transform(aliasIPsFromModel(item), net.IP.String)
+			curAliasIPs := sliceutil.Transform(n.Aliases, net.IP.String)
+
+			if !sameElements(cfgAliasIPs, curAliasIPs) {
+				if err := updateServerAliasIPs(ctx, c, s, n.Network, aliasIPsFromModel(item)); err != nil {
+					diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+					return diags
+				}
 			}
-			continue
 		}
 	}
 
 	// Whatever remains in cfgNetworks now is a newly added network. We attach
 	// the server to it.
-	for _, nwData := range cfgNetworks {
-		if err := inlineAttachServerToNetwork(ctx, c, s, nwData); err != nil {
-			return fmt.Errorf("%s: %w", op, err)
+	for _, item := range cfgNetworks {
+		if err := attachServerToNetworkFromModel(ctx, c, s, item); err != nil {
+			diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return diags
 		}
 	}
 
-	return nil
+	return diags
 }
 
-func newIPSet(f schema.SchemaSetFunc, ips []net.IP) *schema.Set {
-	ss := make([]any, len(ips))
-	for i, ip := range ips {
-		ss[i] = ip.String()
+func sameElements(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	counts := make(map[string]int, len(a))
+	for _, v := range a {
+		counts[v]++
+	}
+	for _, v := range b {
+		counts[v]--
+		if counts[v] < 0 {
+			return false
+		}
 	}
-	return schema.NewSet(f, ss)
+	return true
 }
 
-func setServerSchema(d *schema.ResourceData, s *hcloud.Server, forceSetNetworkAttribute bool) {
-	util.SetSchemaFromAttributes(d, getServerAttributes(d, s, forceSetNetworkAttribute))
+func updateServerFirewalls(ctx context.Context, c *hcloud.Client, server *hcloud.Server, firewallIDs []int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resources := []hcloud.FirewallResource{{
+		Type:   hcloud.FirewallResourceTypeServer,
+		Server: &hcloud.FirewallResourceServer{ID: server.ID},
+	}}
+
+	for _, f := range server.PublicNet.Firewalls {
+		if slicesContains(firewallIDs, f.Firewall.ID) {
+			continue
+		}
+
+		actions, _, err := c.Firewall.RemoveResources(ctx, &f.Firewall, resources)
+		if err != nil {
+			diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return diags
+		}
+
+		diags.Append(hcloudutil.SettleActions(ctx, &c.Action, actions...)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	currentFirewallIDs := sliceutil.Transform(server.PublicNet.Firewalls, func(o *hcloud.ServerFirewallStatus) int64 { return o.Firewall.ID })
+
+	for _, firewallID := range firewallIDs {
+		if slicesContains(currentFirewallIDs, firewallID) {
+			continue
+		}
+
+		actions, _, err := c.Firewall.ApplyResources(ctx, &hcloud.Firewall{ID: firewallID}, resources)
+		if err != nil {
+			diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return diags
+		}
+
+		diags.Append(hcloudutil.SettleActions(ctx, &c.Action, actions...)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return diags
 }
 
-func getServerAttributes(d *schema.ResourceData, s *hcloud.Server, forceSetNetworkAttribute bool) map[string]any {
-	firewallIDs := make([]int, len(s.PublicNet.Firewalls))
-	for i, firewall := range s.PublicNet.Firewalls {
-		firewallIDs[i] = util.CastInt(firewall.Firewall.ID)
-	}
-
-	res := map[string]any{
-		"id":                 s.ID,
-		"name":               s.Name,
-		"location":           s.Location.Name,
-		"status":             s.Status,
-		"server_type":        s.ServerType.Name,
-		"ipv6_network":       s.PublicNet.IPv6.Network.String(),
-		"backup_window":      s.BackupWindow,
-		"backups":            s.BackupWindow != "",
-		"labels":             s.Labels,
-		"delete_protection":  s.Protection.Delete,
-		"rebuild_protection": s.Protection.Rebuild,
-		"firewall_ids":       firewallIDs,
-		"primary_disk_size":  s.PrimaryDiskSize,
-	}
-	if s.PublicNet.IPv4.IsUnspecified() {
-		res["ipv4_address"] = nil
-	} else {
-		res["ipv4_address"] = s.PublicNet.IPv4.IP.String()
+func updatePublicNet(ctx context.Context, c *hcloud.Client, server *hcloud.Server, oldPublicNets, newPublicNets modelPublicNets) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var ipv4IDToRemove int64
+	var ipv6IDToRemove int64
+	ipv4EnabledInRemoveDiff := true
+	ipv6EnabledInRemoveDiff := true
+	// collect ip IDs which got removed
+	for _, item := range oldPublicNets {
+		ipv4IDToRemove = item.IPv4.ValueInt64()
+		ipv6IDToRemove = item.IPv6.ValueInt64()
+		if !item.IPv4Enabled.IsNull() {
+			ipv4EnabledInRemoveDiff = item.IPv4Enabled.ValueBool()
+		}
+		if !item.IPv6Enabled.IsNull() {
+			ipv6EnabledInRemoveDiff = item.IPv6Enabled.ValueBool()
+		}
 	}
 
-	if len(s.PublicNet.IPv6.IP) == 0 {
-		// No IPv6 Primary IP assigned
-		res["ipv6_address"] = nil
-	} else {
-		// Set first IP in assigned subnet range
-		res["ipv6_address"] = s.PublicNet.IPv6.IP.String() + "1"
+	poweroffAction, _, err := c.Server.Poweroff(ctx, &hcloud.Server{ID: server.ID})
+	if err != nil {
+		diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return diags
+	}
+
+	diags.Append(hcloudutil.SettleActions(ctx, &c.Action, poweroffAction)...)
+	if diags.HasError() {
+		return diags
 	}
 
-	if s.Image != nil {
-		if s.Image.Name != "" && util.FormatID(s.Image.ID) != d.Get("image") {
-			// Only use the image name if the image is official (Name != "")
-			// AND the user did not explicitly specify the image id
-			res["image"] = s.Image.Name
-		} else {
-			res["image"] = fmt.Sprintf("%d", s.Image.ID)
+	// This block handles the case where the full `public_net` block was removed.
+	// In this case, we want to unassign any primary IPs that were explicitly assigned to the server previously,
+	// and generate new random primary ips to replace them.
+	if len(newPublicNets) == 0 {
+		for _, ip := range []struct {
+			ipType     hcloud.PrimaryIPType
+			serverIPID int64
+			removedID  int64
+		}{
+			{hcloud.PrimaryIPTypeIPv4, server.PublicNet.IPv4.ID, ipv4IDToRemove},
+			{hcloud.PrimaryIPTypeIPv6, server.PublicNet.IPv6.ID, ipv6IDToRemove},
+		} {
+			publicNetBlockHadExplicitIDConfigured := ip.removedID != 0
+			if ip.serverIPID == 0 || !publicNetBlockHadExplicitIDConfigured {
+				continue
+			}
+
+			if ip.serverIPID != ip.removedID {
+				diags.AddError(
+					"Primary IP changed",
+					fmt.Sprintf("Assigned %s changed between plan and apply, please check and generate a new plan.", primaryIPTypeName(ip.ipType)),
+				)
+				return diags
+			}
+
+			diags.Append(primaryip.UnassignPrimaryIP(ctx, c, ip.serverIPID)...)
+			if diags.HasError() {
+				return diags
+			}
+			diags.Append(primaryip.CreateRandomPrimaryIP(ctx, c, server, ip.ipType)...)
+			if diags.HasError() {
+				return diags
+			}
 		}
 	}
 
-	// Only write the networks to the resource data if it already contains
-	// such an entry. This avoids setting the "network" property which is not
-	// marked as "computed" if the user uses the "server_network_subnet"
-	// resource. Setting the "network" property as computed is not possible
-	// because this would lead to loosing any updates.
-	//
-	// The easiest would be to use schema.ComputedWhen but this is marked
-	// as currently not working.
-	_, ok := d.GetOk("network")
-	if ok || forceSetNetworkAttribute {
-		res["network"] = networkToTerraformNetworks(d, s.PrivateNet)
+	// Check ip bool together with IDs to trigger the right actions
+	for _, item := range newPublicNets {
+		diags.Append(publicNetUpdateDecision(ctx,
+			c,
+			item.IPv4Enabled.ValueBool(),
+			ipv4EnabledInRemoveDiff,
+			item.IPv4.ValueInt64(),
+			ipv4IDToRemove,
+			server,
+			server.PublicNet.IPv4.ID,
+			hcloud.PrimaryIPTypeIPv4)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		diags.Append(publicNetUpdateDecision(ctx,
+			c,
+			item.IPv6Enabled.ValueBool(),
+			ipv6EnabledInRemoveDiff,
+			item.IPv6.ValueInt64(),
+			ipv6IDToRemove,
+			server,
+			server.PublicNet.IPv6.ID,
+			hcloud.PrimaryIPTypeIPv6)...)
+		if diags.HasError() {
+			return diags
+		}
 	}
 
-	if s.PlacementGroup != nil {
-		res["placement_group_id"] = util.CastInt(s.PlacementGroup.ID)
-	} else {
-		res["placement_group_id"] = nil
+	if err := powerOnServer(ctx, c, server); err != nil {
+		diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return diags
 	}
 
-	return res
+	return diags
 }
 
-func networkToTerraformNetworks(d *schema.ResourceData, privateNetworks []hcloud.ServerPrivateNet) []map[string]any {
-	tfPrivateNetworks := make([]map[string]any, len(privateNetworks))
-	for i, privateNetwork := range privateNetworks {
-		tfPrivateNetwork := make(map[string]any)
-		tfPrivateNetwork["ip"] = privateNetwork.IP.String()
-		tfPrivateNetwork["mac_address"] = privateNetwork.MACAddress
-
-		// Check the user input to preserve the same structure in state
-		if nwSet, ok := d.GetOk("network"); ok {
-			for _, item := range nwSet.(*schema.Set).List() {
-				nwData := item.(map[string]any)
-				configSubnetID, hasSubnetID := nwData["subnet_id"].(string)
-
-				// Match API response to config entry by network_id or subnet_id
-				var matchesNetwork bool
-				var configNetworkID int64
-
-				if hasSubnetID && configSubnetID != "" {
-					if subnetNetwork, _, err := ParseSubnetID(configSubnetID); err == nil {
-						configNetworkID = subnetNetwork.ID
-						matchesNetwork = subnetNetwork.ID == privateNetwork.Network.ID
-					}
-				} else {
-					configNetworkID = util.CastInt64(nwData["network_id"])
-					matchesNetwork = configNetworkID == privateNetwork.Network.ID
-				}
+func publicNetUpdateDecision(ctx context.Context,
+	c *hcloud.Client,
+	ipEnabled bool,
+	ipEnabledInRemoveDiff bool,
+	ipID int64,
+	ipIDInRemoveDiff int64,
+	server *hcloud.Server,
+	serverIPID int64,
+	ipType hcloud.PrimaryIPType) (diags diag.Diagnostics) {
+	// Make sure to power on the server again, when one of the operations failed.
+	defer func() {
+		if diags.HasError() {
+			if err := powerOnServer(ctx, c, server); err != nil {
+				diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			}
+		}
+	}()
 
-				if matchesNetwork {
-					// Write fields to state based on the user input
-					if configNetworkID != 0 {
-						tfPrivateNetwork["network_id"] = privateNetwork.Network.ID
-					}
-					if hasSubnetID && configSubnetID != "" {
-						tfPrivateNetwork["subnet_id"] = configSubnetID
-					}
-					break
+	switch {
+	// if ip set true + ip id, remove all previous assigned ip + assign new
+	case ipEnabled && ipID != 0:
+		if serverIPID == ipID {
+			return diags
+		}
+		if serverIPID != 0 {
+			// if primary ip is managed + unassigned before, this might throw an error
+			diags.Append(primaryip.UnassignPrimaryIP(ctx, c, serverIPID)...)
+			if diags.HasError() {
+				return diags
+			}
+			if ipIDInRemoveDiff == 0 {
+				diags.Append(primaryip.DeletePrimaryIP(ctx, c, &hcloud.PrimaryIP{ID: serverIPID})...)
+				if diags.HasError() {
+					return diags
 				}
 			}
 		}
+		diags.Append(primaryip.AssignPrimaryIP(ctx, c, ipID, server.ID, "server")...)
+
+	// if ip set from true -> false + no ip id, unassign + delete PrimaryIP
+	case !ipEnabled && ipID == 0:
+		if serverIPID != 0 {
+			diags.Append(primaryip.UnassignPrimaryIP(ctx, c, serverIPID)...)
+			if diags.HasError() {
+				return diags
+			}
+			if ipIDInRemoveDiff == 0 {
+				diags.Append(primaryip.DeletePrimaryIP(ctx, c, &hcloud.PrimaryIP{ID: serverIPID})...)
+			}
+		}
+
+	// if ip set from false -> true, create & assign auto generated primary ip
+	case ipEnabled && ipID == 0:
+		// unassign managed ip when id is removed
+		if ipEnabledInRemoveDiff && ipIDInRemoveDiff != 0 {
+			diags.Append(primaryip.UnassignPrimaryIP(ctx, c, ipIDInRemoveDiff)...)
+			if diags.HasError() {
+				return diags
+			}
+		}
+		if !ipEnabledInRemoveDiff && ipIDInRemoveDiff == 0 ||
+			ipEnabledInRemoveDiff && ipIDInRemoveDiff != 0 {
+			diags.Append(primaryip.CreateRandomPrimaryIP(ctx, c, server, ipType)...)
+		}
+
+	// error on ip set from true -> false + ip ID provided
+	case !ipEnabled && ipID != 0:
+		diags.AddError(
+			"Invalid public_net configuration",
+			fmt.Sprintf("This operation is not allowed: %s_enabled = false | %s = %d", ipType, ipType, ipID),
+		)
+	}
+	return diags
+}
+
+func primaryIPTypeName(ipType hcloud.PrimaryIPType) string {
+	if ipType == hcloud.PrimaryIPTypeIPv6 {
+		return "IPv6"
+	}
+	return "IPv4"
+}
 
-		aliasIPs := make([]string, len(privateNetwork.Aliases))
-		for in, ip := range privateNetwork.Aliases {
-			aliasIPs[in] = ip.String()
+func slicesContains(s []int64, v int64) bool {
+	for _, item := range s {
+		if item == v {
+			return true
 		}
-		tfPrivateNetwork["alias_ips"] = aliasIPs
-		tfPrivateNetworks[i] = tfPrivateNetwork
 	}
-	return tfPrivateNetworks
+	return false
 }
 
 func getPlacementGroup(ctx context.Context, c *hcloud.Client, id int64) (*hcloud.PlacementGroup, error) {
@@ -1366,41 +1631,50 @@ func getPlacementGroup(ctx context.Context, c *hcloud.Client, id int64) (*hcloud
 	return placementGroup, nil
 }
 
-func setPlacementGroup(ctx context.Context, c *hcloud.Client, server *hcloud.Server, id int64) error {
+func setPlacementGroup(ctx context.Context, c *hcloud.Client, server *hcloud.Server, id int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+
 	if server.PlacementGroup != nil {
 		if server.Status != hcloud.ServerStatusOff {
 			// Removing PG requires the server to be shut down before, this is an invasive operation. We do not currently
 			// warn the user about this, so we prefer to forbid the operation until we have a proper framework for
 			// shutting down + warning in place.
-			return fmt.Errorf("removing a running server from a placement group is currently not supported in the provider. " +
-				"You can shutdown the server yourself, apply the changes again and then start the server manually as a workaround")
+			diags.AddAttributeError(
+				path.Root("placement_group_id"),
+				"Unsupported placement group change",
+				"Removing a running server from a placement group is currently not supported in the provider. "+
+					"You can shutdown the server yourself, apply the changes again and then start the server manually as a workaround.",
+			)
+			return diags
 		}
 
 		action, _, err := c.Server.RemoveFromPlacementGroup(ctx, server)
 		if err != nil {
-			return err
+			diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return diags
 		}
-		if err = c.Action.WaitFor(ctx, action); err != nil {
-			return err
+		diags.Append(hcloudutil.SettleActions(ctx, &c.Action, action)...)
+		if diags.HasError() {
+			return diags
 		}
 	}
 
 	if id != 0 {
 		placementGroup, err := getPlacementGroup(ctx, c, id)
 		if err != nil {
-			return err
+			diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return diags
 		}
 
 		action, _, err := c.Server.AddToPlacementGroup(ctx, server, placementGroup)
 		if err != nil {
-			return err
-		}
-		if err = c.Action.WaitFor(ctx, action); err != nil {
-			return err
+			diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return diags
 		}
+		diags.Append(hcloudutil.SettleActions(ctx, &c.Action, action)...)
 	}
 
-	return nil
+	return diags
 }
 
 func setProtection(ctx context.Context, c *hcloud.Client, server *hcloud.Server, deleteProtection bool, rebuildProtection bool) error {
@@ -1417,138 +1691,13 @@ func setProtection(ctx context.Context, c *hcloud.Client, server *hcloud.Server,
 	return c.Action.WaitFor(ctx, action)
 }
 
-func collectPrimaryIPIDs(primaryIPList map[string]any) (int64, int64) {
-	var IPv4ID int64
-	var IPv6ID int64
-	if id, err := ToPublicNetField[int](primaryIPList, "ipv4"); id != 0 && err == nil {
-		IPv4ID = util.CastInt64(id)
-	}
-	if id, err := ToPublicNetField[int](primaryIPList, "ipv6"); id != 0 && err == nil {
-		IPv6ID = util.CastInt64(id)
-	}
-	return IPv4ID, IPv6ID
-}
-
-func ToPublicNetField[V int | bool](field map[string]any, key string) (V, error) {
-	var op = "ToPublicNetField"
-
-	if value, ok := field[key]; ok {
-		return value.(V), nil
-	}
-
-	var zero V
-	return zero, fmt.Errorf("%s: field does not contain key: %s", op, key)
-}
-
-func onServerCreateWithoutPublicNet(opts *hcloud.ServerCreateOpts, d *schema.ResourceData, fn func(opts *hcloud.ServerCreateOpts) error) diag.Diagnostics {
-	if _, ok := d.GetOk("network"); ok && opts.PublicNet != nil {
-		if !opts.PublicNet.EnableIPv6 && !opts.PublicNet.EnableIPv4 {
-			if err := fn(opts); err != nil {
-				return hcloudutil.ErrorToDiag(err)
-			}
-		}
-		return nil
-	}
-	return nil
-}
-
 func powerOnServer(ctx context.Context, c *hcloud.Client, server *hcloud.Server) error {
-	err := control.Retry(control.DefaultRetries, func() error {
-		powerOn, _, err := c.Server.Poweron(ctx, server)
+	return control.Retry(ctx, control.DefaultRetries, func() error {
+		action, _, err := c.Server.Poweron(ctx, server)
 		if err != nil {
 			return err
 		}
 
-		return c.Action.WaitFor(ctx, powerOn)
+		return c.Action.WaitFor(ctx, action)
 	})
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-func resourceServerCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
-	return validateUniqueNetworkIDs(d)
-}
-
-func validateUniqueNetworkIDs(d *schema.ResourceDiff) error {
-	// Validate that at least one of network_id or subnet_id is specified.
-	if rawNetworks := d.GetRawConfig().GetAttr("network"); rawNetworks.IsWhollyKnown() && !rawNetworks.IsNull() {
-		for it := rawNetworks.ElementIterator(); it.Next(); {
-			_, networkVal := it.Element()
-
-			hasNetworkID := !networkVal.GetAttr("network_id").IsNull()
-			hasSubnetID := !networkVal.GetAttr("subnet_id").IsNull()
-
-			if !hasNetworkID && !hasSubnetID {
-				return fmt.Errorf("must specify either network_id or subnet_id")
-			}
-		}
-	}
-
-	// Validate that every network set element uses unique network id
-	if n, ok := d.GetOkExists("network"); ok {
-		networks, ok := n.(*schema.Set)
-		if !ok {
-			return fmt.Errorf("network has unexpected type: %T", n)
-		}
-
-		uniqueNetworkIDs := map[int64]bool{}
-
-		for _, networkI := range networks.List() {
-			network, ok := networkI.(map[string]any)
-			if !ok {
-				return fmt.Errorf("network item has unexpected type: %T", networkI)
-			}
-
-			var networkID int64
-			configNetworkID := util.CastInt64(network["network_id"])
-			subnetIDStr, _ := network["subnet_id"].(string)
-
-			// When subnet_id is specified, extract network_id and validate IP range
-			if subnetIDStr != "" {
-				subnetNetwork, subnetIPRange, err := ParseSubnetID(subnetIDStr)
-				if err != nil {
-					continue
-				}
-
-				// If the user specified both network_id and subnet_id, they must match.
-				if configNetworkID != 0 && subnetNetwork.ID != configNetworkID {
-					return fmt.Errorf("subnet_id (%s) does not belong to the specified network_id (%d)", subnetIDStr, configNetworkID)
-				}
-
-				// Extract network_id from parsed subnet
-				networkID = subnetNetwork.ID
-
-				// Check if the server IP is within the subnet IP range
-				if ipStr, ok := network["ip"].(string); ok && ipStr != "" {
-					ip := net.ParseIP(ipStr)
-					if ip != nil && !subnetIPRange.Contains(ip) {
-						return fmt.Errorf("server IP (%s) is outside subnet IP range (%s)", ip.String(), subnetIPRange.String())
-					}
-				}
-			} else {
-				networkID = util.CastInt64(network["network_id"])
-			}
-
-			if networkID == 0 {
-				// ID is 0 if Network will be created in same apply, we are unable to reliably detect if the
-				// "to-be-created" networks are the same.
-				// See https://github.com/hetznercloud/terraform-provider-hcloud/issues/899
-
-				// We should revisit this after moving to the Plugin Framework,
-				// as it allows more introspection for the plan/changes made.
-				// See https://github.com/hetznercloud/terraform-provider-hcloud/issues/752
-				continue
-			}
-
-			if uniqueNetworkIDs[networkID] {
-				return fmt.Errorf("server is only allowed to be attached to each network once: %d", networkID)
-			}
-
-			uniqueNetworkIDs[networkID] = true
-		}
-	}
-
-	return nil
 }
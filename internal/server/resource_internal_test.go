@@ -0,0 +1,77 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserDataRequiresReplace(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		state  types.String
+		config types.String
+		want   bool
+	}{
+		{
+			name:   "hash from sdkv2 state",
+			state:  types.StringValue(userDataHashSum("#cloud-config\n")),
+			config: types.StringValue("#cloud-config\n"),
+			want:   false,
+		},
+		{
+			name:   "hash of other user data",
+			state:  types.StringValue(userDataHashSum("#cloud-config\n")),
+			config: types.StringValue("#cloud-config\nruncmd: []\n"),
+			want:   true,
+		},
+		{
+			name:   "trailing whitespace",
+			state:  types.StringValue("#cloud-config\n"),
+			config: types.StringValue("#cloud-config"),
+			want:   false,
+		},
+		{
+			name:   "changed",
+			state:  types.StringValue("#cloud-config\n"),
+			config: types.StringValue("#cloud-config\nruncmd: []\n"),
+			want:   true,
+		},
+		{
+			name:   "removed",
+			state:  types.StringValue("#cloud-config\n"),
+			config: types.StringNull(),
+			want:   true,
+		},
+		{
+			name:   "unknown",
+			state:  types.StringValue("#cloud-config\n"),
+			config: types.StringUnknown(),
+			want:   true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			req := planmodifier.StringRequest{
+				StateValue:  tt.state,
+				ConfigValue: tt.config,
+				PlanValue:   tt.config,
+			}
+			resp := &stringplanmodifier.RequiresReplaceIfFuncResponse{}
+
+			userDataRequiresReplace(t.Context(), req, resp)
+
+			assert.Equal(t, tt.want, resp.RequiresReplace)
+			assert.False(t, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestUserDataHash(t *testing.T) {
+	assert.Equal(t, types.StringValue(userDataHashSum("#cloud-config\n")), userDataHash(types.StringValue("#cloud-config\n")))
+	assert.NotEqual(t, types.StringValue("#cloud-config\n"), userDataHash(types.StringValue("#cloud-config\n")))
+	assert.Equal(t, types.StringNull(), userDataHash(types.StringNull()))
+	assert.Equal(t, types.StringUnknown(), userDataHash(types.StringUnknown()))
+}
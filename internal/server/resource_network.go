@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -25,6 +26,7 @@ import (
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/control"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/validateutil"
 )
 
@@ -34,6 +36,7 @@ var _ resource.Resource = (*NetworkResource)(nil)
 var _ resource.ResourceWithConfigure = (*NetworkResource)(nil)
 var _ resource.ResourceWithConfigValidators = (*NetworkResource)(nil)
 var _ resource.ResourceWithImportState = (*NetworkResource)(nil)
+var _ resource.ResourceWithIdentity = (*NetworkResource)(nil)
 var _ resource.ResourceWithModifyPlan = (*NetworkResource)(nil)
 
 type NetworkResource struct {
@@ -58,7 +61,7 @@ func (r *NetworkResource) Configure(_ context.Context, req resource.ConfigureReq
 	}
 }
 
-func (r *NetworkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NetworkResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema.MarkdownDescription = util.MarkdownDescription(`
 Manage the attachment of a Server in a Network in the Hetzner Cloud.
 `)
@@ -123,6 +126,11 @@ Manage the attachment of a Server in a Network in the Hetzner Cloud.
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
 	}
 }
 
@@ -135,6 +143,21 @@ func (r *NetworkResource) ConfigValidators(context.Context) []resource.ConfigVal
 	}
 }
 
+func (r *NetworkResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"server_id": identityschema.Int64Attribute{
+				Description:       "ID of the Server.",
+				RequiredForImport: true,
+			},
+			"network_id": identityschema.Int64Attribute{
+				Description:       "ID of the Network.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *NetworkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	// Do not modify on resource creation.
 	if req.State.Raw.IsNull() {
@@ -213,6 +236,15 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
+
 	server := &hcloud.Server{ID: data.ServerID.ValueInt64()}
 
 	opts := hcloud.ServerAttachToNetworkOpts{}
@@ -251,7 +283,7 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 	// Apply changes
 	var action *hcloud.Action
 
-	err := control.Retry(control.DefaultRetries, func() error {
+	err := control.Retry(ctx, control.DefaultRetries, func() error {
 		var innerErr error
 
 		action, _, innerErr = r.client.Server.AttachToNetwork(ctx, server, opts)
@@ -314,6 +346,7 @@ func (r *NetworkResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newNetworkIdentity(data))...)
 }
 
 func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -357,6 +390,7 @@ func (r *NetworkResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newNetworkIdentity(data))...)
 }
 
 func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -368,6 +402,15 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "update", updateTimeout)
+	defer cancel()
+
 	server, network, err := r.ParseID(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(
@@ -434,7 +477,10 @@ func (r *NetworkResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	data.Timeouts = plan.Timeouts
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newNetworkIdentity(data))...)
 }
 
 func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -445,6 +491,15 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
+
 	server, network, err := r.ParseID(data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(
@@ -460,7 +515,7 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	var action *hcloud.Action
-	err = control.Retry(control.DefaultRetries, func() error {
+	err = control.Retry(ctx, control.DefaultRetries, func() error {
 		var innerErr error
 
 		action, _, innerErr = r.client.Server.DetachFromNetwork(ctx, server, opts)
@@ -486,6 +541,13 @@ func (r *NetworkResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *NetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity networkIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%d-%d", identity.ServerID.ValueInt64(), identity.NetworkID.ValueInt64()))...)
+		return
+	}
+
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
@@ -9,8 +9,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/firewall"
@@ -72,7 +72,7 @@ func TestAccServerResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet(res1.TFID(), "ipv6_network"),
 					resource.TestCheckResourceAttr(res1.TFID(), "status", string(hcloud.ServerStatusRunning)),
 					resource.TestCheckResourceAttrSet(res1.TFID(), "primary_disk_size"),
-					resource.TestCheckResourceAttr(res1.TFID(), "placement_group_id", "0"),
+					resource.TestCheckNoResourceAttr(res1.TFID(), "placement_group_id"),
 				),
 			},
 			{
@@ -291,7 +291,7 @@ func TestAccServerResource_ChangeUserData(t *testing.T) {
 					resource.TestCheckResourceAttr(res1.TFID(), "name", fmt.Sprintf("server-userdata--%d", tmplMan.RandInt)),
 					resource.TestCheckResourceAttr(res1.TFID(), "server_type", res1.Type),
 					resource.TestCheckResourceAttr(res1.TFID(), "image", res1.Image),
-					resource.TestCheckResourceAttr(res1.TFID(), "user_data", userDataHashSum(res1.UserData+"\n")),
+					resource.TestCheckResourceAttr(res1.TFID(), "user_data", userDataHash(res1.UserData+"\n")),
 				),
 			},
 			{
@@ -309,13 +309,103 @@ func TestAccServerResource_ChangeUserData(t *testing.T) {
 					resource.TestCheckResourceAttr(res2.TFID(), "name", fmt.Sprintf("server-userdata--%d", tmplMan.RandInt)),
 					resource.TestCheckResourceAttr(res2.TFID(), "server_type", res2.Type),
 					resource.TestCheckResourceAttr(res2.TFID(), "image", res2.Image),
-					resource.TestCheckResourceAttr(res2.TFID(), "user_data", userDataHashSum(res2.UserData+"\n")),
+					resource.TestCheckResourceAttr(res2.TFID(), "user_data", userDataHash(res2.UserData+"\n")),
 				),
 			},
 		},
 	})
 }
 
+func TestAccServerResource_UserDataWO(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	res := &server.RData{
+		Name:  "server-userdata-wo",
+		Type:  teste2e.TestServerType,
+		Image: teste2e.TestImage,
+		Raw:   `user_data_wo = "#cloud-config"`,
+	}
+	res.SetRName("server-userdata-wo")
+
+	resource.ParallelTest(t, resource.TestCase{
+		// Write-only attributes are only available in 1.11 and later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				// The user data is never applied without a version
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_server", res,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute\s+"user_data_wo_version"\s+must\s+be\s+specified\s+when\s+"user_data_wo"\s+is\s+specified`),
+			},
+		},
+	})
+}
+
+func TestAccServerResource_UpgradePluginFrameworkUserData(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	sshKeyRes := sshkey.NewRData(t, "server-upgrade-userdata")
+
+	res := &server.RData{
+		Name:     "server-upgrade-userdata",
+		Type:     teste2e.TestServerType,
+		Image:    teste2e.TestImage,
+		SSHKeys:  []string{sshKeyRes.TFID() + ".id"},
+		UserData: "stuff",
+	}
+	res.SetRName("server-upgrade-userdata")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: teste2e.PreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				// The SDKv2 implementation stores a hash of the user data in the state.
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"hcloud": {
+						VersionConstraint: "1.66.1",
+						Source:            "hetznercloud/hcloud",
+					},
+				},
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_ssh_key", sshKeyRes,
+					"testdata/r/hcloud_server", res,
+				),
+				Check: resource.TestCheckResourceAttr(res.TFID(), "user_data", userDataHash(res.UserData+"\n")),
+			},
+			{
+				// The hash of the user data is kept in the state, without any change.
+				ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_ssh_key", sshKeyRes,
+					"testdata/r/hcloud_server", res,
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(res.TFID(), "user_data", userDataHash(res.UserData+"\n")),
+				),
+			},
+			{
+				ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_ssh_key", sshKeyRes,
+					"testdata/r/hcloud_server", res,
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccServerResource_ISO(t *testing.T) {
 	tmplMan := testtemplate.Manager{}
 
@@ -761,7 +851,7 @@ func TestAccServerResource_DirectAttachToNetworkSubnetID(t *testing.T) {
 					"testdata/r/hcloud_network_subnet", nws.SubnetA2,
 					"testdata/r/hcloud_server", res3,
 				),
-				ExpectError: regexp.MustCompile(`subnet_id \(\d+-10\.0\.1\.0\/24\) does not belong to the specified network_id \(12345\)`),
+				ExpectError: regexp.MustCompile(`subnet_id \(\d+-10\.0\.1\.0\/24\)\s+does\s+not\s+belong\s+to\s+the\s+specified\s+network_id\s+\(12345\)`),
 			},
 			{
 				Config: tmplMan.Render(t,
@@ -1114,8 +1204,7 @@ func TestAccServerResource_PrimaryIPs(t *testing.T) {
 				),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
-						// TODO: The resource should be updated.
-						plancheck.ExpectResourceAction(res7.TFID(), plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction(res7.TFID(), plancheck.ResourceActionUpdate),
 					},
 				},
 				Check: resource.ComposeTestCheckFunc(
@@ -1123,9 +1212,7 @@ func TestAccServerResource_PrimaryIPs(t *testing.T) {
 					testsupport.CheckResourceExists(ips.PrimaryIPv6C.TFID(), primaryip.ByID(t, &hcPrimaryIP)),
 					testsupport.LiftTCF(func() error {
 						assert.NotEqual(t, hcPrimaryIP.ID, hcServer.PublicNet.IPv4.ID)
-						// TODO: The managed primary ipv6 should have been removed and
-						// replaced with an unmanaged one.
-						assert.Equal(t, hcPrimaryIP.ID, hcServer.PublicNet.IPv6.ID)
+						assert.NotEqual(t, hcPrimaryIP.ID, hcServer.PublicNet.IPv6.ID)
 						assert.Equal(t, int64(0), hcServer.PublicNet.IPv4.ID)
 						assert.NotEqual(t, int64(0), hcServer.PublicNet.IPv6.ID)
 						return nil
@@ -1385,7 +1472,7 @@ func TestAccServerResource_PlacementGroup(t *testing.T) {
 					"testdata/r/hcloud_placement_group", resPlacementGroup,
 					"testdata/r/hcloud_server", resWithoutPG,
 				),
-				ExpectError: regexp.MustCompile("removing a running server from a placement group is currently not supported in the provider.*"),
+				ExpectError: regexp.MustCompile("Removing a running server from a placement group"),
 			},
 			{
 				// Remove Placement Group
@@ -1419,7 +1506,7 @@ func TestAccServerResource_PlacementGroup(t *testing.T) {
 				},
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resWithoutPG.TFID(), "status", "off"),
-					resource.TestCheckResourceAttr(resWithoutPG.TFID(), "placement_group_id", "0"),
+					resource.TestCheckNoResourceAttr(resWithoutPG.TFID(), "placement_group_id"),
 				),
 			},
 			{
@@ -1895,33 +1982,8 @@ func TestAccServerResource_RemoveDatacenter(t *testing.T) {
 	})
 }
 
-func userDataHashSum(userData string) string {
+// userDataHash returns the hash of the user data stored in the state.
+func userDataHash(userData string) string {
 	sum := sha1.Sum([]byte(userData)) // nolint: gosec
 	return base64.StdEncoding.EncodeToString(sum[:])
 }
-
-func TestToPublicNetField(t *testing.T) {
-	t.Run("int", func(t *testing.T) {
-		got, err := server.ToPublicNetField[int](map[string]any{"key": int(1)}, "key")
-		require.NoError(t, err)
-		require.Equal(t, int(1), got)
-	})
-
-	t.Run("bool", func(t *testing.T) {
-		got, err := server.ToPublicNetField[bool](map[string]any{"key": true}, "key")
-		require.NoError(t, err)
-		require.Equal(t, true, got)
-	})
-
-	t.Run("int not found", func(t *testing.T) {
-		got, err := server.ToPublicNetField[int](map[string]any{}, "key")
-		require.EqualError(t, err, "ToPublicNetField: field does not contain key: key")
-		require.Equal(t, int(0), got)
-	})
-
-	t.Run("bool not found", func(t *testing.T) {
-		got, err := server.ToPublicNetField[bool](map[string]any{}, "key")
-		require.EqualError(t, err, "ToPublicNetField: field does not contain key: key")
-		require.Equal(t, false, got)
-	})
-}
@@ -0,0 +1,264 @@
+package server
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
+)
+
+// modelV0 is the state of the server resource, as stored by the SDKv2
+// implementation of the resource.
+type modelV0 struct {
+	ID                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	ServerType              types.String `tfsdk:"server_type"`
+	Image                   types.String `tfsdk:"image"`
+	Location                types.String `tfsdk:"location"`
+	Datacenter              types.String `tfsdk:"datacenter"`
+	UserData                types.String `tfsdk:"user_data"`
+	SSHKeys                 types.List   `tfsdk:"ssh_keys"`
+	KeepDisk                types.Bool   `tfsdk:"keep_disk"`
+	AllowDeprecatedImages   types.Bool   `tfsdk:"allow_deprecated_images"`
+	BackupWindow            types.String `tfsdk:"backup_window"`
+	Backups                 types.Bool   `tfsdk:"backups"`
+	IPv4Address             types.String `tfsdk:"ipv4_address"`
+	IPv6Address             types.String `tfsdk:"ipv6_address"`
+	IPv6Network             types.String `tfsdk:"ipv6_network"`
+	Status                  types.String `tfsdk:"status"`
+	ISO                     types.String `tfsdk:"iso"`
+	Rescue                  types.String `tfsdk:"rescue"`
+	Labels                  types.Map    `tfsdk:"labels"`
+	PublicNet               types.Set    `tfsdk:"public_net"`
+	Network                 types.Set    `tfsdk:"network"`
+	IgnoreRemoteFirewallIDs types.Bool   `tfsdk:"ignore_remote_firewall_ids"`
+	FirewallIDs             types.Set    `tfsdk:"firewall_ids"`
+	PlacementGroupID        types.Int64  `tfsdk:"placement_group_id"`
+	DeleteProtection        types.Bool   `tfsdk:"delete_protection"`
+	RebuildProtection       types.Bool   `tfsdk:"rebuild_protection"`
+	ShutdownBeforeDeletion  types.Bool   `tfsdk:"shutdown_before_deletion"`
+	PrimaryDiskSize         types.Int64  `tfsdk:"primary_disk_size"`
+	Timeouts                types.Object `tfsdk:"timeouts"`
+}
+
+type modelNetworkV0 struct {
+	NetworkID  types.Int64  `tfsdk:"network_id"`
+	SubnetID   types.String `tfsdk:"subnet_id"`
+	IP         types.String `tfsdk:"ip"`
+	AliasIPs   types.Set    `tfsdk:"alias_ips"`
+	MACAddress types.String `tfsdk:"mac_address"`
+}
+
+func (r *Resource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                         schema.StringAttribute{Computed: true},
+					"name":                       schema.StringAttribute{Required: true},
+					"server_type":                schema.StringAttribute{Required: true},
+					"image":                      schema.StringAttribute{Optional: true, Computed: true},
+					"location":                   schema.StringAttribute{Optional: true, Computed: true},
+					"datacenter":                 schema.StringAttribute{Optional: true, Computed: true},
+					"user_data":                  schema.StringAttribute{Optional: true},
+					"ssh_keys":                   schema.ListAttribute{ElementType: types.StringType, Optional: true},
+					"keep_disk":                  schema.BoolAttribute{Optional: true},
+					"allow_deprecated_images":    schema.BoolAttribute{Optional: true},
+					"backup_window":              schema.StringAttribute{Computed: true},
+					"backups":                    schema.BoolAttribute{Optional: true},
+					"ipv4_address":               schema.StringAttribute{Computed: true},
+					"ipv6_address":               schema.StringAttribute{Computed: true},
+					"ipv6_network":               schema.StringAttribute{Computed: true},
+					"status":                     schema.StringAttribute{Computed: true},
+					"iso":                        schema.StringAttribute{Optional: true},
+					"rescue":                     schema.StringAttribute{Optional: true},
+					"labels":                     schema.MapAttribute{ElementType: types.StringType, Optional: true},
+					"ignore_remote_firewall_ids": schema.BoolAttribute{Optional: true},
+					"firewall_ids":               schema.SetAttribute{ElementType: types.Int64Type, Optional: true, Computed: true},
+					"placement_group_id":         schema.Int64Attribute{Optional: true},
+					"delete_protection":          schema.BoolAttribute{Optional: true},
+					"rebuild_protection":         schema.BoolAttribute{Optional: true},
+					"shutdown_before_deletion":   schema.BoolAttribute{Optional: true},
+					"primary_disk_size":          schema.Int64Attribute{Computed: true},
+				},
+				Blocks: map[string]schema.Block{
+					"public_net": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"ipv4_enabled": schema.BoolAttribute{Optional: true},
+								"ipv6_enabled": schema.BoolAttribute{Optional: true},
+								"ipv4":         schema.Int64Attribute{Optional: true, Computed: true},
+								"ipv6":         schema.Int64Attribute{Optional: true, Computed: true},
+							},
+						},
+					},
+					"network": schema.SetNestedBlock{
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"network_id":  schema.Int64Attribute{Optional: true, Computed: true},
+								"subnet_id":   schema.StringAttribute{Optional: true, Computed: true},
+								"ip":          schema.StringAttribute{Optional: true, Computed: true},
+								"alias_ips":   schema.SetAttribute{ElementType: types.StringType, Optional: true, Computed: true},
+								"mac_address": schema.StringAttribute{Computed: true},
+							},
+						},
+					},
+					"timeouts": schema.SingleNestedBlock{
+						Attributes: map[string]schema.Attribute{
+							"create": schema.StringAttribute{Optional: true},
+						},
+					},
+				},
+			},
+			StateUpgrader: upgradeStateV0,
+		},
+	}
+}
+
+func upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior modelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := util.ParseID(prior.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Server ID", err.Error())
+		return
+	}
+
+	data := model{
+		ID:                      types.Int64Value(id),
+		Name:                    prior.Name,
+		ServerType:              prior.ServerType,
+		Image:                   prior.Image,
+		Location:                prior.Location,
+		Datacenter:              types.StringNull(),
+		UserData:                emptyStringToNull(prior.UserData),
+		UserDataWO:              types.StringNull(),
+		UserDataWOVersion:       types.Int64Null(),
+		SSHKeys:                 prior.SSHKeys,
+		KeepDisk:                falseIfNull(prior.KeepDisk),
+		AllowDeprecatedImages:   prior.AllowDeprecatedImages,
+		BackupWindow:            prior.BackupWindow,
+		Backups:                 falseIfNull(prior.Backups),
+		IPv4Address:             emptyStringToNull(prior.IPv4Address),
+		IPv6Address:             emptyStringToNull(prior.IPv6Address),
+		IPv6Network:             emptyStringToNull(prior.IPv6Network),
+		Status:                  prior.Status,
+		ISO:                     emptyStringToNull(prior.ISO),
+		Rescue:                  emptyStringToNull(prior.Rescue),
+		Labels:                  prior.Labels,
+		IgnoreRemoteFirewallIDs: falseIfNull(prior.IgnoreRemoteFirewallIDs),
+		FirewallIDs:             prior.FirewallIDs,
+		PlacementGroupID:        prior.PlacementGroupID,
+		DeleteProtection:        falseIfNull(prior.DeleteProtection),
+		RebuildProtection:       falseIfNull(prior.RebuildProtection),
+		ShutdownBeforeDeletion:  falseIfNull(prior.ShutdownBeforeDeletion),
+		PrimaryDiskSize:         prior.PrimaryDiskSize,
+		Project:                 types.StringNull(),
+		Timeouts:                resourceutil.TimeoutsNull(),
+	}
+
+	// The SDKv2 stored the zero values for unset attributes.
+	if data.IPv6Network.ValueString() == "<nil>" {
+		data.IPv6Network = types.StringNull()
+	}
+	if data.PlacementGroupID.ValueInt64() == 0 {
+		data.PlacementGroupID = types.Int64Null()
+	}
+	if !data.AllowDeprecatedImages.ValueBool() {
+		data.AllowDeprecatedImages = types.BoolNull()
+	}
+	if data.Labels.IsNull() {
+		data.Labels = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+	if data.FirewallIDs.IsNull() {
+		data.FirewallIDs = types.SetValueMust(types.Int64Type, []attr.Value{})
+	}
+
+	publicNets := modelPublicNets{}
+	if !prior.PublicNet.IsNull() {
+		resp.Diagnostics.Append(publicNets.FromTerraform(ctx, prior.PublicNet)...)
+	}
+	for i := range publicNets {
+		if publicNets[i].IPv4.ValueInt64() == 0 {
+			publicNets[i].IPv4 = types.Int64Null()
+		}
+		if publicNets[i].IPv6.ValueInt64() == 0 {
+			publicNets[i].IPv6 = types.Int64Null()
+		}
+	}
+	var newDiags diag.Diagnostics
+	data.PublicNet, newDiags = publicNets.ToTerraform(ctx)
+	resp.Diagnostics.Append(newDiags...)
+
+	priorNetworks := []modelNetworkV0{}
+	if !prior.Network.IsNull() {
+		resp.Diagnostics.Append(prior.Network.ElementsAs(ctx, &priorNetworks, false)...)
+	}
+	networks := make(modelNetworks, 0, len(priorNetworks))
+	for _, item := range priorNetworks {
+		network := modelNetwork{
+			NetworkID:  item.NetworkID,
+			SubnetID:   emptyStringToNull(item.SubnetID),
+			IP:         iptypes.NewIPAddressNull(),
+			MACAddress: item.MACAddress,
+		}
+		if network.NetworkID.ValueInt64() == 0 {
+			network.NetworkID = types.Int64Null()
+		}
+		if item.IP.ValueString() != "" {
+			network.IP = iptypes.NewIPAddressValue(item.IP.ValueString())
+		}
+
+		aliasIPs := make([]attr.Value, 0, len(item.AliasIPs.Elements()))
+		for _, value := range item.AliasIPs.Elements() {
+			aliasIPs = append(aliasIPs, iptypes.NewIPAddressValue(value.(types.String).ValueString()))
+		}
+		network.AliasIPs = types.SetValueMust(iptypes.IPAddressType{}, aliasIPs)
+
+		networks = append(networks, network)
+	}
+	data.Network, newDiags = networks.ToTerraform(ctx)
+	resp.Diagnostics.Append(newDiags...)
+
+	// The SDKv2 only supported the create timeout.
+	if create, ok := prior.Timeouts.Attributes()["create"]; ok && !create.IsNull() {
+		data.Timeouts.Object, newDiags = types.ObjectValue(resourceutil.TimeoutsType().AttrTypes, map[string]attr.Value{
+			"create": create,
+			"update": types.StringNull(),
+			"delete": types.StringNull(),
+		})
+		resp.Diagnostics.Append(newDiags...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func emptyStringToNull(value types.String) types.String {
+	if value.ValueString() == "" {
+		return types.StringNull()
+	}
+	return value
+}
+
+func falseIfNull(value types.Bool) types.Bool {
+	if value.IsNull() {
+		return types.BoolValue(false)
+	}
+	return value
+}
@@ -131,6 +131,8 @@ type AData struct {
 
 	Type     string
 	ServerID string
+
+	Raw string
 }
 
 // TFID returns the resource identifier.
@@ -166,3 +168,20 @@ func NewBlueprint(t *testing.T) *Blueprint {
 
 	return b
 }
+
+// DDataMetrics defines the fields for the "testdata/d/hcloud_server_metrics"
+// template.
+type DDataMetrics struct {
+	testtemplate.DataCommon
+
+	ServerID string
+	Type     hcloud.ServerMetricType
+	Start    string
+	End      string
+	Step     int
+}
+
+// TFID returns the data source identifier.
+func (d *DDataMetrics) TFID() string {
+	return fmt.Sprintf("data.%s.%s", MetricsDataSourceType, d.RName())
+}
@@ -3,6 +3,7 @@ package sshkey
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -16,6 +17,9 @@ type resourceData struct {
 	Fingerprint types.String `tfsdk:"fingerprint"`
 	PublicKey   types.String `tfsdk:"public_key"`
 	Labels      types.Map    `tfsdk:"labels"`
+
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func populateResourceData(ctx context.Context, data *resourceData, in *hcloud.SSHKey) diag.Diagnostics {
@@ -28,6 +28,7 @@ type resourceDataWithSelector struct {
 
 	Selector     types.String `tfsdk:"selector"`
 	WithSelector types.String `tfsdk:"with_selector"`
+	Project      types.String `tfsdk:"project"`
 }
 
 func populateResourceDataWithSelector(ctx context.Context, data *resourceDataWithSelector, in *hcloud.SSHKey) diag.Diagnostics {
@@ -120,6 +121,7 @@ This resource is useful if you want to use a non-terraform managed SSH Key.
 			MarkdownDescription: "Filter results using a [Label Selector](https://docs.hetzner.cloud/reference/cloud#label-selector).",
 			Optional:            true,
 		},
+		"project": datasourceutil.ProjectAttribute(),
 	})
 }
 
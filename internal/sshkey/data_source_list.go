@@ -12,6 +12,7 @@ import (
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/datasourceutil"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
 )
@@ -70,6 +71,7 @@ This resource is useful if you want to use a non-terraform managed SSH Key.
 			MarkdownDescription: "Filter results using a [Label Selector](https://docs.hetzner.cloud/reference/cloud#label-selector)",
 			Optional:            true,
 		},
+		"project": datasourceutil.ProjectAttribute(),
 	}
 }
 
@@ -78,6 +80,7 @@ type resourceDataList struct {
 	SSHKeys types.List   `tfsdk:"ssh_keys"`
 
 	WithSelector types.String `tfsdk:"with_selector"`
+	Project      types.String `tfsdk:"project"`
 }
 
 func populateResourceDataList(ctx context.Context, data *resourceDataList, in []*hcloud.SSHKey) diag.Diagnostics {
@@ -0,0 +1,199 @@
+package storagebox
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/actionutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+)
+
+const (
+	ResetPasswordActionType    = "hcloud_storage_box_reset_password"
+	RollbackSnapshotActionType = "hcloud_storage_box_rollback_snapshot"
+	ChangeTypeActionType       = "hcloud_storage_box_change_type"
+)
+
+var _ action.Action = (*storageBoxAction[storageBoxActionData])(nil)
+var _ action.ActionWithConfigure = (*storageBoxAction[storageBoxActionData])(nil)
+
+type storageBoxActionData struct {
+	StorageBoxID types.Int64  `tfsdk:"storage_box_id"`
+	Project      types.String `tfsdk:"project"`
+}
+
+func (d storageBoxActionData) storageBoxID() int64 {
+	return d.StorageBoxID.ValueInt64()
+}
+
+// storageBoxActionModel is implemented by the data of the Storage Box actions,
+// the Storage Box action data must be embedded in the data of each action.
+type storageBoxActionModel interface {
+	storageBoxID() int64
+}
+
+// storageBoxActionInvoke runs the action on the Storage Box and returns the API
+// action to wait for.
+type storageBoxActionInvoke[T storageBoxActionModel] func(ctx context.Context, client *hcloud.Client, storageBox *hcloud.StorageBox, data T) (*hcloud.Action, error)
+
+type storageBoxAction[T storageBoxActionModel] struct {
+	client              *hcloud.Client
+	typeName            string
+	markdownDescription string
+	attributes          map[string]actionschema.Attribute
+	invoke              storageBoxActionInvoke[T]
+}
+
+type resetPasswordActionData struct {
+	storageBoxActionData
+	Password types.String `tfsdk:"password"`
+}
+
+func NewResetPasswordAction() action.Action {
+	return &storageBoxAction[resetPasswordActionData]{
+		typeName: ResetPasswordActionType,
+		markdownDescription: util.MarkdownDescription(`
+Reset the password of a Storage Box in Hetzner.
+
+The password is not stored in the Terraform state, it may be provided using an ephemeral value. When the Storage Box is managed by Terraform, the password of the ` + "`hcloud_storage_box`" + ` resource is not updated.
+
+See the [Reset Password documentation](https://docs.hetzner.cloud/reference/hetzner#storage-box-actions-reset-password) for more details.
+`),
+		attributes: map[string]actionschema.Attribute{
+			"password": actionschema.StringAttribute{
+				MarkdownDescription: "New password of the Storage Box. For more details, see the [Storage Boxes password policy](https://docs.hetzner.cloud/reference/hetzner#storage-boxes-password-policy).",
+				Required:            true,
+				WriteOnly:           true,
+			},
+		},
+		invoke: func(ctx context.Context, client *hcloud.Client, storageBox *hcloud.StorageBox, data resetPasswordActionData) (*hcloud.Action, error) {
+			apiAction, _, err := client.StorageBox.ResetPassword(ctx, storageBox, hcloud.StorageBoxResetPasswordOpts{
+				Password: data.Password.ValueString(),
+			})
+			return apiAction, err
+		},
+	}
+}
+
+type rollbackSnapshotActionData struct {
+	storageBoxActionData
+	Snapshot types.String `tfsdk:"snapshot"`
+}
+
+func NewRollbackSnapshotAction() action.Action {
+	return &storageBoxAction[rollbackSnapshotActionData]{
+		typeName: RollbackSnapshotActionType,
+		markdownDescription: util.MarkdownDescription(`
+Roll back a Storage Box in Hetzner to a Storage Box Snapshot. All the data written after the Snapshot was taken will be lost.
+
+See the [Rollback Snapshot documentation](https://docs.hetzner.cloud/reference/hetzner#storage-box-actions-rollback-snapshot) for more details.
+`),
+		attributes: map[string]actionschema.Attribute{
+			"snapshot": actionschema.StringAttribute{
+				MarkdownDescription: "ID or name of the Storage Box Snapshot to roll back to.",
+				Required:            true,
+			},
+		},
+		invoke: func(ctx context.Context, client *hcloud.Client, storageBox *hcloud.StorageBox, data rollbackSnapshotActionData) (*hcloud.Action, error) {
+			snapshot := &hcloud.StorageBoxSnapshot{}
+			if id, err := util.ParseID(data.Snapshot.ValueString()); err == nil {
+				snapshot.ID = id
+			} else {
+				snapshot.Name = data.Snapshot.ValueString()
+			}
+
+			apiAction, _, err := client.StorageBox.RollbackSnapshot(ctx, storageBox, hcloud.StorageBoxRollbackSnapshotOpts{
+				Snapshot: snapshot,
+			})
+			return apiAction, err
+		},
+	}
+}
+
+type changeTypeActionData struct {
+	storageBoxActionData
+	StorageBoxType types.String `tfsdk:"storage_box_type"`
+}
+
+func NewChangeTypeAction() action.Action {
+	return &storageBoxAction[changeTypeActionData]{
+		typeName: ChangeTypeActionType,
+		markdownDescription: util.MarkdownDescription(`
+Change the type of a Storage Box in Hetzner.
+
+When the Storage Box is managed by Terraform, the ` + "`storage_box_type`" + ` of the ` + "`hcloud_storage_box`" + ` resource must be updated accordingly, otherwise the type is changed back during the next apply.
+
+See the [Change Type documentation](https://docs.hetzner.cloud/reference/hetzner#storage-box-actions-change-type) for more details.
+`),
+		attributes: map[string]actionschema.Attribute{
+			"storage_box_type": actionschema.StringAttribute{
+				MarkdownDescription: "Name of the Storage Box Type to change the Storage Box to.",
+				Required:            true,
+			},
+		},
+		invoke: func(ctx context.Context, client *hcloud.Client, storageBox *hcloud.StorageBox, data changeTypeActionData) (*hcloud.Action, error) {
+			apiAction, _, err := client.StorageBox.ChangeType(ctx, storageBox, hcloud.StorageBoxChangeTypeOpts{
+				StorageBoxType: &hcloud.StorageBoxType{Name: data.StorageBoxType.ValueString()},
+			})
+			return apiAction, err
+		},
+	}
+}
+
+func (a *storageBoxAction[T]) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = a.typeName
+}
+
+func (a *storageBoxAction[T]) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	a.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+}
+
+func (a *storageBoxAction[T]) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = actionschema.Schema{
+		MarkdownDescription: a.markdownDescription,
+		Attributes: map[string]actionschema.Attribute{
+			"storage_box_id": actionschema.Int64Attribute{
+				MarkdownDescription: "ID of the Storage Box to apply the action to.",
+				Required:            true,
+			},
+			"project": actionutil.ProjectAttribute(),
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, a.attributes)
+}
+
+func (a *storageBoxAction[T]) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if a.client == nil {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider client is not configured. This is an issue in the provider. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	var data T
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storageBox := &hcloud.StorageBox{ID: data.storageBoxID()}
+
+	apiAction, err := a.invoke(ctx, a.client, storageBox, data)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &a.client.Action, apiAction)...)
+}
@@ -0,0 +1,92 @@
+package storagebox_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/kit/randutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/storagebox"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/teste2e"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testmux"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testsupport"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testtemplate"
+)
+
+func TestAccStorageBoxActions(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	storageBox := &hcloud.StorageBox{}
+
+	res := &storagebox.RData{
+		StorageBox: schema.StorageBox{
+			Name:           fmt.Sprintf("storage-box-%s", randutil.GenerateID()),
+			StorageBoxType: schema.StorageBoxType{Name: teste2e.TestStorageBoxType},
+			Location:       schema.Location{Name: teste2e.TestLocationName},
+		},
+		Password: storagebox.GeneratePassword(t),
+	}
+	res.SetRName("default")
+
+	resActionResetPassword := &storagebox.AData{
+		Type:         "reset_password",
+		StorageBoxID: res.TFID() + ".id",
+		Raw:          fmt.Sprintf("password = %q", storagebox.GeneratePassword(t)),
+	}
+	resActionResetPassword.SetRName("default")
+
+	res.Raw = fmt.Sprintf(`
+		lifecycle {
+			action_trigger {
+				events  = [after_create]
+				actions = [%s]
+			}
+		}
+	`, resActionResetPassword.TFID())
+
+	resource.ParallelTest(t, resource.TestCase{
+		// Actions are only available in 1.14 and later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		CheckDestroy:             testsupport.CheckAPIResourceAllAbsent(storagebox.ResourceType, storagebox.GetAPIResource()),
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_storage_box", res,
+					"testdata/a/hcloud_storage_box", resActionResetPassword,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testsupport.CheckAPIResourcePresent(res.TFID(), testsupport.CopyAPIResource(storageBox, storagebox.GetAPIResource())),
+					func(_ *terraform.State) error {
+						client, err := testsupport.CreateClient()
+						if err != nil {
+							return err
+						}
+
+						actions, err := client.StorageBox.Action.AllFor(context.Background(), storageBox, hcloud.ActionListOpts{})
+						if err != nil {
+							return err
+						}
+
+						assert.True(t, slices.ContainsFunc(actions, func(action *hcloud.Action) bool {
+							return action.Command == "reset_password"
+						}))
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -158,6 +158,7 @@ See the [Storage Boxes API documentation](https://docs.hetzner.cloud/reference/h
 			MarkdownDescription: "Filter results using a [Label Selector](https://docs.hetzner.cloud/reference/hetzner#label-selector).",
 			Optional:            true,
 		},
+		"project": datasourceutil.ProjectAttribute(),
 	})
 }
 
@@ -165,6 +166,7 @@ type dataSourceModel struct {
 	commonModel
 
 	WithSelector types.String `tfsdk:"with_selector"`
+	Project      types.String `tfsdk:"project"`
 }
 
 var _ util.ModelFromAPI[*hcloud.StorageBox] = &dataSourceModel{}
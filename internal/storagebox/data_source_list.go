@@ -11,6 +11,7 @@ import (
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/datasourceutil"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
 )
 
@@ -65,6 +66,7 @@ See the [Storage Boxes API documentation](https://docs.hetzner.cloud/reference/h
 			MarkdownDescription: "Filter results using a [Label Selector](https://docs.hetzner.cloud/reference/cloud#label-selector)",
 			Optional:            true,
 		},
+		"project": datasourceutil.ProjectAttribute(),
 	}
 }
 
@@ -72,6 +74,7 @@ type dataSourceListModel struct {
 	StorageBoxes types.List `tfsdk:"storage_boxes"`
 
 	WithSelector types.String `tfsdk:"with_selector"`
+	Project      types.String `tfsdk:"project"`
 }
 
 var _ util.ModelFromAPI[[]*hcloud.StorageBox] = &dataSourceListModel{}
@@ -3,7 +3,12 @@ package storagebox
 import (
 	"context"
 	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
@@ -31,6 +37,8 @@ const ResourceType = "hcloud_storage_box"
 var _ resource.Resource = (*Resource)(nil)
 var _ resource.ResourceWithConfigure = (*Resource)(nil)
 var _ resource.ResourceWithImportState = (*Resource)(nil)
+var _ resource.ResourceWithIdentity = (*Resource)(nil)
+var _ resource.ResourceWithConfigValidators = (*Resource)(nil)
 
 type Resource struct {
 	client *hcloud.Client
@@ -106,9 +114,25 @@ See the [Storage Box API documentation](https://docs.hetzner.cloud/reference/het
 			},
 		},
 		"password": schema.StringAttribute{
-			MarkdownDescription: "Password of the Storage Box. For more details, see the [Storage Boxes password policy](https://docs.hetzner.cloud/reference/hetzner#storage-boxes-password-policy).",
-			Required:            true,
+			MarkdownDescription: "Password of the Storage Box. For more details, see the [Storage Boxes password policy](https://docs.hetzner.cloud/reference/hetzner#storage-boxes-password-policy). Exactly one of `password` or `password_wo` must be set.",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"password_wo": schema.StringAttribute{
+			MarkdownDescription: "Password of the Storage Box, the value is never stored in the state. For more details, see the [Storage Boxes password policy](https://docs.hetzner.cloud/reference/hetzner#storage-boxes-password-policy). Must be used together with `password_wo_version`.",
+			Optional:            true,
 			Sensitive:           true,
+			WriteOnly:           true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+			},
+		},
+		"password_wo_version": schema.Int64Attribute{
+			MarkdownDescription: "Version of the `password_wo` attribute. Changing the version resets the password of the Storage Box.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+			},
 		},
 		"labels": resourceutil.LabelsSchema(),
 		"ssh_keys": schema.SetAttribute{
@@ -205,14 +229,23 @@ See the [Storage Box API documentation](https://docs.hetzner.cloud/reference/het
 				},
 			},
 		},
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
 	}
 }
 
 type resourceModel struct {
 	commonModel
 
-	Password types.String `tfsdk:"password"`
-	SSHKeys  types.Set    `tfsdk:"ssh_keys"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	SSHKeys           types.Set    `tfsdk:"ssh_keys"`
+
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 var _ util.ModelFromAPI[*hcloud.StorageBox] = &resourceModel{} // reuse commonModel, as the fields from resourceModel are not readable anyway
@@ -222,8 +255,11 @@ func (m *resourceModel) tfAttributesTypes() map[string]attr.Type {
 	return merge.Maps(
 		(&commonModel{}).tfAttributesTypes(),
 		map[string]attr.Type{
-			"password": types.StringType,
-			"ssh_keys": types.SetType{ElemType: types.StringType},
+			"password":            types.StringType,
+			"password_wo_version": types.Int64Type,
+			"ssh_keys":            types.SetType{ElemType: types.StringType},
+			"project":             types.StringType,
+			"timeouts":            resourceutil.TimeoutsType(),
 		},
 	)
 }
@@ -232,19 +268,61 @@ func (m *resourceModel) ToTerraform(ctx context.Context) (types.Object, diag.Dia
 	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
 }
 
+// resetPasswordValue returns the password to reset, or null if the password did
+// not change. The write-only password is only reset when its version changed.
+func resetPasswordValue(data, plan resourceModel, passwordWO types.String) types.String {
+	switch {
+	case !passwordWO.IsNull() && !plan.PasswordWOVersion.Equal(data.PasswordWOVersion):
+		return passwordWO
+	case !plan.Password.IsUnknown() && !plan.Password.IsNull() && !plan.Password.Equal(data.Password):
+		return plan.Password
+	default:
+		return types.StringNull()
+	}
+}
+
+func (r *Resource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("password"),
+			path.MatchRoot("password_wo"),
+		),
+	}
+}
+
+func (r *Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceutil.IDIdentitySchema("ID of the Storage Box.")
+}
+
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resourceModel
+	var passwordWO types.String
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 60*time.Minute)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
+
+	password := data.Password
+	if !passwordWO.IsNull() {
+		password = passwordWO
+	}
+
 	opts := hcloud.StorageBoxCreateOpts{
 		Name:           data.Name.ValueString(),
 		StorageBoxType: &hcloud.StorageBoxType{Name: data.StorageBoxType.ValueString()},
 		Location:       &hcloud.Location{Name: data.Location.ValueString()},
-		Password:       data.Password.ValueString(),
+		Password:       password.ValueString(),
 	}
 
 	resp.Diagnostics.Append(hcloudutil.TerraformLabelsToHCloud(ctx, data.Labels, &opts.Labels)...)
@@ -351,6 +429,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -378,17 +457,29 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data, plan resourceModel
+	var passwordWO types.String
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "update", updateTimeout)
+	defer cancel()
+
 	storageBox := &hcloud.StorageBox{ID: data.ID.ValueInt64()}
 
 	// Run Actions
@@ -474,9 +565,9 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	}
 
 	// Action: Reset Password
-	if !plan.Password.IsUnknown() && !plan.Password.Equal(data.Password) {
+	if password := resetPasswordValue(data, plan, passwordWO); !password.IsNull() {
 		opts := hcloud.StorageBoxResetPasswordOpts{
-			Password: plan.Password.ValueString(),
+			Password: password.ValueString(),
 		}
 
 		action, _, err := r.client.StorageBox.ResetPassword(ctx, storageBox, opts)
@@ -550,8 +641,11 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	if !plan.Password.IsUnknown() && !plan.Password.Equal(data.Password) {
 		data.Password = plan.Password
 	}
+	data.PasswordWOVersion = plan.PasswordWOVersion
+	data.Timeouts = plan.Timeouts
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
 func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -562,6 +656,15 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
+
 	storageBox := &hcloud.StorageBox{ID: data.ID.ValueInt64()}
 
 	// Disable delete protection before deleting.
@@ -602,6 +705,14 @@ func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequ
 	// Not setting the `ssh_keys` value during import will trigger a replacement of the resource, even if the
 	// user configured `lifecycle { ignore_changes = [ssh_keys]}`.
 
+	if req.ID == "" {
+		var identity resourceutil.IDIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ssh_keys"), []string{})...)
+		return
+	}
+
 	if id, err := strconv.ParseInt(req.ID, 10, 64); err == nil {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ssh_keys"), []string{})...)
@@ -2,6 +2,7 @@ package storagebox_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/kit/randutil"
@@ -194,3 +196,78 @@ func TestAccStorageBoxResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccStorageBoxResource_PasswordWO(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	storageBox := &hcloud.StorageBox{}
+
+	res := &storagebox.RData{
+		StorageBox: schema.StorageBox{
+			Name:           fmt.Sprintf("storage-box-%s", randutil.GenerateID()),
+			StorageBoxType: schema.StorageBoxType{Name: teste2e.TestStorageBoxType},
+			Location:       schema.Location{Name: teste2e.TestLocationName},
+		},
+		Raw: fmt.Sprintf(`
+			password_wo         = %q
+			password_wo_version = 1
+		`, storagebox.GeneratePassword(t)),
+	}
+	res.SetRName("default")
+
+	resMissingVersion := testtemplate.DeepCopy(t, res)
+	resMissingVersion.Raw = fmt.Sprintf(`
+		password_wo = %q
+	`, storagebox.GeneratePassword(t))
+
+	resUpdated := testtemplate.DeepCopy(t, res)
+	resUpdated.Raw = fmt.Sprintf(`
+		password_wo         = %q
+		password_wo_version = 2
+	`, storagebox.GeneratePassword(t))
+
+	resource.ParallelTest(t, resource.TestCase{
+		// Write-only attributes are only available in 1.11 and later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		CheckDestroy:             testsupport.CheckAPIResourceAllAbsent(storagebox.ResourceType, storagebox.GetAPIResource()),
+		Steps: []resource.TestStep{
+			{
+				// The password is never applied without a version
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_storage_box", resMissingVersion,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute\s+"password_wo_version"\s+must\s+be\s+specified\s+when\s+"password_wo"\s+is\s+specified`),
+			},
+			{
+				Config: tmplMan.Render(t, "testdata/r/hcloud_storage_box", res),
+				Check: resource.ComposeTestCheckFunc(
+					testsupport.CheckAPIResourcePresent(res.TFID(), testsupport.CopyAPIResource(storageBox, storagebox.GetAPIResource())),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(res.TFID(), tfjsonpath.New("password"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(res.TFID(), tfjsonpath.New("password_wo"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(res.TFID(), tfjsonpath.New("password_wo_version"), knownvalue.Int64Exact(1)),
+				},
+			},
+			{
+				// Update the password by changing the version
+				Config: tmplMan.Render(t, "testdata/r/hcloud_storage_box", resUpdated),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resUpdated.TFID(), plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resUpdated.TFID(), tfjsonpath.New("password"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resUpdated.TFID(), tfjsonpath.New("password_wo"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resUpdated.TFID(), tfjsonpath.New("password_wo_version"), knownvalue.Int64Exact(2)),
+				},
+			},
+		},
+	})
+}
@@ -81,3 +81,19 @@ func GeneratePassword(t *testing.T) string {
 
 	return password.String()
 }
+
+// AData defines the fields for the "testdata/a/hcloud_storage_box"
+// template.
+type AData struct {
+	testtemplate.DataCommon
+
+	Type         string
+	StorageBoxID string
+
+	Raw string
+}
+
+// TFID returns the action identifier.
+func (d *AData) TFID() string {
+	return fmt.Sprintf("action.hcloud_storage_box_%s.%s", d.Type, d.RName())
+}
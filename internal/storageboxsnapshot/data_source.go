@@ -112,6 +112,7 @@ See the [Storage Box Snapshots API documentation](https://docs.hetzner.cloud/ref
 			MarkdownDescription: "Filter results using a [Label Selector](https://docs.hetzner.cloud/reference/hetzner#label-selector).",
 			Optional:            true,
 		},
+		"project": datasourceutil.ProjectAttribute(),
 	})
 }
 
@@ -161,6 +162,7 @@ type dataSourceModel struct {
 	dataSourceCommonModel
 
 	WithSelector types.String `tfsdk:"with_selector"`
+	Project      types.String `tfsdk:"project"`
 }
 
 // ConfigValidators returns a list of ConfigValidators. Each ConfigValidator's Validate method will be called when validating the data source.
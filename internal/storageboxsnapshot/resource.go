@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -29,6 +31,7 @@ const ResourceType = "hcloud_storage_box_snapshot"
 var _ resource.Resource = (*Resource)(nil)
 var _ resource.ResourceWithConfigure = (*Resource)(nil)
 var _ resource.ResourceWithImportState = (*Resource)(nil)
+var _ resource.ResourceWithIdentity = (*Resource)(nil)
 
 type Resource struct {
 	client *hcloud.Client
@@ -57,7 +60,7 @@ func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, r
 }
 
 // Schema should return the schema for this resource.
-func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema.MarkdownDescription = `
 Provides a Hetzner Storage Box Snapshot resource.
 
@@ -100,18 +103,54 @@ See the [Storage Box Snapshots API documentation](https://docs.hetzner.cloud/ref
 				boolplanmodifier.UseStateForUnknown(),
 			},
 		},
-		"labels": resourceutil.LabelsSchema(),
+		"labels":  resourceutil.LabelsSchema(),
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
+	}
+}
+
+func (r *Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"storage_box_id": identityschema.Int64Attribute{
+				Description:       "ID of the Storage Box.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.Int64Attribute{
+				Description:       "ID of the Storage Box Snapshot.",
+				RequiredForImport: true,
+			},
+		},
 	}
 }
 
+type resourceModel struct {
+	model
+
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data model
+	var data resourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
+
 	storageBox := &hcloud.StorageBox{
 		ID: data.StorageBoxID.ValueInt64(),
 	}
@@ -129,7 +168,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	// Create in API
 	// For a single storage box, only a single snapshot can be created simultaneously, all others fail with `locked` error.
 	var result hcloud.StorageBoxSnapshotCreateResult
-	err := control.Retry(2*control.DefaultRetries, func() error {
+	err := control.Retry(ctx, 2*control.DefaultRetries, func() error {
 		var err error
 
 		result, _, err = r.client.StorageBox.CreateSnapshot(ctx, storageBox, opts)
@@ -173,10 +212,11 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newIdentity(data.model))...)
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data model
+	var data resourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -202,10 +242,11 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newIdentity(data.model))...)
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data, plan model
+	var data, plan resourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -213,6 +254,15 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "update", updateTimeout)
+	defer cancel()
+
 	snapshot := &hcloud.StorageBoxSnapshot{
 		StorageBox: &hcloud.StorageBox{ID: data.StorageBoxID.ValueInt64()},
 		ID:         data.ID.ValueInt64(),
@@ -245,16 +295,26 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newIdentity(data.model))...)
 }
 
 func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data model
+	var data resourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
+
 	snapshot := &hcloud.StorageBoxSnapshot{
 		StorageBox: &hcloud.StorageBox{ID: data.StorageBoxID.ValueInt64()},
 		ID:         data.ID.ValueInt64(),
@@ -274,6 +334,14 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 }
 
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity identityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("storage_box_id"), identity.StorageBoxID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		return
+	}
+
 	parts := strings.Split(req.ID, "/")
 	if len(parts) != 2 {
 		resp.Diagnostics.Append(util.InvalidImportID("$STORAGE_BOX_ID/$SNAPSHOT_ID", req.ID))
@@ -0,0 +1,105 @@
+package storageboxsubaccount
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/actionutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+)
+
+const ResetPasswordActionType = "hcloud_storage_box_subaccount_reset_password"
+
+var _ action.Action = (*ResetPasswordAction)(nil)
+var _ action.ActionWithConfigure = (*ResetPasswordAction)(nil)
+
+type ResetPasswordAction struct {
+	client *hcloud.Client
+}
+
+func NewResetPasswordAction() action.Action {
+	return &ResetPasswordAction{}
+}
+
+func (a *ResetPasswordAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = ResetPasswordActionType
+}
+
+func (a *ResetPasswordAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	a.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+}
+
+func (a *ResetPasswordAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = actionschema.Schema{
+		MarkdownDescription: util.MarkdownDescription(`
+Reset the password of a Storage Box Subaccount in Hetzner.
+
+The password is not stored in the Terraform state, it may be provided using an ephemeral value. When the Storage Box Subaccount is managed by Terraform, the password of the ` + "`hcloud_storage_box_subaccount`" + ` resource is not updated.
+
+See the [Reset Subaccount Password documentation](https://docs.hetzner.cloud/reference/hetzner#storage-box-subaccount-actions-reset-password) for more details.
+`),
+		Attributes: map[string]actionschema.Attribute{
+			"storage_box_id": actionschema.Int64Attribute{
+				MarkdownDescription: "ID of the Storage Box.",
+				Required:            true,
+			},
+			"subaccount_id": actionschema.Int64Attribute{
+				MarkdownDescription: "ID of the Storage Box Subaccount to apply the action to.",
+				Required:            true,
+			},
+			"password": actionschema.StringAttribute{
+				MarkdownDescription: "New password of the Storage Box Subaccount. For more details, see the [Storage Boxes password policy](https://docs.hetzner.cloud/reference/hetzner#storage-boxes-password-policy).",
+				Required:            true,
+				WriteOnly:           true,
+			},
+			"project": actionutil.ProjectAttribute(),
+		},
+	}
+}
+
+type resetPasswordActionData struct {
+	StorageBoxID types.Int64  `tfsdk:"storage_box_id"`
+	SubaccountID types.Int64  `tfsdk:"subaccount_id"`
+	Password     types.String `tfsdk:"password"`
+	Project      types.String `tfsdk:"project"`
+}
+
+func (a *ResetPasswordAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	if a.client == nil {
+		resp.Diagnostics.AddError(
+			"Provider not configured",
+			"The provider client is not configured. This is an issue in the provider. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	var data resetPasswordActionData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subaccount := &hcloud.StorageBoxSubaccount{
+		ID:         data.SubaccountID.ValueInt64(),
+		StorageBox: &hcloud.StorageBox{ID: data.StorageBoxID.ValueInt64()},
+	}
+
+	apiAction, _, err := a.client.StorageBox.ResetSubaccountPassword(ctx, subaccount, hcloud.StorageBoxSubaccountResetPasswordOpts{
+		Password: data.Password.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &a.client.Action, apiAction)...)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/datasourceutil"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
 )
 
@@ -69,6 +70,7 @@ See the [Storage Box Subaccounts API documentation](https://docs.hetzner.cloud/r
 			MarkdownDescription: "Filter results using a [Label Selector](https://docs.hetzner.cloud/reference/cloud#label-selector)",
 			Optional:            true,
 		},
+		"project": datasourceutil.ProjectAttribute(),
 	}
 }
 
@@ -77,6 +79,7 @@ type dataSourceListModel struct {
 	Subaccounts  types.List  `tfsdk:"subaccounts"`
 
 	WithSelector types.String `tfsdk:"with_selector"`
+	Project      types.String `tfsdk:"project"`
 }
 
 var _ util.ModelFromAPI[[]*hcloud.StorageBoxSubaccount] = &dataSourceListModel{}
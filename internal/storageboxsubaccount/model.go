@@ -119,3 +119,15 @@ func (m *modelAccessSettings) FromTerraform(ctx context.Context, tf types.Object
 func (m *modelAccessSettings) ToTerraform(ctx context.Context) (types.Object, diag.Diagnostics) {
 	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
 }
+
+type identityModel struct {
+	StorageBoxID types.Int64 `tfsdk:"storage_box_id"`
+	ID           types.Int64 `tfsdk:"id"`
+}
+
+func newIdentity(m model) identityModel {
+	return identityModel{
+		StorageBoxID: m.StorageBoxID,
+		ID:           m.ID,
+	}
+}
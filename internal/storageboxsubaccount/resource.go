@@ -5,10 +5,15 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -16,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
@@ -32,6 +38,8 @@ const ResourceType = "hcloud_storage_box_subaccount"
 var _ resource.Resource = (*Resource)(nil)
 var _ resource.ResourceWithConfigure = (*Resource)(nil)
 var _ resource.ResourceWithImportState = (*Resource)(nil)
+var _ resource.ResourceWithIdentity = (*Resource)(nil)
+var _ resource.ResourceWithConfigValidators = (*Resource)(nil)
 
 type Resource struct {
 	client *hcloud.Client
@@ -115,9 +123,25 @@ See the [Storage Box Subaccounts API documentation](https://docs.hetzner.cloud/r
 			Required:            true,
 		},
 		"password": schema.StringAttribute{
-			MarkdownDescription: "Password of the Storage Box. For more details, see the [Storage Boxes password policy](https://docs.hetzner.cloud/reference/hetzner#storage-boxes-password-policy).",
-			Required:            true,
+			MarkdownDescription: "Password of the Storage Box Subaccount. For more details, see the [Storage Boxes password policy](https://docs.hetzner.cloud/reference/hetzner#storage-boxes-password-policy). Exactly one of `password` or `password_wo` must be set.",
+			Optional:            true,
+			Sensitive:           true,
+		},
+		"password_wo": schema.StringAttribute{
+			MarkdownDescription: "Password of the Storage Box Subaccount, the value is never stored in the state. For more details, see the [Storage Boxes password policy](https://docs.hetzner.cloud/reference/hetzner#storage-boxes-password-policy). Must be used together with `password_wo_version`.",
+			Optional:            true,
 			Sensitive:           true,
+			WriteOnly:           true,
+			Validators: []validator.String{
+				stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+			},
+		},
+		"password_wo_version": schema.Int64Attribute{
+			MarkdownDescription: "Version of the `password_wo` attribute. Changing the version resets the password of the Storage Box Subaccount.",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+			},
 		},
 		"server": schema.StringAttribute{
 			MarkdownDescription: "FQDN of the Storage Box Subaccount.",
@@ -164,14 +188,23 @@ See the [Storage Box Subaccounts API documentation](https://docs.hetzner.cloud/r
 				},
 			},
 		},
-		"labels": resourceutil.LabelsSchema(),
+		"labels":  resourceutil.LabelsSchema(),
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
 	}
 }
 
 type resourceModel struct {
 	model
 
-	Password types.String `tfsdk:"password"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 var _ util.ModelFromAPI[*hcloud.StorageBoxSubaccount] = &resourceModel{} // reuse model, as the fields from resourceModel are not readable anyway
@@ -181,7 +214,10 @@ func (m *resourceModel) tfAttributesTypes() map[string]attr.Type {
 	return merge.Maps(
 		(&model{}).tfAttributesTypes(),
 		map[string]attr.Type{
-			"password": types.StringType,
+			"password":            types.StringType,
+			"password_wo_version": types.Int64Type,
+			"project":             types.StringType,
+			"timeouts":            resourceutil.TimeoutsType(),
 		},
 	)
 }
@@ -190,14 +226,67 @@ func (m *resourceModel) ToTerraform(ctx context.Context) (types.Object, diag.Dia
 	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
 }
 
+// resetPasswordValue returns the password to reset, or null if the password did
+// not change. The write-only password is only reset when its version changed.
+func resetPasswordValue(data, plan resourceModel, passwordWO types.String) types.String {
+	switch {
+	case !passwordWO.IsNull() && !plan.PasswordWOVersion.Equal(data.PasswordWOVersion):
+		return passwordWO
+	case !plan.Password.IsUnknown() && !plan.Password.IsNull() && !plan.Password.Equal(data.Password):
+		return plan.Password
+	default:
+		return types.StringNull()
+	}
+}
+
+func (r *Resource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("password"),
+			path.MatchRoot("password_wo"),
+		),
+	}
+}
+
+func (r *Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"storage_box_id": identityschema.Int64Attribute{
+				Description:       "ID of the Storage Box.",
+				RequiredForImport: true,
+			},
+			"id": identityschema.Int64Attribute{
+				Description:       "ID of the Storage Box Subaccount.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data resourceModel
+	var passwordWO types.String
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
+
+	password := data.Password
+	if !passwordWO.IsNull() {
+		password = passwordWO
+	}
+
 	storageBox := &hcloud.StorageBox{
 		ID: data.StorageBoxID.ValueInt64(),
 	}
@@ -205,7 +294,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	opts := hcloud.StorageBoxSubaccountCreateOpts{
 		Name:          data.Name.ValueString(),
 		HomeDirectory: data.HomeDirectory.ValueString(),
-		Password:      data.Password.ValueString(),
+		Password:      password.ValueString(),
 		Description:   data.Description.ValueString(),
 	}
 
@@ -231,7 +320,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	// Create in API
 	// For a single storage box, only a single subaccount can be created simultaneously, all others fail with `locked` error.
 	var result hcloud.StorageBoxSubaccountCreateResult
-	err := control.Retry(2*control.DefaultRetries, func() error {
+	err := control.Retry(ctx, 2*control.DefaultRetries, func() error {
 		var err error
 
 		result, _, err = r.client.StorageBox.CreateSubaccount(ctx, storageBox, opts)
@@ -275,6 +364,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newIdentity(data.model))...)
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -304,17 +394,29 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newIdentity(data.model))...)
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data, plan resourceModel
+	var passwordWO types.String
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password_wo"), &passwordWO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "update", updateTimeout)
+	defer cancel()
+
 	subaccount := &hcloud.StorageBoxSubaccount{
 		StorageBox: &hcloud.StorageBox{ID: data.StorageBoxID.ValueInt64()},
 		ID:         data.ID.ValueInt64(),
@@ -340,9 +442,9 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	}
 
 	// Action: Reset Password
-	if !plan.Password.IsUnknown() && !plan.Password.Equal(data.Password) {
+	if password := resetPasswordValue(data, plan, passwordWO); !password.IsNull() {
 		action, _, err := r.client.StorageBox.ResetSubaccountPassword(ctx, subaccount, hcloud.StorageBoxSubaccountResetPasswordOpts{
-			Password: plan.Password.ValueString(),
+			Password: password.ValueString(),
 		})
 
 		if err != nil {
@@ -419,8 +521,11 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	if !plan.Password.IsUnknown() && !plan.Password.Equal(data.Password) {
 		data.Password = plan.Password
 	}
+	data.PasswordWOVersion = plan.PasswordWOVersion
+	data.Timeouts = plan.Timeouts
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, newIdentity(data.model))...)
 }
 
 func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -431,6 +536,15 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
+
 	subaccount := &hcloud.StorageBoxSubaccount{
 		StorageBox: &hcloud.StorageBox{ID: data.StorageBoxID.ValueInt64()},
 		ID:         data.ID.ValueInt64(),
@@ -450,6 +564,14 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 }
 
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity identityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("storage_box_id"), identity.StorageBoxID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		return
+	}
+
 	parts := strings.Split(req.ID, "/")
 	if len(parts) != 2 {
 		resp.Diagnostics.Append(util.InvalidImportID("$STORAGE_BOX_ID/$SUBACCOUNT_ID", req.ID))
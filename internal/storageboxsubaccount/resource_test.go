@@ -2,6 +2,7 @@ package storageboxsubaccount_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/kit/randutil"
@@ -177,3 +179,92 @@ func TestAccStorageBoxSubaccountResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccStorageBoxSubaccountResource_PasswordWO(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	subaccount := &hcloud.StorageBoxSubaccount{}
+
+	resStorageBox := &storagebox.RData{
+		StorageBox: schema.StorageBox{
+			Name:           fmt.Sprintf("storage-box-subaccount-%s", randutil.GenerateID()),
+			StorageBoxType: schema.StorageBoxType{Name: teste2e.TestStorageBoxType},
+			Location:       schema.Location{Name: teste2e.TestLocationName},
+		},
+		Password: storagebox.GeneratePassword(t),
+	}
+	resStorageBox.SetRName("default")
+
+	res := &storageboxsubaccount.RData{
+		StorageBox:    resStorageBox.TFID() + ".id",
+		HomeDirectory: "test",
+		Raw: fmt.Sprintf(`
+			password_wo         = %q
+			password_wo_version = 1
+		`, storagebox.GeneratePassword(t)),
+	}
+	res.SetRName("subaccount")
+
+	resMissingVersion := testtemplate.DeepCopy(t, res)
+	resMissingVersion.Raw = fmt.Sprintf(`
+		password_wo = %q
+	`, storagebox.GeneratePassword(t))
+
+	resUpdated := testtemplate.DeepCopy(t, res)
+	resUpdated.Raw = fmt.Sprintf(`
+		password_wo         = %q
+		password_wo_version = 2
+	`, storagebox.GeneratePassword(t))
+
+	resource.ParallelTest(t, resource.TestCase{
+		// Write-only attributes are only available in 1.11 and later
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		CheckDestroy:             testsupport.CheckAPIResourceAllAbsent(storageboxsubaccount.ResourceType, storageboxsubaccount.GetAPIResource()),
+		Steps: []resource.TestStep{
+			{
+				// The password is never applied without a version
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_storage_box", resStorageBox,
+					"testdata/r/hcloud_storage_box_subaccount", resMissingVersion,
+				),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute\s+"password_wo_version"\s+must\s+be\s+specified\s+when\s+"password_wo"\s+is\s+specified`),
+			},
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_storage_box", resStorageBox,
+					"testdata/r/hcloud_storage_box_subaccount", res,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testsupport.CheckAPIResourcePresent(res.TFID(), testsupport.CopyAPIResource(subaccount, storageboxsubaccount.GetAPIResource())),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(res.TFID(), tfjsonpath.New("password"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(res.TFID(), tfjsonpath.New("password_wo"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(res.TFID(), tfjsonpath.New("password_wo_version"), knownvalue.Int64Exact(1)),
+				},
+			},
+			{
+				// Update the password by changing the version
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_storage_box", resStorageBox,
+					"testdata/r/hcloud_storage_box_subaccount", resUpdated,
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resUpdated.TFID(), plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resUpdated.TFID(), tfjsonpath.New("password"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resUpdated.TFID(), tfjsonpath.New("password_wo"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resUpdated.TFID(), tfjsonpath.New("password_wo_version"), knownvalue.Int64Exact(2)),
+				},
+			},
+		},
+	})
+}
@@ -73,3 +73,20 @@ type RData struct {
 func (d *RData) TFID() string {
 	return fmt.Sprintf("%s.%s", ResourceType, d.RName())
 }
+
+// AData defines the fields for the "testdata/a/hcloud_storage_box_subaccount"
+// template.
+type AData struct {
+	testtemplate.DataCommon
+
+	Type         string
+	StorageBoxID string
+	SubaccountID string
+
+	Raw string
+}
+
+// TFID returns the action identifier.
+func (d *AData) TFID() string {
+	return fmt.Sprintf("action.hcloud_storage_box_subaccount_%s.%s", d.Type, d.RName())
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/joho/godotenv"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testfake"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/testsupport"
 )
 
@@ -56,6 +57,11 @@ func PreCheck(t *testing.T) func() {
 		t.Fatalf("Could not load .env file: %v", err)
 	}
 
+	// Run the tests against the in-memory fake API, without spending money.
+	if testfake.Enabled() {
+		testfake.Setup()
+	}
+
 	return func() {
 		if v := os.Getenv("HCLOUD_TOKEN"); v == "" {
 			t.Fatal("HCLOUD_TOKEN must be set for acceptance tests")
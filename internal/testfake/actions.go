@@ -0,0 +1,123 @@
+package testfake
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+type action struct {
+	schema.Action
+
+	// reads is the number of times the action was read.
+	reads int
+	// complete is called once the action succeeded.
+	complete func()
+}
+
+// newAction creates a running action for the given resources. The complete
+// function is called once the action succeeded, to apply the changes that only
+// become visible after the action, and may be nil.
+func (a *API) newAction(command string, complete func(), resources ...schema.ActionResourceReference) schema.Action {
+	act := &action{
+		Action: schema.Action{
+			ID:        a.nextID(),
+			Status:    "running",
+			Command:   command,
+			Started:   now(),
+			Resources: resources,
+		},
+		complete: complete,
+	}
+	if act.Resources == nil {
+		act.Resources = []schema.ActionResourceReference{}
+	}
+
+	a.actions[act.ID] = act
+	if a.ActionSteps <= 0 {
+		a.progress(act)
+	}
+
+	return act.Action
+}
+
+// progress advances the action, and completes it once it was read
+// [API.ActionSteps] times.
+func (a *API) progress(act *action) {
+	if act.Status != "running" {
+		return
+	}
+
+	act.reads++
+	if act.reads < a.ActionSteps {
+		act.Progress = 100 * act.reads / a.ActionSteps
+		return
+	}
+
+	finished := now()
+	act.Status = "success"
+	act.Progress = 100
+	act.Finished = &finished
+
+	if act.complete != nil {
+		act.complete()
+		act.complete = nil
+	}
+}
+
+func (a *API) registerActions() {
+	a.handle("GET /actions", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		ids := make([]int64, 0, len(query["id"]))
+		for _, value := range query["id"] {
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				writeInvalidInput(w, "invalid id")
+				return
+			}
+			ids = append(ids, id)
+		}
+		if len(ids) == 0 {
+			writeInvalidInput(w, "id is required")
+			return
+		}
+
+		actions := []schema.Action{}
+		for _, id := range sortedIDs(a.actions) {
+			act := a.actions[id]
+			if !slices.Contains(ids, id) {
+				continue
+			}
+			a.progress(act)
+			if statuses := query["status"]; len(statuses) > 0 && !slices.Contains(statuses, act.Status) {
+				continue
+			}
+			actions = append(actions, act.Action)
+		}
+
+		writeJSON(w, http.StatusOK, struct {
+			schema.ActionListResponse
+			schema.MetaResponse
+		}{
+			ActionListResponse: schema.ActionListResponse{Actions: actions},
+			MetaResponse:       schema.MetaResponse{Meta: listMeta(len(actions))},
+		})
+	})
+
+	a.handle("GET /actions/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathID(w, r, "id")
+		if !ok {
+			return
+		}
+		act, ok := a.actions[id]
+		if !ok {
+			writeNotFound(w, "action")
+			return
+		}
+		a.progress(act)
+		writeJSON(w, http.StatusOK, schema.ActionGetResponse{Action: act.Action})
+	})
+}
@@ -0,0 +1,270 @@
+// Package testfake implements an in-memory fake of the Hetzner Cloud and Hetzner
+// APIs, used to run the acceptance tests without a real project.
+//
+// The fake supports the endpoints used by the servers, networks, zones, zone
+// RRSets and storage boxes resources, as well as the catalog endpoints they
+// depend on (locations, datacenters, server types, images and storage box
+// types). Actions are created in the running state and progress each time they
+// are read, until they succeed, see [API.ActionSteps].
+package testfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+// DefaultActionSteps is the default number of reads required for an action to
+// complete.
+const DefaultActionSteps = 2
+
+// API is an in-memory fake of the Hetzner Cloud and Hetzner APIs.
+//
+// Both APIs are served on the same root, the API can therefore be used for the
+// `endpoint` and the `endpoint_hetzner` of the provider.
+type API struct {
+	// ActionSteps is the number of reads required for an action to complete.
+	ActionSteps int
+
+	mux *http.ServeMux
+
+	mu     sync.Mutex
+	lastID int64
+
+	actions      map[int64]*action
+	servers      map[int64]*schema.Server
+	networks     map[int64]*schema.Network
+	zones        map[int64]*schema.Zone
+	rrsets       map[int64][]*schema.ZoneRRSet
+	storageBoxes map[int64]*schema.StorageBox
+}
+
+// New returns a new empty fake API.
+func New() *API {
+	a := &API{
+		ActionSteps: DefaultActionSteps,
+
+		mux: http.NewServeMux(),
+
+		// IDs below are reserved for the catalog.
+		lastID: 1000,
+
+		actions:      map[int64]*action{},
+		servers:      map[int64]*schema.Server{},
+		networks:     map[int64]*schema.Network{},
+		zones:        map[int64]*schema.Zone{},
+		rrsets:       map[int64][]*schema.ZoneRRSet{},
+		storageBoxes: map[int64]*schema.StorageBox{},
+	}
+
+	a.registerActions()
+	a.registerCatalog()
+	a.registerServers()
+	a.registerNetworks()
+	a.registerZones()
+	a.registerStorageBoxes()
+
+	return a
+}
+
+func (a *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "Bearer ") {
+		writeError(w, http.StatusUnauthorized, "unauthorized", "unable to authenticate")
+		return
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.mux.ServeHTTP(w, r)
+}
+
+// handle registers a handler, all handlers are called with the lock held.
+func (a *API) handle(pattern string, handler http.HandlerFunc) {
+	a.mux.HandleFunc(pattern, handler)
+}
+
+func (a *API) nextID() int64 {
+	a.lastID++
+	return a.lastID
+}
+
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, schema.ErrorResponse{
+		Error: schema.Error{Code: code, Message: message},
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, resource string) {
+	writeError(w, http.StatusNotFound, "not_found", fmt.Sprintf("%s not found", resource))
+}
+
+func writeInvalidInput(w http.ResponseWriter, message string) {
+	writeError(w, http.StatusBadRequest, "invalid_input", message)
+}
+
+func writeUniquenessError(w http.ResponseWriter, field string) {
+	writeError(w, http.StatusConflict, "uniqueness_error", fmt.Sprintf("%s is already used", field))
+}
+
+func writeProtected(w http.ResponseWriter, resource string) {
+	writeError(w, http.StatusLocked, "protected", fmt.Sprintf("%s is protected", resource))
+}
+
+// decode reads the JSON body of the request, and writes an error response if it
+// is invalid.
+func decode(w http.ResponseWriter, r *http.Request, body any) bool {
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		writeInvalidInput(w, fmt.Sprintf("invalid request body: %v", err))
+		return false
+	}
+	return true
+}
+
+// pathID parses the ID path value of the request, and writes an error response
+// if it is invalid.
+func pathID(w http.ResponseWriter, r *http.Request, name string) (int64, bool) {
+	id, err := strconv.ParseInt(r.PathValue(name), 10, 64)
+	if err != nil {
+		writeInvalidInput(w, fmt.Sprintf("invalid %s", name))
+		return 0, false
+	}
+	return id, true
+}
+
+// listMeta returns the pagination meta of a list response, all the entries are
+// always returned in a single page.
+func listMeta(total int) schema.Meta {
+	return schema.Meta{
+		Pagination: &schema.MetaPagination{
+			Page:         1,
+			PerPage:      max(total, 1),
+			LastPage:     1,
+			TotalEntries: total,
+		},
+	}
+}
+
+// sortedIDs returns the keys of the map in ascending order.
+func sortedIDs[T any](m map[int64]T) []int64 {
+	ids := make([]int64, 0, len(m))
+	for id := range m {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// matchesFilters reports whether a resource matches the `name` and
+// `label_selector` query parameters of a list request.
+func matchesFilters(r *http.Request, name string, labels map[string]string) bool {
+	query := r.URL.Query()
+	if value := query.Get("name"); value != "" && value != name {
+		return false
+	}
+	return matchesLabelSelector(query.Get("label_selector"), labels)
+}
+
+// matchesLabelSelector reports whether the labels match the label selector.
+//
+// Supported expressions are `key`, `!key`, `key=value`, `key==value`,
+// `key!=value`, `key in (a,b)` and `key notin (a,b)`.
+func matchesLabelSelector(selector string, labels map[string]string) bool {
+	for _, expr := range splitLabelSelector(selector) {
+		expr = strings.TrimSpace(expr)
+		if expr == "" {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(expr, "!"):
+			if _, ok := labels[strings.TrimPrefix(expr, "!")]; ok {
+				return false
+			}
+		case strings.Contains(expr, "!="):
+			key, value, _ := strings.Cut(expr, "!=")
+			if labels[strings.TrimSpace(key)] == strings.TrimSpace(value) {
+				return false
+			}
+		case strings.Contains(expr, "="):
+			key, value, _ := strings.Cut(strings.Replace(expr, "==", "=", 1), "=")
+			actual, ok := labels[strings.TrimSpace(key)]
+			if !ok || actual != strings.TrimSpace(value) {
+				return false
+			}
+		case strings.Contains(expr, " notin "):
+			key, values, _ := strings.Cut(expr, " notin ")
+			if actual, ok := labels[strings.TrimSpace(key)]; ok && slices.Contains(setValues(values), actual) {
+				return false
+			}
+		case strings.Contains(expr, " in "):
+			key, values, _ := strings.Cut(expr, " in ")
+			actual, ok := labels[strings.TrimSpace(key)]
+			if !ok || !slices.Contains(setValues(values), actual) {
+				return false
+			}
+		default:
+			if _, ok := labels[expr]; !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// splitLabelSelector splits the label selector on the commas outside of the set
+// expressions.
+func splitLabelSelector(selector string) []string {
+	var (
+		exprs []string
+		depth int
+		start int
+	)
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				exprs = append(exprs, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(exprs, selector[start:])
+}
+
+func setValues(values string) []string {
+	values = strings.Trim(strings.TrimSpace(values), "()")
+	result := strings.Split(values, ",")
+	for i := range result {
+		result[i] = strings.TrimSpace(result[i])
+	}
+	return result
+}
+
+func labelsOrEmpty(labels *map[string]string) map[string]string {
+	if labels == nil || *labels == nil {
+		return map[string]string{}
+	}
+	return *labels
+}
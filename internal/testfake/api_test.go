@@ -0,0 +1,222 @@
+package testfake
+
+import (
+	"net"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func newTestClient(t *testing.T) *hcloud.Client {
+	t.Helper()
+
+	server := httptest.NewServer(New())
+	t.Cleanup(server.Close)
+
+	return hcloud.NewClient(
+		hcloud.WithToken(Token),
+		hcloud.WithEndpoint(server.URL),
+		hcloud.WithHetznerEndpoint(server.URL),
+		hcloud.WithPollOpts(hcloud.PollOpts{BackoffFunc: hcloud.ConstantBackoff(time.Millisecond)}),
+	)
+}
+
+func TestServers(t *testing.T) {
+	ctx := t.Context()
+	client := newTestClient(t)
+
+	network, _, err := client.Network.Create(ctx, hcloud.NetworkCreateOpts{
+		Name:    "network",
+		IPRange: mustParseCIDR(t, "10.0.0.0/16"),
+		Subnets: []hcloud.NetworkSubnet{{
+			Type:        hcloud.NetworkSubnetTypeCloud,
+			IPRange:     mustParseCIDR(t, "10.0.1.0/24"),
+			NetworkZone: hcloud.NetworkZoneEUCentral,
+		}},
+	})
+	require.NoError(t, err)
+
+	result, _, err := client.Server.Create(ctx, hcloud.ServerCreateOpts{
+		Name:       "server",
+		ServerType: &hcloud.ServerType{Name: "cpx22"},
+		Image:      &hcloud.Image{Name: "ubuntu-24.04"},
+		Location:   &hcloud.Location{Name: "hel1"},
+		Labels:     map[string]string{"key": "value"},
+		Networks:   []*hcloud.Network{network},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, hcloud.ServerStatusInitializing, result.Server.Status)
+	assert.Equal(t, hcloud.ActionStatusRunning, result.Action.Status)
+	assert.NotEmpty(t, result.RootPassword)
+
+	require.NoError(t, client.Action.WaitFor(ctx, result.Action))
+
+	server, _, err := client.Server.GetByID(ctx, result.Server.ID)
+	require.NoError(t, err)
+	assert.Equal(t, hcloud.ServerStatusRunning, server.Status)
+	assert.Equal(t, "hel1", server.Location.Name)
+	assert.Equal(t, "cpx22", server.ServerType.Name)
+	require.Len(t, server.PrivateNet, 1)
+	assert.Equal(t, "10.0.1.1", server.PrivateNet[0].IP.String())
+
+	servers, err := client.Server.AllWithOpts(ctx, hcloud.ServerListOpts{ListOpts: hcloud.ListOpts{LabelSelector: "key=value"}})
+	require.NoError(t, err)
+	assert.Len(t, servers, 1)
+
+	action, _, err := client.Server.Poweroff(ctx, server)
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, action))
+
+	action, _, err = client.Server.ChangeType(ctx, server, hcloud.ServerChangeTypeOpts{
+		ServerType: &hcloud.ServerType{Name: "cpx32"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, action))
+
+	server, _, err = client.Server.GetByID(ctx, server.ID)
+	require.NoError(t, err)
+	assert.Equal(t, hcloud.ServerStatusOff, server.Status)
+	assert.Equal(t, "cpx32", server.ServerType.Name)
+
+	deleteResult, _, err := client.Server.DeleteWithResult(ctx, server)
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, deleteResult.Action))
+
+	server, _, err = client.Server.GetByID(ctx, server.ID)
+	require.NoError(t, err)
+	assert.Nil(t, server)
+
+	network, _, err = client.Network.GetByID(ctx, network.ID)
+	require.NoError(t, err)
+	assert.Empty(t, network.Servers)
+}
+
+func TestZones(t *testing.T) {
+	ctx := t.Context()
+	client := newTestClient(t)
+
+	result, _, err := client.Zone.Create(ctx, hcloud.ZoneCreateOpts{
+		Name: "example.com",
+		Mode: hcloud.ZoneModePrimary,
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, result.Action))
+
+	zone, _, err := client.Zone.GetByName(ctx, "example.com")
+	require.NoError(t, err)
+	require.NotNil(t, zone)
+	assert.Equal(t, 3600, zone.TTL)
+
+	rrsetResult, _, err := client.Zone.CreateRRSet(ctx, zone, hcloud.ZoneRRSetCreateOpts{
+		Name:    "www",
+		Type:    hcloud.ZoneRRSetTypeA,
+		Records: []hcloud.ZoneRRSetRecord{{Value: "201.78.10.45"}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, rrsetResult.Action))
+
+	action, _, err := client.Zone.AddRRSetRecords(ctx, rrsetResult.RRSet, hcloud.ZoneRRSetAddRecordsOpts{
+		Records: []hcloud.ZoneRRSetRecord{{Value: "201.78.10.46"}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, action))
+
+	rrset, _, err := client.Zone.GetRRSetByNameAndType(ctx, zone, "www", hcloud.ZoneRRSetTypeA)
+	require.NoError(t, err)
+	require.NotNil(t, rrset)
+	assert.Len(t, rrset.Records, 2)
+
+	rrsets, err := client.Zone.AllRRSets(ctx, zone)
+	require.NoError(t, err)
+	assert.Len(t, rrsets, 3) // SOA, NS and A
+
+	zonefile, _, err := client.Zone.ExportZonefile(ctx, zone)
+	require.NoError(t, err)
+	assert.Contains(t, zonefile.Zonefile, "www IN A 201.78.10.46\n")
+
+	_, _, err = client.Zone.Create(ctx, hcloud.ZoneCreateOpts{
+		Name: "example.com",
+		Mode: hcloud.ZoneModePrimary,
+	})
+	assert.True(t, hcloud.IsError(err, hcloud.ErrorCodeUniquenessError))
+}
+
+func TestStorageBoxes(t *testing.T) {
+	ctx := t.Context()
+	client := newTestClient(t)
+
+	result, _, err := client.StorageBox.Create(ctx, hcloud.StorageBoxCreateOpts{
+		Name:           "storage-box",
+		StorageBoxType: &hcloud.StorageBoxType{Name: "bx11"},
+		Location:       &hcloud.Location{Name: "fsn1"},
+		Password:       "password",
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, result.Action))
+
+	storageBox, _, err := client.StorageBox.GetByID(ctx, result.StorageBox.ID)
+	require.NoError(t, err)
+	assert.Equal(t, hcloud.StorageBoxStatusActive, storageBox.Status)
+
+	action, _, err := client.StorageBox.ChangeProtection(ctx, storageBox, hcloud.StorageBoxChangeProtectionOpts{
+		Delete: hcloud.Ptr(true),
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.Action.WaitFor(ctx, action))
+
+	_, _, err = client.StorageBox.Delete(ctx, storageBox)
+	assert.True(t, hcloud.IsError(err, hcloud.ErrorCodeProtected))
+}
+
+func TestActionProgress(t *testing.T) {
+	a := New()
+	a.ActionSteps = 3
+
+	created := a.newAction("test", nil)
+	act := a.actions[created.ID]
+	assert.Equal(t, "running", act.Status)
+
+	a.progress(act)
+	assert.Equal(t, "running", act.Status)
+	assert.Equal(t, 33, act.Progress)
+
+	a.progress(act)
+	a.progress(act)
+	assert.Equal(t, "success", act.Status)
+	assert.Equal(t, 100, act.Progress)
+	assert.NotNil(t, act.Finished)
+}
+
+func TestMatchesLabelSelector(t *testing.T) {
+	labels := map[string]string{"env": "prod", "team": "a"}
+
+	for selector, expected := range map[string]bool{
+		"":                        true,
+		"env":                     true,
+		"!env":                    false,
+		"env=prod":                true,
+		"env==prod":               true,
+		"env=dev":                 false,
+		"env!=dev":                true,
+		"env=prod,team=a":         true,
+		"env=prod,team=b":         false,
+		"env in (dev,prod)":       true,
+		"env notin (dev,prod)":    false,
+		"env in (dev,prod),!team": false,
+	} {
+		assert.Equal(t, expected, matchesLabelSelector(selector, labels), selector)
+	}
+}
+
+func mustParseCIDR(t *testing.T, value string) *net.IPNet {
+	t.Helper()
+
+	_, ipNet, err := net.ParseCIDR(value)
+	require.NoError(t, err)
+	return ipNet
+}
@@ -0,0 +1,203 @@
+package testfake
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+var locations = []schema.Location{
+	{ID: 1, Name: "fsn1", Description: "Falkenstein DC Park 1", Country: "DE", City: "Falkenstein", Latitude: 50.47612, Longitude: 12.370071, NetworkZone: "eu-central"},
+	{ID: 2, Name: "nbg1", Description: "Nuremberg DC Park 1", Country: "DE", City: "Nuremberg", Latitude: 49.452102, Longitude: 11.076665, NetworkZone: "eu-central"},
+	{ID: 3, Name: "hel1", Description: "Helsinki DC Park 1", Country: "FI", City: "Helsinki", Latitude: 60.169855, Longitude: 24.938379, NetworkZone: "eu-central"},
+	{ID: 4, Name: "ash", Description: "Ashburn, VA", Country: "US", City: "Ashburn, VA", Latitude: 39.045821, Longitude: -77.487073, NetworkZone: "us-east"},
+	{ID: 5, Name: "hil", Description: "Hillsboro, OR", Country: "US", City: "Hillsboro, OR", Latitude: 45.54222, Longitude: -122.951924, NetworkZone: "us-west"},
+	{ID: 6, Name: "sin", Description: "Singapore", Country: "SG", City: "Singapore", Latitude: 1.283333, Longitude: 103.833333, NetworkZone: "ap-southeast"},
+}
+
+var datacenters = []schema.Datacenter{
+	{ID: 4, Name: "fsn1-dc14", Description: "Falkenstein 1 virtual DC 14", Location: locations[0]},
+	{ID: 2, Name: "nbg1-dc3", Description: "Nuremberg 1 virtual DC 3", Location: locations[1]},
+	{ID: 3, Name: "hel1-dc2", Description: "Helsinki 1 virtual DC 2", Location: locations[2]},
+	{ID: 5, Name: "ash-dc1", Description: "Ashburn virtual DC 1", Location: locations[3]},
+	{ID: 6, Name: "hil-dc1", Description: "Hillsboro virtual DC 1", Location: locations[4]},
+	{ID: 7, Name: "sin-dc1", Description: "Singapore virtual DC 1", Location: locations[5]},
+}
+
+var serverTypes = []schema.ServerType{
+	newServerType(108, "cpx12", 1, 2, 40, "x86"),
+	newServerType(109, "cpx22", 2, 4, 80, "x86"),
+	newServerType(110, "cpx32", 4, 8, 160, "x86"),
+	newServerType(104, "cx23", 2, 4, 40, "x86"),
+	newServerType(45, "cax11", 2, 4, 40, "arm"),
+}
+
+func newServerType(id int64, name string, cores int, memory float32, disk int, architecture string) schema.ServerType {
+	serverType := schema.ServerType{
+		ID:              id,
+		Name:            name,
+		Description:     name,
+		Category:        "shared",
+		Cores:           cores,
+		Memory:          memory,
+		Disk:            disk,
+		StorageType:     "local",
+		CPUType:         "shared",
+		Architecture:    architecture,
+		IncludedTraffic: 21990232555520,
+		Prices:          []schema.PricingServerTypePrice{},
+	}
+	for _, location := range locations {
+		serverType.Locations = append(serverType.Locations, schema.ServerTypeLocation{
+			ID:        location.ID,
+			Name:      location.Name,
+			Available: true,
+		})
+	}
+	return serverType
+}
+
+var images = []schema.Image{
+	newSystemImage(161547269, "ubuntu-24.04", "ubuntu", "24.04", "x86"),
+	newSystemImage(161547270, "ubuntu-24.04", "ubuntu", "24.04", "arm"),
+	newSystemImage(114690387, "debian-12", "debian", "12", "x86"),
+	newSystemImage(310557660, "debian-13", "debian", "13", "x86"),
+}
+
+func newSystemImage(id int64, name, flavor, version, architecture string) schema.Image {
+	created := now()
+	return schema.Image{
+		ID:           id,
+		Status:       "available",
+		Type:         "system",
+		Name:         &name,
+		Description:  name,
+		DiskSize:     5,
+		Created:      &created,
+		OSFlavor:     flavor,
+		OSVersion:    &version,
+		Architecture: architecture,
+		RapidDeploy:  true,
+		Labels:       map[string]string{},
+	}
+}
+
+var storageBoxTypes = []schema.StorageBoxType{
+	newStorageBoxType(1333, "bx11", 1099511627776, 10, 100),
+	newStorageBoxType(1334, "bx21", 5497558138880, 20, 100),
+	newStorageBoxType(1335, "bx31", 10995116277760, 30, 100),
+}
+
+func newStorageBoxType(id int64, name string, size int64, snapshotLimit, subaccountsLimit int) schema.StorageBoxType {
+	return schema.StorageBoxType{
+		ID:                     id,
+		Name:                   name,
+		Description:            name,
+		SnapshotLimit:          &snapshotLimit,
+		AutomaticSnapshotLimit: &snapshotLimit,
+		SubaccountsLimit:       subaccountsLimit,
+		Size:                   size,
+		Prices:                 []schema.StorageBoxTypePrice{},
+	}
+}
+
+func (a *API) registerCatalog() {
+	registerCatalog(a, "locations", "location", locations,
+		func(l schema.Location) (int64, string) { return l.ID, l.Name }, nil)
+	registerCatalog(a, "datacenters", "datacenter", datacenters,
+		func(d schema.Datacenter) (int64, string) { return d.ID, d.Name }, nil)
+	registerCatalog(a, "server_types", "server_type", serverTypes,
+		func(s schema.ServerType) (int64, string) { return s.ID, s.Name }, nil)
+	registerCatalog(a, "images", "image", images,
+		func(i schema.Image) (int64, string) { return i.ID, *i.Name },
+		func(r *http.Request, i schema.Image) bool {
+			query := r.URL.Query()
+			if value := query.Get("architecture"); value != "" && value != i.Architecture {
+				return false
+			}
+			if value := query.Get("type"); value != "" && value != i.Type {
+				return false
+			}
+			return matchesLabelSelector(query.Get("label_selector"), i.Labels)
+		})
+	registerCatalog(a, "storage_box_types", "storage_box_type", storageBoxTypes,
+		func(s schema.StorageBoxType) (int64, string) { return s.ID, s.Name }, nil)
+}
+
+// registerCatalog registers the list and get handlers of read-only resources.
+func registerCatalog[T any](
+	a *API,
+	plural, singular string,
+	items []T,
+	key func(T) (int64, string),
+	filter func(*http.Request, T) bool,
+) {
+	a.handle("GET /"+plural, func(w http.ResponseWriter, r *http.Request) {
+		result := []T{}
+		for _, item := range items {
+			_, name := key(item)
+			if value := r.URL.Query().Get("name"); value != "" && value != name {
+				continue
+			}
+			if filter != nil && !filter(r, item) {
+				continue
+			}
+			result = append(result, item)
+		}
+		writeJSON(w, http.StatusOK, map[string]any{
+			plural: result,
+			"meta": listMeta(len(result)),
+		})
+	})
+
+	a.handle("GET /"+plural+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+		for _, item := range items {
+			itemID, name := key(item)
+			if (err == nil && itemID == id) || name == r.PathValue("id") {
+				writeJSON(w, http.StatusOK, map[string]any{singular: item})
+				return
+			}
+		}
+		writeNotFound(w, singular)
+	})
+}
+
+func findLocation(idOrName schema.IDOrName) (schema.Location, bool) {
+	for _, location := range locations {
+		if location.ID == idOrName.ID || (idOrName.Name != "" && location.Name == idOrName.Name) {
+			return location, true
+		}
+	}
+	return schema.Location{}, false
+}
+
+func findServerType(idOrName schema.IDOrName) (schema.ServerType, bool) {
+	for _, serverType := range serverTypes {
+		if serverType.ID == idOrName.ID || (idOrName.Name != "" && serverType.Name == idOrName.Name) {
+			return serverType, true
+		}
+	}
+	return schema.ServerType{}, false
+}
+
+// findImage returns the image with the given ID, or the image with the given
+// name and architecture.
+func findImage(idOrName schema.IDOrName, architecture string) (schema.Image, bool) {
+	for _, image := range images {
+		if image.ID == idOrName.ID || (idOrName.Name != "" && *image.Name == idOrName.Name && image.Architecture == architecture) {
+			return image, true
+		}
+	}
+	return schema.Image{}, false
+}
+
+func findStorageBoxType(idOrName schema.IDOrName) (schema.StorageBoxType, bool) {
+	for _, storageBoxType := range storageBoxTypes {
+		if storageBoxType.ID == idOrName.ID || (idOrName.Name != "" && storageBoxType.Name == idOrName.Name) {
+			return storageBoxType, true
+		}
+	}
+	return schema.StorageBoxType{}, false
+}
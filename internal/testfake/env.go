@@ -0,0 +1,42 @@
+package testfake
+
+import (
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// EnvVar is the environment variable enabling the fake API in the acceptance
+// tests.
+const EnvVar = "HCLOUD_FAKE_API"
+
+// Token is the API token configured when the fake API is enabled. The fake API
+// accepts any token.
+const Token = "fake-api-token" // nolint: gosec
+
+var (
+	setupOnce sync.Once
+	server    *httptest.Server
+)
+
+// Enabled reports whether the acceptance tests run against the fake API.
+func Enabled() bool {
+	enabled, _ := strconv.ParseBool(os.Getenv(EnvVar))
+	return enabled
+}
+
+// Setup starts the fake API shared by the tests of the package, and configures
+// the provider and the test client to use it, using the `HCLOUD_TOKEN`,
+// `HCLOUD_ENDPOINT` and `HETZNER_ENDPOINT` environment variables.
+//
+// The fake API is only started once, and is stopped when the test binary exits.
+func Setup() {
+	setupOnce.Do(func() {
+		server = httptest.NewServer(New())
+	})
+
+	_ = os.Setenv("HCLOUD_TOKEN", Token)
+	_ = os.Setenv("HCLOUD_ENDPOINT", server.URL)
+	_ = os.Setenv("HETZNER_ENDPOINT", server.URL)
+}
@@ -0,0 +1,314 @@
+package testfake
+
+import (
+	"fmt"
+	"net/http"
+	"net/netip"
+	"slices"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func networkResource(id int64) schema.ActionResourceReference {
+	return schema.ActionResourceReference{ID: id, Type: "network"}
+}
+
+// networkGateway returns the gateway of the network, which is the first address
+// of the network IP range.
+func networkGateway(network *schema.Network) string {
+	prefix, err := netip.ParsePrefix(network.IPRange)
+	if err != nil {
+		return ""
+	}
+	return prefix.Masked().Addr().Next().String()
+}
+
+// parseSubnet validates the subnet of a network, and returns the normalized
+// subnet.
+func parseSubnet(network *schema.Network, subnet schema.NetworkSubnet) (schema.NetworkSubnet, error) {
+	networkPrefix, err := netip.ParsePrefix(network.IPRange)
+	if err != nil {
+		return subnet, err
+	}
+	prefix, err := netip.ParsePrefix(subnet.IPRange)
+	if err != nil {
+		return subnet, fmt.Errorf("invalid ip_range: %w", err)
+	}
+	if !networkPrefix.Contains(prefix.Addr()) || prefix.Bits() < networkPrefix.Bits() {
+		return subnet, fmt.Errorf("ip_range %s is not part of the network ip_range %s", subnet.IPRange, network.IPRange)
+	}
+	for _, existing := range network.Subnets {
+		if existing.IPRange == prefix.Masked().String() {
+			return subnet, fmt.Errorf("ip_range %s is already used", subnet.IPRange)
+		}
+	}
+
+	subnet.IPRange = prefix.Masked().String()
+	subnet.Gateway = networkGateway(network)
+	return subnet, nil
+}
+
+// allocateNetworkIP returns a free IP of the network, in the first subnet
+// containing the requested IP or in the first subnet.
+func (a *API) allocateNetworkIP(network *schema.Network, requested string) (string, error) {
+	used := map[string]bool{networkGateway(network): true}
+	for _, serverID := range network.Servers {
+		for _, privateNet := range a.servers[serverID].PrivateNet {
+			if privateNet.Network != network.ID {
+				continue
+			}
+			used[privateNet.IP] = true
+			for _, aliasIP := range privateNet.AliasIPs {
+				used[aliasIP] = true
+			}
+		}
+	}
+
+	if requested != "" {
+		addr, err := netip.ParseAddr(requested)
+		if err != nil {
+			return "", fmt.Errorf("invalid ip: %w", err)
+		}
+		if used[addr.String()] {
+			return "", fmt.Errorf("ip %s is already used", requested)
+		}
+		for _, subnet := range network.Subnets {
+			if prefix, err := netip.ParsePrefix(subnet.IPRange); err == nil && prefix.Contains(addr) {
+				return addr.String(), nil
+			}
+		}
+		return "", fmt.Errorf("ip %s is not part of a subnet of the network", requested)
+	}
+
+	for _, subnet := range network.Subnets {
+		prefix, err := netip.ParsePrefix(subnet.IPRange)
+		if err != nil {
+			continue
+		}
+		for addr := prefix.Addr().Next(); prefix.Contains(addr); addr = addr.Next() {
+			if !used[addr.String()] {
+				return addr.String(), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no free ip in the network")
+}
+
+func (a *API) registerNetworks() {
+	a.handle("GET /networks", func(w http.ResponseWriter, r *http.Request) {
+		networks := []schema.Network{}
+		for _, id := range sortedIDs(a.networks) {
+			network := a.networks[id]
+			if matchesFilters(r, network.Name, network.Labels) {
+				networks = append(networks, *network)
+			}
+		}
+		writeJSON(w, http.StatusOK, struct {
+			schema.NetworkListResponse
+			schema.MetaResponse
+		}{
+			NetworkListResponse: schema.NetworkListResponse{Networks: networks},
+			MetaResponse:        schema.MetaResponse{Meta: listMeta(len(networks))},
+		})
+	})
+
+	a.handle("POST /networks", func(w http.ResponseWriter, r *http.Request) {
+		var req schema.NetworkCreateRequest
+		if !decode(w, r, &req) {
+			return
+		}
+		if req.Name == "" {
+			writeInvalidInput(w, "name is required")
+			return
+		}
+		for _, network := range a.networks {
+			if network.Name == req.Name {
+				writeUniquenessError(w, "name")
+				return
+			}
+		}
+		prefix, err := netip.ParsePrefix(req.IPRange)
+		if err != nil {
+			writeInvalidInput(w, fmt.Sprintf("invalid ip_range: %v", err))
+			return
+		}
+
+		network := &schema.Network{
+			ID:                    a.nextID(),
+			Name:                  req.Name,
+			Created:               now(),
+			IPRange:               prefix.Masked().String(),
+			Subnets:               []schema.NetworkSubnet{},
+			Routes:                []schema.NetworkRoute{},
+			Servers:               []int64{},
+			LoadBalancers:         []int64{},
+			Labels:                labelsOrEmpty(req.Labels),
+			ExposeRoutesToVSwitch: req.ExposeRoutesToVSwitch,
+		}
+		for _, subnet := range req.Subnets {
+			subnet, err := parseSubnet(network, subnet)
+			if err != nil {
+				writeInvalidInput(w, err.Error())
+				return
+			}
+			network.Subnets = append(network.Subnets, subnet)
+		}
+		network.Routes = append(network.Routes, req.Routes...)
+
+		a.networks[network.ID] = network
+		writeJSON(w, http.StatusCreated, schema.NetworkCreateResponse{Network: *network})
+	})
+
+	a.handle("GET /networks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		network, ok := a.network(w, r)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, schema.NetworkGetResponse{Network: *network})
+	})
+
+	a.handle("PUT /networks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		network, ok := a.network(w, r)
+		if !ok {
+			return
+		}
+		var req schema.NetworkUpdateRequest
+		if !decode(w, r, &req) {
+			return
+		}
+		if req.Name != "" {
+			network.Name = req.Name
+		}
+		if req.Labels != nil {
+			network.Labels = labelsOrEmpty(req.Labels)
+		}
+		if req.ExposeRoutesToVSwitch != nil {
+			network.ExposeRoutesToVSwitch = *req.ExposeRoutesToVSwitch
+		}
+		writeJSON(w, http.StatusOK, schema.NetworkUpdateResponse{Network: *network})
+	})
+
+	a.handle("DELETE /networks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		network, ok := a.network(w, r)
+		if !ok {
+			return
+		}
+		if network.Protection.Delete {
+			writeProtected(w, "network")
+			return
+		}
+		if len(network.Servers) > 0 {
+			writeError(w, http.StatusConflict, "conflict", "network has attached servers")
+			return
+		}
+		delete(a.networks, network.ID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	a.handle("POST /networks/{id}/actions/{action}", func(w http.ResponseWriter, r *http.Request) {
+		network, ok := a.network(w, r)
+		if !ok {
+			return
+		}
+
+		command := r.PathValue("action")
+		switch command {
+		case "add_subnet":
+			var req schema.NetworkActionAddSubnetRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			subnet, err := parseSubnet(network, schema.NetworkSubnet{
+				Type:        req.Type,
+				IPRange:     req.IPRange,
+				NetworkZone: req.NetworkZone,
+				VSwitchID:   req.VSwitchID,
+			})
+			if err != nil {
+				writeInvalidInput(w, err.Error())
+				return
+			}
+			network.Subnets = append(network.Subnets, subnet)
+
+		case "delete_subnet":
+			var req schema.NetworkActionDeleteSubnetRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			index := slices.IndexFunc(network.Subnets, func(s schema.NetworkSubnet) bool { return s.IPRange == req.IPRange })
+			if index < 0 {
+				writeNotFound(w, "subnet")
+				return
+			}
+			network.Subnets = slices.Delete(network.Subnets, index, index+1)
+
+		case "add_route":
+			var req schema.NetworkActionAddRouteRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			route := schema.NetworkRoute(req)
+			if slices.Contains(network.Routes, route) {
+				writeUniquenessError(w, "destination")
+				return
+			}
+			network.Routes = append(network.Routes, route)
+
+		case "delete_route":
+			var req schema.NetworkActionDeleteRouteRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			index := slices.Index(network.Routes, schema.NetworkRoute(req))
+			if index < 0 {
+				writeNotFound(w, "route")
+				return
+			}
+			network.Routes = slices.Delete(network.Routes, index, index+1)
+
+		case "change_ip_range":
+			var req schema.NetworkActionChangeIPRangeRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			prefix, err := netip.ParsePrefix(req.IPRange)
+			if err != nil {
+				writeInvalidInput(w, fmt.Sprintf("invalid ip_range: %v", err))
+				return
+			}
+			network.IPRange = prefix.Masked().String()
+
+		case "change_protection":
+			var req schema.NetworkActionChangeProtectionRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			if req.Delete != nil {
+				network.Protection.Delete = *req.Delete
+			}
+
+		default:
+			writeNotFound(w, fmt.Sprintf("network action %q", command))
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, schema.ActionGetResponse{
+			Action: a.newAction(command, nil, networkResource(network.ID)),
+		})
+	})
+}
+
+// network returns the network of the request path, and writes an error
+// response if it does not exist.
+func (a *API) network(w http.ResponseWriter, r *http.Request) (*schema.Network, bool) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+	network, ok := a.networks[id]
+	if !ok {
+		writeNotFound(w, "network")
+		return nil, false
+	}
+	return network, true
+}
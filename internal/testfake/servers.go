@@ -0,0 +1,393 @@
+package testfake
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+const fakeRootPassword = "fake-root-password"
+
+func serverResource(id int64) schema.ActionResourceReference {
+	return schema.ActionResourceReference{ID: id, Type: "server"}
+}
+
+// attachServer attaches the server to the network, using the requested IP or a
+// free IP of the network.
+func (a *API) attachServer(server *schema.Server, network *schema.Network, ip string, aliasIPs []string) error {
+	if slices.Contains(network.Servers, server.ID) {
+		return fmt.Errorf("server is already attached to the network")
+	}
+	ip, err := a.allocateNetworkIP(network, ip)
+	if err != nil {
+		return err
+	}
+	if aliasIPs == nil {
+		aliasIPs = []string{}
+	}
+
+	server.PrivateNet = append(server.PrivateNet, schema.ServerPrivateNet{
+		Network:    network.ID,
+		IP:         ip,
+		AliasIPs:   aliasIPs,
+		MACAddress: fmt.Sprintf("86:00:00:%02x:%02x:%02x", byte(server.ID>>16), byte(server.ID>>8), byte(server.ID)),
+	})
+	network.Servers = append(network.Servers, server.ID)
+	return nil
+}
+
+// detachServer detaches the server from the network.
+func (a *API) detachServer(server *schema.Server, network *schema.Network) {
+	server.PrivateNet = slices.DeleteFunc(server.PrivateNet, func(p schema.ServerPrivateNet) bool {
+		return p.Network == network.ID
+	})
+	network.Servers = slices.DeleteFunc(network.Servers, func(id int64) bool {
+		return id == server.ID
+	})
+}
+
+func (a *API) registerServers() {
+	a.handle("GET /servers", func(w http.ResponseWriter, r *http.Request) {
+		statuses := r.URL.Query()["status"]
+
+		servers := []schema.Server{}
+		for _, id := range sortedIDs(a.servers) {
+			server := a.servers[id]
+			if !matchesFilters(r, server.Name, server.Labels) {
+				continue
+			}
+			if len(statuses) > 0 && !slices.Contains(statuses, server.Status) {
+				continue
+			}
+			servers = append(servers, *server)
+		}
+		writeJSON(w, http.StatusOK, struct {
+			schema.ServerListResponse
+			schema.MetaResponse
+		}{
+			ServerListResponse: schema.ServerListResponse{Servers: servers},
+			MetaResponse:       schema.MetaResponse{Meta: listMeta(len(servers))},
+		})
+	})
+
+	a.handle("POST /servers", func(w http.ResponseWriter, r *http.Request) {
+		var req schema.ServerCreateRequest
+		if !decode(w, r, &req) {
+			return
+		}
+		if req.Name == "" {
+			writeInvalidInput(w, "name is required")
+			return
+		}
+		for _, server := range a.servers {
+			if server.Name == req.Name {
+				writeUniquenessError(w, "name")
+				return
+			}
+		}
+		serverType, ok := findServerType(req.ServerType)
+		if !ok {
+			writeInvalidInput(w, "server_type not found")
+			return
+		}
+		image, ok := findImage(req.Image, serverType.Architecture)
+		if !ok {
+			writeInvalidInput(w, "image not found")
+			return
+		}
+		locationName := req.Location
+		if locationName == "" {
+			locationName = locations[0].Name
+		}
+		location, ok := findLocation(schema.IDOrName{Name: locationName})
+		if !ok {
+			writeInvalidInput(w, "location not found")
+			return
+		}
+
+		id := a.nextID()
+		server := &schema.Server{
+			ID:         id,
+			Name:       req.Name,
+			Status:     "initializing",
+			Created:    now(),
+			PrivateNet: []schema.ServerPrivateNet{},
+			PublicNet: schema.ServerPublicNet{
+				FloatingIPs: []int64{},
+				Firewalls:   []schema.ServerFirewall{},
+				IPv6: schema.ServerPublicNetIPv6{
+					DNSPtr: []schema.ServerPublicNetIPv6DNSPtr{},
+				},
+			},
+			ServerType:      serverType,
+			IncludedTraffic: uint64(serverType.IncludedTraffic),
+			Location:        location,
+			Image:           &image,
+			Labels:          labelsOrEmpty(req.Labels),
+			Volumes:         []int64{},
+			PrimaryDiskSize: serverType.Disk,
+			LoadBalancers:   []int64{},
+		}
+		if req.PublicNet == nil || req.PublicNet.EnableIPv4 {
+			server.PublicNet.IPv4 = schema.ServerPublicNetIPv4{
+				ID:     a.nextID(),
+				IP:     fmt.Sprintf("203.0.%d.%d", byte(id>>8), byte(id)),
+				DNSPtr: fmt.Sprintf("static.%d.0.203.clients.your-server.de", byte(id)),
+			}
+		}
+		if req.PublicNet == nil || req.PublicNet.EnableIPv6 {
+			server.PublicNet.IPv6.ID = a.nextID()
+			server.PublicNet.IPv6.IP = fmt.Sprintf("2001:db8:%x::/64", uint16(id))
+		}
+
+		for _, networkID := range req.Networks {
+			network, ok := a.networks[networkID]
+			if !ok {
+				writeInvalidInput(w, fmt.Sprintf("network %d not found", networkID))
+				return
+			}
+			if err := a.attachServer(server, network, "", nil); err != nil {
+				writeInvalidInput(w, err.Error())
+				return
+			}
+		}
+
+		a.servers[server.ID] = server
+
+		status := "running"
+		if req.StartAfterCreate != nil && !*req.StartAfterCreate {
+			status = "off"
+		}
+		createAction := a.newAction("create_server", func() { server.Status = status }, serverResource(server.ID))
+
+		rootPassword := fakeRootPassword
+		resp := schema.ServerCreateResponse{
+			Server:      *server,
+			Action:      createAction,
+			NextActions: []schema.Action{},
+		}
+		if len(req.SSHKeys) == 0 {
+			resp.RootPassword = &rootPassword
+		}
+		writeJSON(w, http.StatusCreated, resp)
+	})
+
+	a.handle("GET /servers/{id}", func(w http.ResponseWriter, r *http.Request) {
+		server, ok := a.server(w, r)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, schema.ServerGetResponse{Server: *server})
+	})
+
+	a.handle("PUT /servers/{id}", func(w http.ResponseWriter, r *http.Request) {
+		server, ok := a.server(w, r)
+		if !ok {
+			return
+		}
+		var req schema.ServerUpdateRequest
+		if !decode(w, r, &req) {
+			return
+		}
+		if req.Name != "" {
+			server.Name = req.Name
+		}
+		if req.Labels != nil {
+			server.Labels = labelsOrEmpty(req.Labels)
+		}
+		writeJSON(w, http.StatusOK, schema.ServerUpdateResponse{Server: *server})
+	})
+
+	a.handle("DELETE /servers/{id}", func(w http.ResponseWriter, r *http.Request) {
+		server, ok := a.server(w, r)
+		if !ok {
+			return
+		}
+		if server.Protection.Delete {
+			writeProtected(w, "server")
+			return
+		}
+		for _, privateNet := range slices.Clone(server.PrivateNet) {
+			if network, ok := a.networks[privateNet.Network]; ok {
+				a.detachServer(server, network)
+			}
+		}
+		delete(a.servers, server.ID)
+
+		writeJSON(w, http.StatusOK, schema.ServerDeleteResponse{
+			Action: a.newAction("delete_server", nil, serverResource(server.ID)),
+		})
+	})
+
+	a.handle("POST /servers/{id}/actions/{action}", func(w http.ResponseWriter, r *http.Request) {
+		server, ok := a.server(w, r)
+		if !ok {
+			return
+		}
+
+		var (
+			command      = r.PathValue("action")
+			complete     func()
+			rootPassword string
+			resources    = []schema.ActionResourceReference{serverResource(server.ID)}
+		)
+
+		switch command {
+		case "poweron", "reboot", "reset":
+			complete = func() { server.Status = "running" }
+
+		case "poweroff", "shutdown":
+			complete = func() { server.Status = "off" }
+
+		case "reset_password":
+			rootPassword = fakeRootPassword
+
+		case "enable_rescue":
+			var req schema.ServerActionEnableRescueRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			server.RescueEnabled = true
+			rootPassword = fakeRootPassword
+
+		case "disable_rescue":
+			server.RescueEnabled = false
+
+		case "enable_backup":
+			backupWindow := "22-02"
+			server.BackupWindow = &backupWindow
+
+		case "disable_backup":
+			server.BackupWindow = nil
+
+		case "change_type":
+			var req schema.ServerActionChangeTypeRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			if server.Status != "off" {
+				writeError(w, http.StatusConflict, "server_not_stopped", "server must be stopped before changing the type")
+				return
+			}
+			serverType, ok := findServerType(req.ServerType)
+			if !ok {
+				writeInvalidInput(w, "server_type not found")
+				return
+			}
+			server.ServerType = serverType
+			if req.UpgradeDisk {
+				server.PrimaryDiskSize = serverType.Disk
+			}
+
+		case "rebuild":
+			var req schema.ServerActionRebuildRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			if server.Protection.Rebuild {
+				writeProtected(w, "server")
+				return
+			}
+			image, ok := findImage(req.Image, server.ServerType.Architecture)
+			if !ok {
+				writeInvalidInput(w, "image not found")
+				return
+			}
+			server.Image = &image
+			rootPassword = fakeRootPassword
+
+		case "change_protection":
+			var req schema.ServerActionChangeProtectionRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			if req.Delete != nil {
+				server.Protection.Delete = *req.Delete
+			}
+			if req.Rebuild != nil {
+				server.Protection.Rebuild = *req.Rebuild
+			}
+
+		case "attach_to_network":
+			var req schema.ServerActionAttachToNetworkRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			network, ok := a.networks[req.Network]
+			if !ok {
+				writeNotFound(w, "network")
+				return
+			}
+			ip := ""
+			if req.IP != nil {
+				ip = *req.IP
+			}
+			aliasIPs := make([]string, 0, len(req.AliasIPs))
+			for _, aliasIP := range req.AliasIPs {
+				aliasIPs = append(aliasIPs, *aliasIP)
+			}
+			if err := a.attachServer(server, network, ip, aliasIPs); err != nil {
+				writeInvalidInput(w, err.Error())
+				return
+			}
+			resources = append(resources, networkResource(network.ID))
+
+		case "detach_from_network":
+			var req schema.ServerActionDetachFromNetworkRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			network, ok := a.networks[req.Network]
+			if !ok || !slices.Contains(network.Servers, server.ID) {
+				writeNotFound(w, "network")
+				return
+			}
+			a.detachServer(server, network)
+			resources = append(resources, networkResource(network.ID))
+
+		case "change_alias_ips":
+			var req schema.ServerActionChangeAliasIPsRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			index := slices.IndexFunc(server.PrivateNet, func(p schema.ServerPrivateNet) bool { return p.Network == req.Network })
+			if index < 0 {
+				writeNotFound(w, "network")
+				return
+			}
+			server.PrivateNet[index].AliasIPs = append([]string{}, req.AliasIPs...)
+			resources = append(resources, networkResource(req.Network))
+
+		default:
+			writeNotFound(w, fmt.Sprintf("server action %q", command))
+			return
+		}
+
+		act := a.newAction(command, complete, resources...)
+		if rootPassword != "" {
+			writeJSON(w, http.StatusCreated, schema.ServerActionResetPasswordResponse{
+				Action:       act,
+				RootPassword: rootPassword,
+			})
+			return
+		}
+		writeJSON(w, http.StatusCreated, schema.ActionGetResponse{Action: act})
+	})
+}
+
+// server returns the server of the request path, and writes an error response
+// if it does not exist.
+func (a *API) server(w http.ResponseWriter, r *http.Request) (*schema.Server, bool) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+	server, ok := a.servers[id]
+	if !ok {
+		writeNotFound(w, "server")
+		return nil, false
+	}
+	return server, true
+}
@@ -0,0 +1,241 @@
+package testfake
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+func storageBoxResource(id int64) schema.ActionResourceReference {
+	return schema.ActionResourceReference{ID: id, Type: "storage_box"}
+}
+
+func (a *API) registerStorageBoxes() {
+	a.handle("GET /storage_boxes", func(w http.ResponseWriter, r *http.Request) {
+		storageBoxes := []schema.StorageBox{}
+		for _, id := range sortedIDs(a.storageBoxes) {
+			storageBox := a.storageBoxes[id]
+			if matchesFilters(r, storageBox.Name, storageBox.Labels) {
+				storageBoxes = append(storageBoxes, *storageBox)
+			}
+		}
+		writeJSON(w, http.StatusOK, struct {
+			schema.StorageBoxListResponse
+			schema.MetaResponse
+		}{
+			StorageBoxListResponse: schema.StorageBoxListResponse{StorageBoxes: storageBoxes},
+			MetaResponse:           schema.MetaResponse{Meta: listMeta(len(storageBoxes))},
+		})
+	})
+
+	a.handle("POST /storage_boxes", func(w http.ResponseWriter, r *http.Request) {
+		var req schema.StorageBoxCreateRequest
+		if !decode(w, r, &req) {
+			return
+		}
+		if req.Name == "" {
+			writeInvalidInput(w, "name is required")
+			return
+		}
+		if req.Password == "" {
+			writeInvalidInput(w, "password is required")
+			return
+		}
+		for _, storageBox := range a.storageBoxes {
+			if storageBox.Name == req.Name {
+				writeUniquenessError(w, "name")
+				return
+			}
+		}
+		storageBoxType, ok := findStorageBoxType(req.StorageBoxType)
+		if !ok {
+			writeInvalidInput(w, "storage_box_type not found")
+			return
+		}
+		location, ok := findLocation(req.Location)
+		if !ok {
+			writeInvalidInput(w, "location not found")
+			return
+		}
+
+		id := a.nextID()
+		username := fmt.Sprintf("u%d", id)
+		server := fmt.Sprintf("%s.your-storagebox.de", username)
+		system := fmt.Sprintf("%s-BX%d", location.Name, id%1000)
+
+		storageBox := &schema.StorageBox{
+			ID:             id,
+			Username:       &username,
+			Status:         "initializing",
+			Name:           req.Name,
+			StorageBoxType: storageBoxType,
+			Location:       location,
+			Server:         &server,
+			System:         &system,
+			Labels:         labelsOrEmpty(req.Labels),
+			Created:        now(),
+		}
+		if settings := req.AccessSettings; settings != nil {
+			storageBox.AccessSettings = schema.StorageBoxAccessSettings{
+				ReachableExternally: valueOrFalse(settings.ReachableExternally),
+				SambaEnabled:        valueOrFalse(settings.SambaEnabled),
+				SSHEnabled:          valueOrFalse(settings.SSHEnabled),
+				WebDAVEnabled:       valueOrFalse(settings.WebDAVEnabled),
+				ZFSEnabled:          valueOrFalse(settings.ZFSEnabled),
+			}
+		}
+
+		a.storageBoxes[storageBox.ID] = storageBox
+
+		writeJSON(w, http.StatusCreated, schema.StorageBoxCreateResponse{
+			StorageBox: *storageBox,
+			Action: a.newAction("create", func() { storageBox.Status = "active" },
+				storageBoxResource(storageBox.ID)),
+		})
+	})
+
+	a.handle("GET /storage_boxes/{id}", func(w http.ResponseWriter, r *http.Request) {
+		storageBox, ok := a.storageBox(w, r)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, schema.StorageBoxGetResponse{StorageBox: *storageBox})
+	})
+
+	a.handle("PUT /storage_boxes/{id}", func(w http.ResponseWriter, r *http.Request) {
+		storageBox, ok := a.storageBox(w, r)
+		if !ok {
+			return
+		}
+		var req schema.StorageBoxUpdateRequest
+		if !decode(w, r, &req) {
+			return
+		}
+		if req.Name != "" {
+			storageBox.Name = req.Name
+		}
+		if req.Labels != nil {
+			storageBox.Labels = labelsOrEmpty(req.Labels)
+		}
+		writeJSON(w, http.StatusOK, schema.StorageBoxUpdateResponse{StorageBox: *storageBox})
+	})
+
+	a.handle("DELETE /storage_boxes/{id}", func(w http.ResponseWriter, r *http.Request) {
+		storageBox, ok := a.storageBox(w, r)
+		if !ok {
+			return
+		}
+		if storageBox.Protection.Delete {
+			writeProtected(w, "storage box")
+			return
+		}
+		delete(a.storageBoxes, storageBox.ID)
+
+		writeJSON(w, http.StatusCreated, schema.ActionGetResponse{
+			Action: a.newAction("delete", nil, storageBoxResource(storageBox.ID)),
+		})
+	})
+
+	a.handle("POST /storage_boxes/{id}/actions/{action}", func(w http.ResponseWriter, r *http.Request) {
+		storageBox, ok := a.storageBox(w, r)
+		if !ok {
+			return
+		}
+
+		command := r.PathValue("action")
+		switch command {
+		case "change_protection":
+			var req schema.StorageBoxChangeProtectionRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			if req.Delete != nil {
+				storageBox.Protection.Delete = *req.Delete
+			}
+
+		case "change_type":
+			var req schema.StorageBoxChangeTypeRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			storageBoxType, ok := findStorageBoxType(req.StorageBoxType)
+			if !ok {
+				writeInvalidInput(w, "storage_box_type not found")
+				return
+			}
+			storageBox.StorageBoxType = storageBoxType
+
+		case "reset_password":
+			var req schema.StorageBoxResetPasswordRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			if req.Password == "" {
+				writeInvalidInput(w, "password is required")
+				return
+			}
+
+		case "update_access_settings":
+			var req schema.StorageBoxUpdateAccessSettingsRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			settings := &storageBox.AccessSettings
+			setIfNotNil(&settings.ReachableExternally, req.ReachableExternally)
+			setIfNotNil(&settings.SambaEnabled, req.SambaEnabled)
+			setIfNotNil(&settings.SSHEnabled, req.SSHEnabled)
+			setIfNotNil(&settings.WebDAVEnabled, req.WebDAVEnabled)
+			setIfNotNil(&settings.ZFSEnabled, req.ZFSEnabled)
+
+		case "enable_snapshot_plan":
+			var req schema.StorageBoxEnableSnapshotPlanRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			storageBox.SnapshotPlan = &schema.StorageBoxSnapshotPlan{
+				MaxSnapshots: req.MaxSnapshots,
+				Minute:       req.Minute,
+				Hour:         req.Hour,
+				DayOfWeek:    req.DayOfWeek,
+				DayOfMonth:   req.DayOfMonth,
+			}
+
+		case "disable_snapshot_plan":
+			storageBox.SnapshotPlan = nil
+
+		default:
+			writeNotFound(w, fmt.Sprintf("storage box action %q", command))
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, schema.ActionGetResponse{
+			Action: a.newAction(command, nil, storageBoxResource(storageBox.ID)),
+		})
+	})
+}
+
+func valueOrFalse(value *bool) bool {
+	return value != nil && *value
+}
+
+func setIfNotNil[T any](dst *T, value *T) {
+	if value != nil {
+		*dst = *value
+	}
+}
+
+// storageBox returns the storage box of the request path, and writes an error
+// response if it does not exist.
+func (a *API) storageBox(w http.ResponseWriter, r *http.Request) (*schema.StorageBox, bool) {
+	id, ok := pathID(w, r, "id")
+	if !ok {
+		return nil, false
+	}
+	storageBox, ok := a.storageBoxes[id]
+	if !ok {
+		writeNotFound(w, "storage box")
+		return nil, false
+	}
+	return storageBox, true
+}
@@ -0,0 +1,563 @@
+package testfake
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+)
+
+const defaultZoneTTL = 3600
+
+var zoneNameservers = []string{
+	"hydrogen.ns.hetzner.com.",
+	"oxygen.ns.hetzner.com.",
+	"helium.ns.hetzner.de.",
+}
+
+func zoneResource(id int64) schema.ActionResourceReference {
+	return schema.ActionResourceReference{ID: id, Type: "zone"}
+}
+
+func rrsetID(name, typ string) string {
+	return name + "/" + typ
+}
+
+// defaultRRSets returns the SOA and NS RRSets created with a primary zone.
+func defaultRRSets(zone *schema.Zone) []*schema.ZoneRRSet {
+	records := make([]schema.ZoneRRSetRecord, 0, len(zoneNameservers))
+	for _, nameserver := range zoneNameservers {
+		records = append(records, schema.ZoneRRSetRecord{Value: nameserver})
+	}
+
+	return []*schema.ZoneRRSet{
+		{
+			ID:     rrsetID("@", "SOA"),
+			Name:   "@",
+			Type:   "SOA",
+			Labels: map[string]string{},
+			Records: []schema.ZoneRRSetRecord{{
+				Value: fmt.Sprintf("%s dns.hetzner.com. %s 86400 10800 3600000 3600", zoneNameservers[0], zone.Created.Format("2006010201")),
+			}},
+			Zone: zone.ID,
+		},
+		{
+			ID:      rrsetID("@", "NS"),
+			Name:    "@",
+			Type:    "NS",
+			Labels:  map[string]string{},
+			Records: records,
+			Zone:    zone.ID,
+		},
+	}
+}
+
+// updateRecordCount updates the record count of the zone, after its RRSets
+// changed.
+func (a *API) updateRecordCount(zone *schema.Zone) {
+	zone.RecordCount = 0
+	for _, rrset := range a.rrsets[zone.ID] {
+		zone.RecordCount += len(rrset.Records)
+	}
+}
+
+func (a *API) registerZones() {
+	a.handle("GET /zones", func(w http.ResponseWriter, r *http.Request) {
+		mode := r.URL.Query().Get("mode")
+
+		zones := []schema.Zone{}
+		for _, id := range sortedIDs(a.zones) {
+			zone := a.zones[id]
+			if !matchesFilters(r, zone.Name, zone.Labels) {
+				continue
+			}
+			if mode != "" && zone.Mode != mode {
+				continue
+			}
+			zones = append(zones, *zone)
+		}
+		writeJSON(w, http.StatusOK, struct {
+			schema.ZoneListResponse
+			schema.MetaResponse
+		}{
+			ZoneListResponse: schema.ZoneListResponse{Zones: zones},
+			MetaResponse:     schema.MetaResponse{Meta: listMeta(len(zones))},
+		})
+	})
+
+	a.handle("POST /zones", func(w http.ResponseWriter, r *http.Request) {
+		var req schema.ZoneCreateRequest
+		if !decode(w, r, &req) {
+			return
+		}
+		if req.Name == "" || !strings.Contains(req.Name, ".") {
+			writeInvalidInput(w, "invalid name")
+			return
+		}
+		for _, zone := range a.zones {
+			if zone.Name == req.Name {
+				writeUniquenessError(w, "name")
+				return
+			}
+		}
+		if req.Mode != "primary" && req.Mode != "secondary" {
+			writeInvalidInput(w, "mode must be primary or secondary")
+			return
+		}
+		if req.Mode == "secondary" && len(req.PrimaryNameservers) == 0 {
+			writeInvalidInput(w, "primary_nameservers is required for secondary zones")
+			return
+		}
+
+		zone := &schema.Zone{
+			ID:                 a.nextID(),
+			Name:               req.Name,
+			Created:            now(),
+			TTL:                defaultZoneTTL,
+			Mode:               req.Mode,
+			PrimaryNameservers: []schema.ZonePrimaryNameserver{},
+			Labels:             labelsOrEmpty(req.Labels),
+			AuthoritativeNameservers: schema.ZoneAuthoritativeNameservers{
+				Assigned:         zoneNameservers,
+				Delegated:        []string{},
+				DelegationStatus: "unknown",
+			},
+			Registrar: "other",
+			Status:    "ok",
+		}
+		if req.TTL != nil {
+			zone.TTL = *req.TTL
+		}
+		for _, nameserver := range req.PrimaryNameservers {
+			zone.PrimaryNameservers = append(zone.PrimaryNameservers, primaryNameserver(schema.ZoneChangePrimaryNameserversRequestPrimaryNameserver(nameserver)))
+		}
+
+		rrsets := []*schema.ZoneRRSet{}
+		if zone.Mode == "primary" {
+			rrsets = defaultRRSets(zone)
+		}
+		for _, item := range req.RRSets {
+			rrset, err := newRRSet(zone, schema.ZoneRRSetCreateRequest{
+				Name:    item.Name,
+				Type:    item.Type,
+				TTL:     item.TTL,
+				Labels:  item.Labels,
+				Records: item.Records,
+			})
+			if err != nil {
+				writeInvalidInput(w, err.Error())
+				return
+			}
+			rrsets = append(rrsets, rrset)
+		}
+
+		a.zones[zone.ID] = zone
+		a.rrsets[zone.ID] = rrsets
+		a.updateRecordCount(zone)
+
+		writeJSON(w, http.StatusCreated, schema.ZoneCreateResponse{
+			Zone:   *zone,
+			Action: a.newAction("create_zone", nil, zoneResource(zone.ID)),
+		})
+	})
+
+	a.handle("GET /zones/{zone}", func(w http.ResponseWriter, r *http.Request) {
+		zone, ok := a.zone(w, r)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, schema.ZoneGetResponse{Zone: *zone})
+	})
+
+	a.handle("PUT /zones/{zone}", func(w http.ResponseWriter, r *http.Request) {
+		zone, ok := a.zone(w, r)
+		if !ok {
+			return
+		}
+		var req schema.ZoneUpdateRequest
+		if !decode(w, r, &req) {
+			return
+		}
+		if req.Labels != nil {
+			zone.Labels = labelsOrEmpty(req.Labels)
+		}
+		writeJSON(w, http.StatusOK, schema.ZoneUpdateResponse{Zone: *zone})
+	})
+
+	a.handle("DELETE /zones/{zone}", func(w http.ResponseWriter, r *http.Request) {
+		zone, ok := a.zone(w, r)
+		if !ok {
+			return
+		}
+		if zone.Protection.Delete {
+			writeProtected(w, "zone")
+			return
+		}
+		delete(a.zones, zone.ID)
+		delete(a.rrsets, zone.ID)
+
+		writeJSON(w, http.StatusCreated, schema.ActionGetResponse{
+			Action: a.newAction("delete_zone", nil, zoneResource(zone.ID)),
+		})
+	})
+
+	a.handle("GET /zones/{zone}/zonefile", func(w http.ResponseWriter, r *http.Request) {
+		zone, ok := a.zone(w, r)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, schema.ZoneExportZonefileResponse{Zonefile: a.zonefile(zone)})
+	})
+
+	a.handle("POST /zones/{zone}/actions/{action}", func(w http.ResponseWriter, r *http.Request) {
+		zone, ok := a.zone(w, r)
+		if !ok {
+			return
+		}
+
+		command := r.PathValue("action")
+		switch command {
+		case "change_protection":
+			var req schema.ZoneChangeProtectionRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			if req.Delete != nil {
+				zone.Protection.Delete = *req.Delete
+			}
+
+		case "change_ttl":
+			var req schema.ZoneChangeTTLRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			zone.TTL = req.TTL
+
+		case "change_primary_nameservers":
+			var req schema.ZoneChangePrimaryNameserversRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			if zone.Mode != "secondary" {
+				writeError(w, http.StatusUnprocessableEntity, "incorrect_zone_mode", "zone is not a secondary zone")
+				return
+			}
+			zone.PrimaryNameservers = []schema.ZonePrimaryNameserver{}
+			for _, nameserver := range req.PrimaryNameservers {
+				zone.PrimaryNameservers = append(zone.PrimaryNameservers, primaryNameserver(nameserver))
+			}
+
+		default:
+			writeNotFound(w, fmt.Sprintf("zone action %q", command))
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, schema.ActionGetResponse{
+			Action: a.newAction(command, nil, zoneResource(zone.ID)),
+		})
+	})
+
+	a.registerZoneRRSets()
+}
+
+func primaryNameserver(in schema.ZoneChangePrimaryNameserversRequestPrimaryNameserver) schema.ZonePrimaryNameserver {
+	out := schema.ZonePrimaryNameserver(in)
+	if out.Port == 0 {
+		out.Port = 53
+	}
+	return out
+}
+
+// zonefile returns the zone file of the zone, generated from its RRSets.
+func (a *API) zonefile(zone *schema.Zone) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "$ORIGIN %s.\n", zone.Name)
+	fmt.Fprintf(&b, "$TTL %d\n", zone.TTL)
+	for _, rrset := range a.rrsets[zone.ID] {
+		ttl := ""
+		if rrset.TTL != nil {
+			ttl = strconv.Itoa(*rrset.TTL) + " "
+		}
+		for _, record := range rrset.Records {
+			fmt.Fprintf(&b, "%s %sIN %s %s\n", rrset.Name, ttl, rrset.Type, record.Value)
+		}
+	}
+
+	return b.String()
+}
+
+func (a *API) registerZoneRRSets() {
+	a.handle("GET /zones/{zone}/rrsets", func(w http.ResponseWriter, r *http.Request) {
+		zone, ok := a.zone(w, r)
+		if !ok {
+			return
+		}
+		types := r.URL.Query()["type"]
+
+		rrsets := []schema.ZoneRRSet{}
+		for _, rrset := range a.rrsets[zone.ID] {
+			if !matchesFilters(r, rrset.Name, rrset.Labels) {
+				continue
+			}
+			if len(types) > 0 && !slices.Contains(types, rrset.Type) {
+				continue
+			}
+			rrsets = append(rrsets, *rrset)
+		}
+		writeJSON(w, http.StatusOK, struct {
+			schema.ZoneRRSetListResponse
+			schema.MetaResponse
+		}{
+			ZoneRRSetListResponse: schema.ZoneRRSetListResponse{RRSets: rrsets},
+			MetaResponse:          schema.MetaResponse{Meta: listMeta(len(rrsets))},
+		})
+	})
+
+	a.handle("POST /zones/{zone}/rrsets", func(w http.ResponseWriter, r *http.Request) {
+		zone, ok := a.zone(w, r)
+		if !ok {
+			return
+		}
+		var req schema.ZoneRRSetCreateRequest
+		if !decode(w, r, &req) {
+			return
+		}
+		if _, ok := a.findRRSet(zone, req.Name, req.Type); ok {
+			writeUniquenessError(w, "name and type")
+			return
+		}
+		rrset, err := newRRSet(zone, req)
+		if err != nil {
+			writeInvalidInput(w, err.Error())
+			return
+		}
+
+		a.rrsets[zone.ID] = append(a.rrsets[zone.ID], rrset)
+		a.updateRecordCount(zone)
+
+		writeJSON(w, http.StatusCreated, schema.ZoneRRSetCreateResponse{
+			RRSet:  *rrset,
+			Action: a.newAction("create_rrset", nil, zoneResource(zone.ID)),
+		})
+	})
+
+	a.handle("GET /zones/{zone}/rrsets/{name}/{type}", func(w http.ResponseWriter, r *http.Request) {
+		_, rrset, ok := a.rrset(w, r)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, schema.ZoneRRSetGetResponse{RRSet: *rrset})
+	})
+
+	a.handle("PUT /zones/{zone}/rrsets/{name}/{type}", func(w http.ResponseWriter, r *http.Request) {
+		_, rrset, ok := a.rrset(w, r)
+		if !ok {
+			return
+		}
+		var req schema.ZoneRRSetUpdateRequest
+		if !decode(w, r, &req) {
+			return
+		}
+		if req.Labels != nil {
+			rrset.Labels = labelsOrEmpty(req.Labels)
+		}
+		writeJSON(w, http.StatusOK, schema.ZoneRRSetUpdateResponse{RRSet: *rrset})
+	})
+
+	a.handle("DELETE /zones/{zone}/rrsets/{name}/{type}", func(w http.ResponseWriter, r *http.Request) {
+		zone, rrset, ok := a.rrset(w, r)
+		if !ok {
+			return
+		}
+		if rrset.Protection.Change {
+			writeProtected(w, "rrset")
+			return
+		}
+		a.deleteRRSet(zone, rrset)
+
+		writeJSON(w, http.StatusCreated, schema.ActionGetResponse{
+			Action: a.newAction("delete_rrset", nil, zoneResource(zone.ID)),
+		})
+	})
+
+	a.handle("POST /zones/{zone}/rrsets/{name}/{type}/actions/{action}", func(w http.ResponseWriter, r *http.Request) {
+		zone, ok := a.zone(w, r)
+		if !ok {
+			return
+		}
+
+		command := r.PathValue("action")
+		rrset, ok := a.findRRSet(zone, r.PathValue("name"), r.PathValue("type"))
+		if !ok && command != "add_records" {
+			writeNotFound(w, "rrset")
+			return
+		}
+		if ok && rrset.Protection.Change && command != "change_protection" {
+			writeProtected(w, "rrset")
+			return
+		}
+
+		switch command {
+		case "change_protection":
+			var req schema.ZoneRRSetChangeProtectionRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			if req.Change != nil {
+				rrset.Protection.Change = *req.Change
+			}
+
+		case "change_ttl":
+			var req schema.ZoneRRSetChangeTTLRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			rrset.TTL = req.TTL
+
+		case "set_records":
+			var req schema.ZoneRRSetSetRecordsRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			rrset.Records = slices.Clone(req.Records)
+
+		case "add_records":
+			var req schema.ZoneRRSetAddRecordsRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			if rrset == nil {
+				created, err := newRRSet(zone, schema.ZoneRRSetCreateRequest{
+					Name: r.PathValue("name"),
+					Type: r.PathValue("type"),
+					TTL:  req.TTL,
+				})
+				if err != nil {
+					writeInvalidInput(w, err.Error())
+					return
+				}
+				rrset = created
+				a.rrsets[zone.ID] = append(a.rrsets[zone.ID], rrset)
+			}
+			for _, record := range req.Records {
+				if !slices.ContainsFunc(rrset.Records, func(r schema.ZoneRRSetRecord) bool { return r.Value == record.Value }) {
+					rrset.Records = append(rrset.Records, record)
+				}
+			}
+
+		case "remove_records":
+			var req schema.ZoneRRSetRemoveRecordsRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			rrset.Records = slices.DeleteFunc(rrset.Records, func(r schema.ZoneRRSetRecord) bool {
+				return slices.ContainsFunc(req.Records, func(removed schema.ZoneRRSetRecord) bool { return removed.Value == r.Value })
+			})
+			if len(rrset.Records) == 0 {
+				a.deleteRRSet(zone, rrset)
+			}
+
+		case "update_records":
+			var req schema.ZoneRRSetUpdateRecordsRequest
+			if !decode(w, r, &req) {
+				return
+			}
+			for _, updated := range req.Records {
+				index := slices.IndexFunc(rrset.Records, func(r schema.ZoneRRSetRecord) bool { return r.Value == updated.Value })
+				if index < 0 {
+					writeNotFound(w, "record")
+					return
+				}
+				rrset.Records[index].Comment = updated.Comment
+			}
+
+		default:
+			writeNotFound(w, fmt.Sprintf("rrset action %q", command))
+			return
+		}
+
+		a.updateRecordCount(zone)
+
+		writeJSON(w, http.StatusCreated, schema.ActionGetResponse{
+			Action: a.newAction(command, nil, zoneResource(zone.ID)),
+		})
+	})
+}
+
+// newRRSet validates and returns a new RRSet of the zone.
+func newRRSet(zone *schema.Zone, req schema.ZoneRRSetCreateRequest) (*schema.ZoneRRSet, error) {
+	if req.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if req.Name != strings.ToLower(req.Name) {
+		return nil, fmt.Errorf("name must be lowercase")
+	}
+	if req.Type == "" || req.Type != strings.ToUpper(req.Type) {
+		return nil, fmt.Errorf("invalid type")
+	}
+	if zone.Mode == "secondary" {
+		return nil, fmt.Errorf("rrsets of secondary zones cannot be changed")
+	}
+
+	records := slices.Clone(req.Records)
+	if records == nil {
+		records = []schema.ZoneRRSetRecord{}
+	}
+
+	return &schema.ZoneRRSet{
+		ID:      rrsetID(req.Name, req.Type),
+		Name:    req.Name,
+		Type:    req.Type,
+		TTL:     req.TTL,
+		Labels:  labelsOrEmpty(req.Labels),
+		Records: records,
+		Zone:    zone.ID,
+	}, nil
+}
+
+func (a *API) findRRSet(zone *schema.Zone, name, typ string) (*schema.ZoneRRSet, bool) {
+	for _, rrset := range a.rrsets[zone.ID] {
+		if rrset.Name == name && rrset.Type == typ {
+			return rrset, true
+		}
+	}
+	return nil, false
+}
+
+func (a *API) deleteRRSet(zone *schema.Zone, rrset *schema.ZoneRRSet) {
+	a.rrsets[zone.ID] = slices.DeleteFunc(a.rrsets[zone.ID], func(r *schema.ZoneRRSet) bool { return r == rrset })
+	a.updateRecordCount(zone)
+}
+
+// zone returns the zone of the request path, referenced by its ID or name, and
+// writes an error response if it does not exist.
+func (a *API) zone(w http.ResponseWriter, r *http.Request) (*schema.Zone, bool) {
+	idOrName := r.PathValue("zone")
+	id, err := strconv.ParseInt(idOrName, 10, 64)
+	for _, zone := range a.zones {
+		if (err == nil && zone.ID == id) || zone.Name == idOrName {
+			return zone, true
+		}
+	}
+	writeNotFound(w, "zone")
+	return nil, false
+}
+
+// rrset returns the zone and RRSet of the request path, and writes an error
+// response if they do not exist.
+func (a *API) rrset(w http.ResponseWriter, r *http.Request) (*schema.Zone, *schema.ZoneRRSet, bool) {
+	zone, ok := a.zone(w, r)
+	if !ok {
+		return nil, nil, false
+	}
+	rrset, ok := a.findRRSet(zone, r.PathValue("name"), r.PathValue("type"))
+	if !ok {
+		writeNotFound(w, "rrset")
+		return nil, nil, false
+	}
+	return zone, rrset, true
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 
 	tfhcloud "github.com/hetznercloud/terraform-provider-hcloud/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testfake"
 )
 
 func ProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
@@ -13,6 +14,10 @@ func ProtoV6ProviderFactories() map[string]func() (tfprotov6.ProviderServer, err
 		"hcloud": func() (tfprotov6.ProviderServer, error) {
 			ctx := context.Background()
 
+			if testfake.Enabled() {
+				testfake.Setup()
+			}
+
 			providerFactory, err := tfhcloud.GetMuxedProvider(ctx)
 			if err != nil {
 				return nil, err
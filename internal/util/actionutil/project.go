@@ -0,0 +1,14 @@
+package actionutil
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+)
+
+// ProjectAttribute returns the `project` attribute used to select the project an
+// action is run in.
+func ProjectAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Name of the project, as configured in the `projects` block of the provider, the action is run in. Defaults to the project of the provider `token`.",
+		Optional:            true,
+	}
+}
@@ -1,10 +1,12 @@
 package control
 
 import (
+	"context"
 	"errors"
-	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 )
 
@@ -36,7 +38,10 @@ func AbortRetry(err error) error {
 }
 
 // Retry executes f at most maxTries times.
-func Retry(maxTries int, f func() error) error {
+//
+// Retry stops waiting between attempts once the context is done, and returns the
+// error of the last attempt.
+func Retry(ctx context.Context, maxTries int, f func() error) error {
 	var err error
 
 	backoff := hcloud.ExponentialBackoff(2, 1*time.Second)
@@ -49,9 +54,23 @@ func Retry(maxTries int, f func() error) error {
 			return aerr.Err
 		}
 		if err != nil {
+			if try+1 == maxTries {
+				break
+			}
+
 			sleep := backoff(try)
-			log.Printf("[WARN] try %d/%d failed: retrying after %v: error: %v", try+1, maxTries, sleep, err)
-			time.Sleep(sleep)
+			tflog.Warn(ctx, "Attempt failed, retrying", map[string]any{
+				"try":       try + 1,
+				"max_tries": maxTries,
+				"delay":     sleep.String(),
+				"error":     err.Error(),
+			})
+
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(sleep):
+			}
 			continue
 		}
 
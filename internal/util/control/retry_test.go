@@ -1,6 +1,7 @@
 package control_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -66,7 +67,7 @@ func TestRetry(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := control.Retry(tt.maxTries, func() error {
+			err := control.Retry(t.Context(), tt.maxTries, func() error {
 				tt.actualTries++
 				t.Logf("Try %d/%d", tt.actualTries, tt.expectedTries)
 				return tt.f(&tt)
@@ -78,3 +79,16 @@ func TestRetry(t *testing.T) {
 		})
 	}
 }
+
+func TestRetryContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	tries := 0
+	err := control.Retry(ctx, 5, func() error {
+		tries++
+		return errors.New("retry me")
+	})
+	assert.EqualError(t, err, "retry me")
+	assert.Equal(t, 1, tries)
+}
@@ -0,0 +1,16 @@
+package datasourceutil
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+)
+
+// ProjectAttribute returns the `project` attribute used to select the project a
+// data source is read from.
+func ProjectAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: hcloudutil.ProjectAttributeDescription,
+		Optional:            true,
+	}
+}
@@ -48,7 +48,7 @@ func SettleActions(ctx context.Context, client ActionWaiter, actions ...*hcloud.
 	}, actions...)
 	if err != nil {
 		if (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) && len(running) > 0 {
-			diags.Append(ActionWaitTimeoutDiagnostic(running...))
+			diags.Append(ActionWaitTimeoutDiagnostic(ctx, running...))
 			return
 		}
 
@@ -76,10 +76,18 @@ func ActionErrorDiagnostic(action *hcloud.Action) diag.Diagnostic {
 	return diag.NewErrorDiagnostic("Action failed", detail.String())
 }
 
-func ActionWaitTimeoutDiagnostic(actions ...*hcloud.Action) diag.Diagnostic {
+// ActionWaitTimeoutDiagnostic returns the diagnostic for actions that did not
+// complete before the context was cancelled. If the context expired because of
+// the timeout configured for the resource operation, the timeout is reported.
+func ActionWaitTimeoutDiagnostic(ctx context.Context, actions ...*hcloud.Action) diag.Diagnostic {
 	detail := strings.Builder{}
 
-	detail.WriteString("The request was cancelled while we were waiting on action(s) to complete.")
+	if timeout, ok := TimeoutFromContext(ctx); ok && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		fmt.Fprintf(&detail, "The %s timeout of %s expired while we were waiting on action(s) to complete. ", timeout.Operation, timeout.Duration)
+		detail.WriteString("The timeout can be increased using the `timeouts` block of the resource.")
+	} else {
+		detail.WriteString("The request was cancelled while we were waiting on action(s) to complete.")
+	}
 	for _, action := range actions {
 		fmt.Fprint(&detail, "\n\n")
 		fmt.Fprintf(&detail, "- Command: %s\n", action.Command)
@@ -3,6 +3,7 @@ package hcloudutil
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/stretchr/testify/assert"
@@ -93,7 +94,7 @@ func TestSettleActions(t *testing.T) {
 				actions: []*hcloud.Action{failedAction1, successAction1, failedAction2, successAction2},
 				err:     context.DeadlineExceeded,
 			},
-			expected: diag.Diagnostics{ActionWaitTimeoutDiagnostic(failedAction1, successAction1, failedAction2, successAction2)},
+			expected: diag.Diagnostics{ActionWaitTimeoutDiagnostic(t.Context(), failedAction1, successAction1, failedAction2, successAction2)},
 		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
@@ -266,7 +267,38 @@ func TestActionWaitTimeoutDiagnostic(t *testing.T) {
 		},
 	} {
 		t.Run(testCase.name, func(t *testing.T) {
-			assert.Equal(t, testCase.expected, ActionWaitTimeoutDiagnostic(testCase.actions...))
+			assert.Equal(t, testCase.expected, ActionWaitTimeoutDiagnostic(t.Context(), testCase.actions...))
 		})
 	}
 }
+
+func TestActionWaitTimeoutDiagnosticConfiguredTimeout(t *testing.T) {
+	ctx, cancel := ContextWithTimeout(t.Context(), "create", time.Nanosecond)
+	defer cancel()
+	<-ctx.Done()
+
+	action := &hcloud.Action{
+		ID:       int64(1337),
+		Status:   hcloud.ActionStatusRunning,
+		Command:  "create_server",
+		Progress: 7,
+		Resources: []*hcloud.ActionResource{
+			{
+				ID:   int64(42),
+				Type: hcloud.ActionResourceTypeServer,
+			},
+		},
+	}
+
+	assert.Equal(t,
+		diag.NewErrorDiagnostic(
+			"Timeout while waiting on action(s)",
+			`The create timeout of 1ns expired while we were waiting on action(s) to complete. The timeout can be increased using the `+"`timeouts`"+` block of the resource.
+
+- Command: create_server
+  ID: 1337
+  Progress: 7%
+  Resources: server: 42`),
+		ActionWaitTimeoutDiagnostic(ctx, action),
+	)
+}
@@ -0,0 +1,109 @@
+package hcloudutil
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProjectAttribute is the name of the attribute selecting the project of a
+// resource or data source.
+const ProjectAttribute = "project"
+
+// ProjectAttributeDescription is the description of the attribute selecting the
+// project of a resource or data source.
+const ProjectAttributeDescription = "Name of the project, as configured in the `projects` block of the provider, the resource is managed in. Defaults to the project of the provider `token`."
+
+// Project is an additional project configured in the provider.
+type Project struct {
+	Name     string
+	Token    string
+	Endpoint string
+}
+
+type projectContextKey struct{}
+
+// ContextWithProject returns a copy of the context, where the requests sent by
+// the client are sent to the given project.
+func ContextWithProject(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, projectContextKey{}, name)
+}
+
+// ProjectFromContext returns the name of the project the requests are sent to,
+// or an empty string for the default project.
+func ProjectFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(projectContextKey{}).(string)
+	return name
+}
+
+// WithProjectSchema adds the project attribute to the schema of a SDKv2 resource
+// or data source.
+func WithProjectSchema(r *schema.Resource, forceNew bool) *schema.Resource {
+	r.Schema[ProjectAttribute] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    forceNew,
+		Description: ProjectAttributeDescription,
+	}
+	return r
+}
+
+// NewProjectTransport returns a transport sending the requests to the project
+// found in the request context, see [ContextWithProject].
+//
+// Each project gets its own transport, created using newTransport, so the
+// requests are budgeted per project, like the rate limit of the API.
+func NewProjectTransport(endpoint string, projects []Project, newTransport func() http.RoundTripper) http.RoundTripper {
+	t := &projectTransport{
+		endpoint: endpoint,
+		base:     newTransport(),
+		projects: make(map[string]projectTransportEntry, len(projects)),
+	}
+	for _, project := range projects {
+		t.projects[project.Name] = projectTransportEntry{project: project, transport: newTransport()}
+	}
+	return t
+}
+
+type projectTransportEntry struct {
+	project   Project
+	transport http.RoundTripper
+}
+
+type projectTransport struct {
+	endpoint string
+	base     http.RoundTripper
+	projects map[string]projectTransportEntry
+}
+
+func (t *projectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	name := ProjectFromContext(req.Context())
+	if name == "" {
+		return t.base.RoundTrip(req)
+	}
+
+	entry, ok := t.projects[name]
+	if !ok {
+		return nil, fmt.Errorf("project %q is not configured in the provider projects", name)
+	}
+
+	cloned := req.Clone(req.Context())
+	cloned.Header.Set("Authorization", "Bearer "+entry.project.Token)
+
+	if entry.project.Endpoint != "" {
+		if path, found := strings.CutPrefix(req.URL.String(), t.endpoint); found {
+			u, err := url.Parse(strings.TrimSuffix(entry.project.Endpoint, "/") + path)
+			if err != nil {
+				return nil, fmt.Errorf("invalid endpoint for project %q: %w", name, err)
+			}
+			cloned.URL = u
+			cloned.Host = u.Host
+		}
+	}
+
+	return entry.transport.RoundTrip(cloned)
+}
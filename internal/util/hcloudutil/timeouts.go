@@ -0,0 +1,30 @@
+package hcloudutil
+
+import (
+	"context"
+	"time"
+)
+
+// Timeout is the timeout of a resource operation, configured using the
+// `timeouts` block of the resource.
+type Timeout struct {
+	Operation string
+	Duration  time.Duration
+}
+
+type timeoutContextKey struct{}
+
+// ContextWithTimeout returns a copy of the context that is cancelled once the
+// timeout of the operation expired. The timeout is reported by
+// [ActionWaitTimeoutDiagnostic] when it expires while waiting on actions.
+func ContextWithTimeout(ctx context.Context, operation string, timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx = context.WithValue(ctx, timeoutContextKey{}, Timeout{Operation: operation, Duration: timeout})
+	return context.WithTimeout(ctx, timeout)
+}
+
+// TimeoutFromContext returns the timeout of the resource operation, if the
+// context was created using [ContextWithTimeout].
+func TimeoutFromContext(ctx context.Context) (Timeout, bool) {
+	timeout, ok := ctx.Value(timeoutContextKey{}).(Timeout)
+	return timeout, ok
+}
@@ -0,0 +1,153 @@
+package hcloudutil
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultRateLimitDelay is the delay used when the rate limit headers are missing
+// from a rate limited response.
+const defaultRateLimitDelay = time.Second
+
+// TransportOpts defines the options used by [NewTransport].
+type TransportOpts struct {
+	// MaxRetries is the maximum number of times a failed request is retried by
+	// the hcloud client. The transport never retries requests itself, rate
+	// limited requests are only retried by the backoff of the hcloud client, up
+	// to this limit.
+	MaxRetries int
+	// RetryOnRateLimit delays the requests while the rate limit is exhausted,
+	// so the rate limited requests retried by the hcloud client are only sent
+	// once the rate limit allows it again.
+	RetryOnRateLimit bool
+	// MaxConcurrentRequests limits the number of requests sent at the same
+	// time. Zero means no limit.
+	MaxConcurrentRequests int
+}
+
+// NewTransport wraps the base [http.RoundTripper] with a transport that budgets
+// the requests sent to the API, according to the given options.
+func NewTransport(base http.RoundTripper, opts TransportOpts) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	t := &transport{base: base, opts: opts}
+	if opts.MaxConcurrentRequests > 0 {
+		t.semaphore = make(chan struct{}, opts.MaxConcurrentRequests)
+	}
+	return t
+}
+
+type transport struct {
+	base http.RoundTripper
+	opts TransportOpts
+
+	semaphore chan struct{}
+
+	mu        sync.Mutex
+	notBefore time.Time
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if t.semaphore != nil {
+		select {
+		case t.semaphore <- struct{}{}:
+			defer func() { <-t.semaphore }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if t.opts.RetryOnRateLimit {
+		if err := t.waitForBudget(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	delay, exhausted := rateLimitDelay(resp)
+	if !exhausted {
+		return resp, nil
+	}
+	t.delay(delay)
+
+	if resp.StatusCode == http.StatusTooManyRequests && t.opts.RetryOnRateLimit {
+		tflog.Warn(ctx, "Rate limit exceeded, delaying requests until the rate limit allows it", map[string]any{
+			"method": req.Method,
+			"path":   req.URL.Path,
+			"delay":  delay.String(),
+		})
+	}
+
+	return resp, nil
+}
+
+// delay postpones the next requests until the rate limit allows them again.
+func (t *transport) delay(delay time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	notBefore := time.Now().Add(delay)
+	if notBefore.After(t.notBefore) {
+		t.notBefore = notBefore
+	}
+}
+
+// waitForBudget blocks until the rate limit allows sending a request.
+func (t *transport) waitForBudget(ctx context.Context) error {
+	t.mu.Lock()
+	wait := time.Until(t.notBefore)
+	t.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+
+	tflog.Debug(ctx, "Rate limit exhausted, waiting before sending request", map[string]any{"delay": wait.String()})
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// rateLimitDelay returns the time until the rate limit allows a new request, and
+// whether the rate limit is exhausted.
+//
+// The RateLimit-Reset header is the time at which the rate limit is fully
+// replenished, the budget is refilled at a constant rate until then.
+func rateLimitDelay(resp *http.Response) (time.Duration, bool) {
+	remaining, err := strconv.Atoi(resp.Header.Get("RateLimit-Remaining"))
+	exhausted := resp.StatusCode == http.StatusTooManyRequests || (err == nil && remaining <= 0)
+	if !exhausted {
+		return 0, false
+	}
+
+	limit, err := strconv.Atoi(resp.Header.Get("RateLimit-Limit"))
+	if err != nil || limit <= 0 {
+		return defaultRateLimitDelay, true
+	}
+	reset, err := strconv.ParseInt(resp.Header.Get("RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return defaultRateLimitDelay, true
+	}
+
+	return max(time.Until(time.Unix(reset, 0))/time.Duration(limit), 0), true
+}
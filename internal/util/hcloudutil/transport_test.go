@@ -0,0 +1,203 @@
+package hcloudutil
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rateLimitedHandler(t *testing.T, rateLimited int, bodies *[]string) http.HandlerFunc {
+	var mu sync.Mutex
+	requests := 0
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		*bodies = append(*bodies, string(body))
+
+		requests++
+		if requests <= rateLimited {
+			w.Header().Set("RateLimit-Limit", "1")
+			w.Header().Set("RateLimit-Remaining", "0")
+			w.Header().Set("RateLimit-Reset", strconv.FormatInt(time.Now().Add(2*time.Second).Unix(), 10))
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func TestTransportRateLimitNoRetry(t *testing.T) {
+	bodies := []string{}
+	server := httptest.NewServer(rateLimitedHandler(t, 5, &bodies))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTransport(nil, TransportOpts{MaxRetries: 5, RetryOnRateLimit: true})}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL, strings.NewReader(`{"name":"example"}`))
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	// The rate limited requests are only retried by the hcloud client.
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, []string{`{"name":"example"}`}, bodies)
+}
+
+func TestTransportRetryOnRateLimit(t *testing.T) {
+	bodies := []string{}
+	server := httptest.NewServer(rateLimitedHandler(t, 1, &bodies))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTransport(nil, TransportOpts{MaxRetries: 5, RetryOnRateLimit: true})}
+
+	do := func() int {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusTooManyRequests, do())
+
+	// The next request is delayed until the rate limit allows it.
+	start := time.Now()
+	assert.Equal(t, http.StatusOK, do())
+	assert.Greater(t, time.Since(start), 100*time.Millisecond)
+	assert.Len(t, bodies, 2)
+}
+
+func TestTransportNoRetryOnRateLimit(t *testing.T) {
+	bodies := []string{}
+	server := httptest.NewServer(rateLimitedHandler(t, 1, &bodies))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTransport(nil, TransportOpts{MaxRetries: 5, RetryOnRateLimit: false})}
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Len(t, bodies, 1)
+}
+
+func TestTransportMaxConcurrentRequests(t *testing.T) {
+	var current, peak atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTransport(nil, TransportOpts{MaxConcurrentRequests: 2})}
+
+	var wg sync.WaitGroup
+	for range 6 {
+		wg.Go(func() {
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+			assert.NoError(t, err)
+
+			resp, err := client.Do(req)
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+		})
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, peak.Load(), int32(2))
+}
+
+func TestRateLimitDelay(t *testing.T) {
+	testCases := []struct {
+		name      string
+		status    int
+		header    map[string]string
+		exhausted bool
+		delay     time.Duration
+	}{
+		{
+			name:   "budget left",
+			status: http.StatusOK,
+			header: map[string]string{"RateLimit-Limit": "3600", "RateLimit-Remaining": "10"},
+		},
+		{
+			name:   "no headers",
+			status: http.StatusOK,
+		},
+		{
+			name:      "rate limited without headers",
+			status:    http.StatusTooManyRequests,
+			exhausted: true,
+			delay:     defaultRateLimitDelay,
+		},
+		{
+			name:   "budget exhausted",
+			status: http.StatusOK,
+			header: map[string]string{
+				"RateLimit-Limit":     "10",
+				"RateLimit-Remaining": "0",
+				"RateLimit-Reset":     strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10),
+			},
+			exhausted: true,
+			delay:     6 * time.Minute,
+		},
+		{
+			name:   "reset in the past",
+			status: http.StatusTooManyRequests,
+			header: map[string]string{
+				"RateLimit-Limit":     "10",
+				"RateLimit-Remaining": "0",
+				"RateLimit-Reset":     strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10),
+			},
+			exhausted: true,
+			delay:     0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: testCase.status, Header: http.Header{}}
+			for key, value := range testCase.header {
+				resp.Header.Set(key, value)
+			}
+
+			delay, exhausted := rateLimitDelay(resp)
+			assert.Equal(t, testCase.exhausted, exhausted)
+			assert.InDelta(t, testCase.delay, delay, float64(time.Second))
+		})
+	}
+}
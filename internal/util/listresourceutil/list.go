@@ -0,0 +1,71 @@
+package listresourceutil
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WithSelectorModel is the list configuration model shared by the list
+// resources that can only be filtered using a label selector.
+type WithSelectorModel struct {
+	WithSelector types.String `tfsdk:"with_selector"`
+	Project      types.String `tfsdk:"project"`
+}
+
+// WithSelectorSchema returns the list configuration schema shared by the list
+// resources that can only be filtered using a label selector.
+func WithSelectorSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"with_selector": WithSelectorAttribute(),
+			"project":       ProjectAttribute(),
+		},
+	}
+}
+
+// WithSelectorAttribute returns the list configuration attribute used to filter
+// the results using a label selector.
+func WithSelectorAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Filter results using a [Label Selector](https://docs.hetzner.cloud/reference/cloud#label-selector)",
+		Optional:            true,
+	}
+}
+
+// ProjectAttribute returns the list configuration attribute used to select the
+// project the resources are listed from.
+func ProjectAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Name of the project, as configured in the `projects` block of the provider, the resources are listed from. Defaults to the project of the provider `token`.",
+		Optional:            true,
+	}
+}
+
+// Results returns an iterator over the list results of the given items. The
+// fill function populates the identity and, if requested, the resource of each
+// result. The number of results is capped by the limit of the request.
+func Results[T any](
+	ctx context.Context,
+	req list.ListRequest,
+	items []T,
+	fill func(ctx context.Context, item T, result *list.ListResult),
+) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		for i, item := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			fill(ctx, item, &result)
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
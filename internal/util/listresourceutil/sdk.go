@@ -0,0 +1,82 @@
+package listresourceutil
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// RawV6Schemas populates the response with the schemas of a SDKv2 resource.
+//
+// List resources are only supported by the plugin framework, the schemas allow
+// the framework to list the instances of resources that are still implemented
+// with the SDKv2. The schemas are upgraded to the protocol version 6, like the
+// rest of the SDKv2 provider.
+func RawV6Schemas(ctx context.Context, typeName string, r *schema.Resource, resp *list.RawV6SchemaResponse) {
+	p := &schema.Provider{ResourcesMap: map[string]*schema.Resource{typeName: r}}
+
+	server, err := tf5to6server.UpgradeServer(ctx, p.GRPCProvider)
+	if err != nil {
+		tflog.Error(ctx, "Unable to upgrade the SDKv2 resource schemas", map[string]any{"error": err})
+		return
+	}
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		tflog.Error(ctx, "Unable to get the SDKv2 resource schema", map[string]any{"error": err})
+		return
+	}
+
+	identitySchemaResp, err := server.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		tflog.Error(ctx, "Unable to get the SDKv2 resource identity schema", map[string]any{"error": err})
+		return
+	}
+
+	resp.ProtoV6Schema = schemaResp.ResourceSchemas[typeName]
+	resp.ProtoV6IdentitySchema = identitySchemaResp.IdentitySchemas[typeName]
+}
+
+// SetResourceData sets the resource of a list result using the data of a SDKv2
+// resource.
+func SetResourceData(ctx context.Context, r *schema.Resource, d *schema.ResourceData, result *list.ListResult) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state := d.State()
+	if state == nil {
+		diags.AddError("Unable to convert resource data", "The resource data is missing an ID.")
+		return diags
+	}
+
+	ty := r.CoreConfigSchema().ImpliedType()
+
+	value, err := state.AttrsAsObjectValue(ty)
+	if err != nil {
+		diags.AddError("Unable to convert resource data", err.Error())
+		return diags
+	}
+
+	data, err := msgpack.Marshal(value, ty)
+	if err != nil {
+		diags.AddError("Unable to convert resource data", err.Error())
+		return diags
+	}
+
+	raw, err := (&tfprotov5.DynamicValue{MsgPack: data}).Unmarshal(result.Resource.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		diags.AddError("Unable to convert resource data", fmt.Sprintf("Could not decode the resource data: %s", err))
+		return diags
+	}
+
+	result.Resource.Raw = raw
+
+	return diags
+}
@@ -0,0 +1,85 @@
+package resourceutil
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+)
+
+// IDIdentityModel is the identity model shared by the resources that are identified
+// by a single numeric ID.
+type IDIdentityModel struct {
+	ID types.Int64 `tfsdk:"id"`
+}
+
+// NewIDIdentity returns an identity for the given resource ID.
+func NewIDIdentity(id types.Int64) IDIdentityModel {
+	return IDIdentityModel{ID: id}
+}
+
+// IDIdentitySchema returns the identity schema shared by the resources that are
+// identified by a single numeric ID.
+func IDIdentitySchema(description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				Description:       description,
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// IDResourceIdentity returns the SDKv2 identity shared by the resources that are
+// identified by a single numeric ID.
+func IDResourceIdentity(description string) *sdkschema.ResourceIdentity {
+	return &sdkschema.ResourceIdentity{
+		SchemaFunc: func() map[string]*sdkschema.Schema {
+			return map[string]*sdkschema.Schema{
+				"id": {
+					Type:              sdkschema.TypeInt,
+					Description:       description,
+					RequiredForImport: true,
+				},
+			}
+		},
+	}
+}
+
+// SetIDIdentity sets the identity of a SDKv2 resource that is identified by a
+// single numeric ID.
+func SetIDIdentity(d *sdkschema.ResourceData, id int64) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	return identity.Set("id", id)
+}
+
+// ImportStatePassthroughWithIDIdentity imports a SDKv2 resource that is
+// identified by a single numeric ID, either using the import ID or the
+// resource identity.
+func ImportStatePassthroughWithIDIdentity(_ context.Context, d *sdkschema.ResourceData, _ any) ([]*sdkschema.ResourceData, error) {
+	if d.Id() != "" {
+		return []*sdkschema.ResourceData{d}, nil
+	}
+
+	identity, err := d.Identity()
+	if err != nil {
+		return nil, fmt.Errorf("error getting identity: %w", err)
+	}
+
+	id, ok := identity.GetOk("id")
+	if !ok {
+		return nil, fmt.Errorf("expected identity to contain key id")
+	}
+
+	d.SetId(util.FormatID(id.(int)))
+
+	return []*sdkschema.ResourceData{d}, nil
+}
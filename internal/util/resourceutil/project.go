@@ -0,0 +1,21 @@
+package resourceutil
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+)
+
+// ProjectAttribute returns the `project` attribute used to select the project a
+// resource is managed in. Moving a resource to another project replaces it.
+func ProjectAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: hcloudutil.ProjectAttributeDescription,
+		Optional:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
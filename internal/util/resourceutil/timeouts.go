@@ -0,0 +1,43 @@
+package resourceutil
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultTimeout is the default timeout of the resource operations, it matches
+// the default timeout of the SDKv2 resources.
+const DefaultTimeout = 20 * time.Minute
+
+// TimeoutsBlock returns the `timeouts` block used to configure the timeouts of
+// the create, update and delete operations of a resource.
+func TimeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+// TimeoutsType returns the type of the `timeouts` block.
+func TimeoutsType() timeouts.Type {
+	return timeouts.Type{
+		ObjectType: types.ObjectType{
+			AttrTypes: map[string]attr.Type{
+				"create": types.StringType,
+				"update": types.StringType,
+				"delete": types.StringType,
+			},
+		},
+	}
+}
+
+// TimeoutsNull returns a null value for the `timeouts` block.
+func TimeoutsNull() timeouts.Value {
+	return timeouts.Value{Object: types.ObjectNull(TimeoutsType().AttrTypes)}
+}
@@ -34,3 +34,29 @@ func (v IPValidator) ValidateString(ctx context.Context, req validator.StringReq
 		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(req.Path, v.Description(ctx), raw))
 	}
 }
+
+var _ validator.String = CIDRValidator{}
+
+type CIDRValidator struct{}
+
+func CIDR() CIDRValidator {
+	return CIDRValidator{}
+}
+
+func (v CIDRValidator) Description(_ context.Context) string {
+	return "must be a valid cidr"
+}
+
+func (v CIDRValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v CIDRValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	raw := req.ConfigValue.ValueString()
+	if _, _, err := net.ParseCIDR(raw); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(req.Path, v.Description(ctx), raw))
+	}
+}
@@ -55,3 +55,49 @@ func TestIPValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestCIDRValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		given types.String
+		want  diag.Diagnostics
+	}{
+		"unknown": {
+			given: types.StringUnknown(),
+		},
+		"null": {
+			given: types.StringNull(),
+		},
+		"valid": {
+			given: types.StringValue("10.0.0.0/16"),
+		},
+		"invalid": {
+			given: types.StringValue("10.0.1.2"),
+			want: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Invalid Attribute Value",
+					"Attribute test must be a valid cidr, got: 10.0.1.2",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    testCase.given,
+			}
+			resp := validator.StringResponse{}
+
+			CIDRValidator{}.ValidateString(t.Context(), req, &resp)
+
+			assert.Equal(t, testCase.want, resp.Diagnostics)
+		})
+	}
+}
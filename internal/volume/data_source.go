@@ -212,3 +212,25 @@ func dataSourceHcloudVolumeListRead(ctx context.Context, d *schema.ResourceData,
 
 	return nil
 }
+
+func setVolumeSchema(d *schema.ResourceData, v *hcloud.Volume) {
+	util.SetSchemaFromAttributes(d, getVolumeAttributes(v))
+}
+
+func getVolumeAttributes(v *hcloud.Volume) map[string]any {
+	res := map[string]any{
+		"id":                v.ID,
+		"name":              v.Name,
+		"size":              v.Size,
+		"location":          v.Location.Name,
+		"labels":            v.Labels,
+		"linux_device":      v.LinuxDevice,
+		"delete_protection": v.Protection.Delete,
+	}
+
+	if v.Server != nil {
+		res["server_id"] = v.Server.ID
+	}
+
+	return res
+}
@@ -0,0 +1,105 @@
+package volume
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
+)
+
+type model struct {
+	ID               types.Int64  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Size             types.Int64  `tfsdk:"size"`
+	Location         types.String `tfsdk:"location"`
+	ServerID         types.Int64  `tfsdk:"server_id"`
+	Labels           types.Map    `tfsdk:"labels"`
+	LinuxDevice      types.String `tfsdk:"linux_device"`
+	DeleteProtection types.Bool   `tfsdk:"delete_protection"`
+}
+
+var _ util.ModelFromAPI[*hcloud.Volume] = &model{}
+var _ util.ModelToTerraform[types.Object] = &model{}
+
+func (m *model) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                types.Int64Type,
+		"name":              types.StringType,
+		"size":              types.Int64Type,
+		"location":          types.StringType,
+		"server_id":         types.Int64Type,
+		"labels":            types.MapType{ElemType: types.StringType},
+		"linux_device":      types.StringType,
+		"delete_protection": types.BoolType,
+	}
+}
+
+func (m *model) FromAPI(ctx context.Context, hc *hcloud.Volume) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	m.ID = types.Int64Value(hc.ID)
+	m.Name = types.StringValue(hc.Name)
+	m.Size = types.Int64Value(int64(hc.Size))
+	m.Location = types.StringValue(hc.Location.Name)
+
+	if hc.Server != nil {
+		m.ServerID = types.Int64Value(hc.Server.ID)
+	} else {
+		m.ServerID = types.Int64Value(0)
+	}
+
+	m.Labels, newDiags = resourceutil.LabelsMapValueFrom(ctx, hc.Labels)
+	diags.Append(newDiags...)
+
+	m.LinuxDevice = types.StringValue(hc.LinuxDevice)
+	m.DeleteProtection = types.BoolValue(hc.Protection.Delete)
+
+	return diags
+}
+
+func (m *model) ToTerraform(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
+}
+
+type attachmentModel struct {
+	ID       types.Int64 `tfsdk:"id"`
+	VolumeID types.Int64 `tfsdk:"volume_id"`
+	ServerID types.Int64 `tfsdk:"server_id"`
+}
+
+var _ util.ModelFromAPI[*hcloud.Volume] = &attachmentModel{}
+var _ util.ModelToTerraform[types.Object] = &attachmentModel{}
+
+func (m *attachmentModel) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":        types.Int64Type,
+		"volume_id": types.Int64Type,
+		"server_id": types.Int64Type,
+	}
+}
+
+// FromAPI populates the model from the API Volume, which must be attached to a
+// server.
+func (m *attachmentModel) FromAPI(_ context.Context, hc *hcloud.Volume) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Since a Volume can only be attached to one server, the Volume ID is used
+	// as the attachment ID.
+	m.ID = types.Int64Value(hc.ID)
+	m.VolumeID = types.Int64Value(hc.ID)
+	if hc.Server != nil {
+		m.ServerID = types.Int64Value(hc.Server.ID)
+	}
+
+	return diags
+}
+
+func (m *attachmentModel) ToTerraform(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
+}
@@ -0,0 +1,71 @@
+package volume
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func TestModel(t *testing.T) {
+	ctx := t.Context()
+
+	t.Run("attached", func(t *testing.T) {
+		in := &hcloud.Volume{
+			ID:          42,
+			Name:        "volume",
+			Size:        10,
+			Location:    &hcloud.Location{Name: "fsn1"},
+			Server:      &hcloud.Server{ID: 4711},
+			LinuxDevice: "/dev/disk/by-id/scsi-0HC_Volume_42",
+			Labels:      map[string]string{"key": "value"},
+			Protection:  hcloud.VolumeProtection{Delete: true},
+		}
+
+		o := &model{}
+		assert.Nil(t, o.FromAPI(ctx, in))
+		assert.Equal(t, int64(42), o.ID.ValueInt64())
+		assert.Equal(t, "volume", o.Name.ValueString())
+		assert.Equal(t, int64(10), o.Size.ValueInt64())
+		assert.Equal(t, "fsn1", o.Location.ValueString())
+		assert.Equal(t, int64(4711), o.ServerID.ValueInt64())
+		assert.Equal(t, "/dev/disk/by-id/scsi-0HC_Volume_42", o.LinuxDevice.ValueString())
+
+		labels := map[string]string{}
+		assert.Nil(t, o.Labels.ElementsAs(ctx, &labels, false))
+		assert.Equal(t, map[string]string{"key": "value"}, labels)
+
+		assert.Equal(t, true, o.DeleteProtection.ValueBool())
+	})
+
+	t.Run("detached", func(t *testing.T) {
+		in := &hcloud.Volume{
+			ID:       42,
+			Name:     "volume",
+			Size:     10,
+			Location: &hcloud.Location{Name: "fsn1"},
+			Labels:   map[string]string{},
+		}
+
+		o := &model{}
+		assert.Nil(t, o.FromAPI(ctx, in))
+		assert.Equal(t, int64(0), o.ServerID.ValueInt64())
+		assert.Equal(t, false, o.DeleteProtection.ValueBool())
+	})
+}
+
+func TestAttachmentModel(t *testing.T) {
+	ctx := t.Context()
+
+	in := &hcloud.Volume{
+		ID:     42,
+		Server: &hcloud.Server{ID: 4711},
+	}
+
+	o := &attachmentModel{}
+	assert.Nil(t, o.FromAPI(ctx, in))
+	assert.Equal(t, int64(42), o.ID.ValueInt64())
+	assert.Equal(t, int64(42), o.VolumeID.ValueInt64())
+	assert.Equal(t, int64(4711), o.ServerID.ValueInt64())
+}
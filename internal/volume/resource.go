@@ -3,422 +3,500 @@ package volume
 import (
 	"context"
 	"errors"
-	"log"
+	"strconv"
 	"strings"
 
-	"github.com/hashicorp/go-cty/cty"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/control"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
 )
 
 // ResourceType is the type name of the Hetzner Cloud Volume resource.
 const ResourceType = "hcloud_volume"
 
-// Resource creates a Terraform schema for the hcloud_volume resource.
-func Resource() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceVolumeCreate,
-		ReadContext:   resourceVolumeRead,
-		UpdateContext: resourceVolumeUpdate,
-		DeleteContext: resourceVolumeDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+var _ resource.Resource = (*Resource)(nil)
+var _ resource.ResourceWithConfigure = (*Resource)(nil)
+var _ resource.ResourceWithImportState = (*Resource)(nil)
+var _ resource.ResourceWithIdentity = (*Resource)(nil)
+var _ resource.ResourceWithUpgradeState = (*Resource)(nil)
 
-		Schema: map[string]*schema.Schema{
-			"name": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"size": {
-				Type:     schema.TypeInt,
-				Required: true,
-			},
-			"location": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
-			},
-			"server_id": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Computed: true,
-			},
-			"labels": {
-				Type:     schema.TypeMap,
-				Optional: true,
-				ValidateDiagFunc: func(i any, path cty.Path) diag.Diagnostics { // nolint:revive
-					if ok, err := hcloud.ValidateResourceLabels(i.(map[string]any)); !ok {
-						return diag.FromErr(err)
-					}
-					return nil
-				},
-			},
-			"linux_device": {
-				Type:     schema.TypeString,
-				Computed: true,
+type Resource struct {
+	client *hcloud.Client
+}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+func (r *Resource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = ResourceType
+}
+
+func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	r.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.Version = 1
+	resp.Schema.MarkdownDescription = util.MarkdownDescription(`
+Provides a Hetzner Cloud Volume to represent a Volume in the Hetzner Cloud.
+
+See the [Volumes API documentation](https://docs.hetzner.cloud/reference/cloud#tag/volumes) for more details.
+`)
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Volume.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
 			},
-			"automount": {
-				Type:     schema.TypeBool,
-				Optional: true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the Volume.",
+			Required:            true,
+		},
+		"size": schema.Int64Attribute{
+			MarkdownDescription: "Size of the Volume in GB.",
+			Required:            true,
+		},
+		"location": schema.StringAttribute{
+			MarkdownDescription: "Name of the Location to create the Volume in. Optional if `server_id` is set.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+				stringplanmodifier.UseStateForUnknown(),
 			},
-			"format": {
-				Type:     schema.TypeString,
-				Optional: true,
+		},
+		"server_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Server to attach the Volume to. Optional if `location` is set.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
 			},
-			"delete_protection": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+		},
+		"labels": resourceutil.LabelsSchema(),
+		"linux_device": schema.StringAttribute{
+			MarkdownDescription: "Device path on the file system of the Volume.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"automount": schema.BoolAttribute{
+			MarkdownDescription: "Whether to automount the Volume after attaching it to the Server.",
+			Optional:            true,
+		},
+		"format": schema.StringAttribute{
+			MarkdownDescription: "Format of the Volume file system (`xfs` or `ext4`).",
+			Optional:            true,
+		},
+		"delete_protection": schema.BoolAttribute{
+			MarkdownDescription: "Whether delete protection is enabled.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
 	}
 }
 
-func resourceVolumeCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	c := m.(*hcloud.Client)
+func (r *Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceutil.IDIdentitySchema("ID of the Volume.")
+}
+
+type resourceModel struct {
+	model
+
+	// Automount and Format are only used when creating or attaching the Volume,
+	// they are not returned by the API.
+	Automount types.Bool   `tfsdk:"automount"`
+	Format    types.String `tfsdk:"format"`
+
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
 
 	opts := hcloud.VolumeCreateOpts{
-		Name: d.Get("name").(string),
-		Size: d.Get("size").(int),
+		Name: data.Name.ValueString(),
+		Size: int(data.Size.ValueInt64()),
 	}
 
-	if serverID, ok := d.GetOk("server_id"); ok {
-		opts.Server = &hcloud.Server{ID: util.CastInt64(serverID)}
+	if data.ServerID.ValueInt64() != 0 {
+		opts.Server = &hcloud.Server{ID: data.ServerID.ValueInt64()}
 	}
-	if location, ok := d.GetOk("location"); ok {
-		opts.Location = &hcloud.Location{Name: location.(string)}
+	if !data.Location.IsUnknown() && !data.Location.IsNull() {
+		opts.Location = &hcloud.Location{Name: data.Location.ValueString()}
 	}
-	if labels, ok := d.GetOk("labels"); ok {
-		tmpLabels := make(map[string]string)
-		for k, v := range labels.(map[string]any) {
-			tmpLabels[k] = v.(string)
-		}
-		opts.Labels = tmpLabels
+	if !data.Automount.IsNull() {
+		opts.Automount = data.Automount.ValueBoolPointer()
 	}
-	if automount, ok := d.GetOk("automount"); ok {
-		opts.Automount = new(automount.(bool))
+	if !data.Format.IsNull() {
+		opts.Format = data.Format.ValueStringPointer()
 	}
-	if format, ok := d.GetOk("format"); ok {
-		opts.Format = new(format.(string))
+
+	resp.Diagnostics.Append(hcloudutil.TerraformLabelsToHCloud(ctx, data.Labels, &opts.Labels)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	result, _, err := c.Volume.Create(ctx, opts)
-	if err != nil {
+	var result hcloud.VolumeCreateResult
+	err := control.Retry(ctx, control.DefaultRetries, func() error {
+		var err error
+
+		result, _, err = r.client.Volume.Create(ctx, opts)
 		if hcloud.IsError(err, hcloud.ErrorCodeLocked) {
-			return resourceVolumeCreate(ctx, d, m)
+			return err
 		}
-		return hcloudutil.ErrorToDiag(err)
+		return control.AbortRetry(err)
+	})
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	d.SetId(util.FormatID(result.Volume.ID))
 
-	if err = c.Action.WaitFor(ctx, result.Action); err != nil {
-		return hcloudutil.ErrorToDiag(err)
+	// Make sure to save the ID immediately so we can recover if the process stops after
+	// this call. Terraform marks the resource as "tainted", so it can be deleted and no
+	// surprise "duplicate resource" errors happen.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.Int64Value(result.Volume.ID))...)
+
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, result.Action)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
 	for _, nextAction := range result.NextActions {
-		if err = c.Action.WaitFor(ctx, nextAction); err != nil {
-			var aerr hcloud.ActionError
+		err := r.client.Action.WaitFor(ctx, nextAction)
+		if err == nil {
+			continue
+		}
 
-			if nextAction.Command != "attach_volume" {
-				return hcloudutil.ErrorToDiag(err)
-			}
-			if !errors.As(err, &aerr) {
-				return hcloudutil.ErrorToDiag(err)
-			}
-			if !strings.Contains(aerr.Message, string(hcloud.ErrorCodeLocked)) {
-				return hcloudutil.ErrorToDiag(err)
-			}
+		var aerr hcloud.ActionError
+		if nextAction.Command != "attach_volume" ||
+			!errors.As(err, &aerr) ||
+			!strings.Contains(aerr.Message, string(hcloud.ErrorCodeLocked)) {
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
+		}
 
-			// Sometimes, when multiple volumes are created (for the same server)
-			// we get a failed attach_volume action with the "locked" error identifier
-			// We should then simply wait a few seconds and control.Retry the attachment.
-			// Use a sleeping timeout that is high enough,
-			// most volume actions will be done in less than 5 seconds
-			// so with 5 seconds we should be safe enough. after that control.Retry the attach
-			// call and it should work.
-			for _, resource := range nextAction.Resources {
-				if resource.Type == hcloud.ActionResourceTypeServer {
-					err := control.Retry(control.DefaultRetries, func() error {
-						o := hcloud.VolumeAttachOpts{Server: opts.Server}
-						if automount, ok := d.GetOk("automount"); ok {
-							opts.Automount = new(automount.(bool))
-						}
-						action, _, err := c.Volume.AttachWithOpts(ctx, result.Volume, o)
-						if err != nil {
-							return err
-						}
-						return c.Action.WaitFor(ctx, action)
-					})
-					if err != nil {
-						return hcloudutil.ErrorToDiag(err)
-					}
-				}
-			}
+		// Sometimes, when multiple volumes are created for the same server, the
+		// attach_volume action fails with the "locked" error. Retry the attachment
+		// until the server is not locked anymore.
+		resp.Diagnostics.Append(attach(ctx, r.client, result.Volume, opts.Server, data.Automount)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	deleteProtection := d.Get("delete_protection").(bool)
-	if deleteProtection {
-		if err := setProtection(ctx, c, result.Volume, deleteProtection); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+	if data.DeleteProtection.ValueBool() {
+		resp.Diagnostics.Append(setProtection(ctx, r.client, result.Volume, true)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	return resourceVolumeRead(ctx, d, m)
+	// Fetch fresh data from the API
+	in, _, err := r.client.Volume.GetByID(ctx, result.Volume.ID)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+	if in == nil {
+		resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("volume", "id", result.Volume.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func resourceVolumeRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resourceModel
 
-	id, err := util.ParseID(d.Id())
-	if err != nil {
-		log.Printf("[WARN] invalid volume id (%s), removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	volume, _, err := client.Volume.GetByID(ctx, id)
+	in, _, err := r.client.Volume.GetByID(ctx, data.ID.ValueInt64())
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	if volume == nil {
-		log.Printf("[WARN] volume (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
+	if in == nil {
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	setVolumeSchema(d, volume)
-	return nil
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func resourceVolumeUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	c := m.(*hcloud.Client)
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, plan resourceModel
 
-	id, err := util.ParseID(d.Id())
-	if err != nil {
-		log.Printf("[WARN] invalid volume id (%s), removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	volume, _, err := c.Volume.GetByID(ctx, id)
-	if err != nil {
-		if resourceVolumeIsNotFound(err, d) {
-			return nil
-		}
-		return hcloudutil.ErrorToDiag(err)
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	d.Partial(true)
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "update", updateTimeout)
+	defer cancel()
 
-	if d.HasChange("name") {
-		description := d.Get("name").(string)
-		_, _, err := c.Volume.Update(ctx, volume, hcloud.VolumeUpdateOpts{
-			Name: description,
-		})
-		if err != nil {
-			if resourceVolumeIsNotFound(err, d) {
-				return nil
-			}
-			return hcloudutil.ErrorToDiag(err)
-		}
-	}
+	volume := &hcloud.Volume{ID: data.ID.ValueInt64()}
 
-	if d.HasChange("server_id") {
-		serverID := util.CastInt64(d.Get("server_id"))
-		if serverID == 0 {
-			err := control.Retry(control.DefaultRetries, func() error {
-				action, _, err := c.Volume.Detach(ctx, volume)
-				if err != nil {
-					if resourceVolumeIsNotFound(err, d) {
-						return nil
-					}
-					return err
-				}
-
-				return c.Action.WaitFor(ctx, action)
-			})
-			if err != nil {
-				return hcloudutil.ErrorToDiag(err)
-			}
-		} else {
-			if volume.Server != nil {
-				err := control.Retry(control.DefaultRetries, func() error {
-					action, _, err := c.Volume.Detach(ctx, volume)
-					if err != nil {
-						if resourceVolumeIsNotFound(err, d) {
-							return nil
-						}
-						return err
-					}
-
-					return c.Action.WaitFor(ctx, action)
-				})
-				if err != nil {
-					return hcloudutil.ErrorToDiag(err)
-				}
+	// Action: Server
+	if !plan.ServerID.IsUnknown() && !plan.ServerID.Equal(data.ServerID) {
+		if data.ServerID.ValueInt64() != 0 {
+			resp.Diagnostics.Append(detach(ctx, r.client, volume)...)
+			if resp.Diagnostics.HasError() {
+				return
 			}
-			err := control.Retry(control.DefaultRetries, func() error {
-				opts := hcloud.VolumeAttachOpts{Server: &hcloud.Server{ID: serverID}}
-				if automount, ok := d.GetOk("automount"); ok {
-					opts.Automount = new(automount.(bool))
-				}
-
-				action, _, err := c.Volume.AttachWithOpts(ctx, volume, opts)
-				if err != nil {
-					if resourceVolumeIsNotFound(err, d) {
-						return nil
-					}
-					return err
-				}
-
-				return c.Action.WaitFor(ctx, action)
-			})
-			if err != nil {
-				return hcloudutil.ErrorToDiag(err)
+		}
+		if plan.ServerID.ValueInt64() != 0 {
+			resp.Diagnostics.Append(attach(ctx, r.client, volume, &hcloud.Server{ID: plan.ServerID.ValueInt64()}, plan.Automount)...)
+			if resp.Diagnostics.HasError() {
+				return
 			}
 		}
 	}
 
-	if d.HasChange("size") {
-		size := d.Get("size").(int)
-		action, _, err := c.Volume.Resize(ctx, volume, size)
+	// Action: Size
+	if !plan.Size.IsUnknown() && !plan.Size.Equal(data.Size) {
+		action, _, err := r.client.Volume.Resize(ctx, volume, int(plan.Size.ValueInt64()))
 		if err != nil {
-			if resourceVolumeIsNotFound(err, d) {
-				return nil
-			}
-			return hcloudutil.ErrorToDiag(err)
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
 		}
 
-		if err = c.Action.WaitFor(ctx, action); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, action)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	if d.HasChange("labels") {
-		labels := d.Get("labels")
-		tmpLabels := make(map[string]string)
-		for k, v := range labels.(map[string]any) {
-			tmpLabels[k] = v.(string)
-		}
-		_, _, err := c.Volume.Update(ctx, volume, hcloud.VolumeUpdateOpts{
-			Labels: tmpLabels,
-		})
-		if err != nil {
-			if resourceVolumeIsNotFound(err, d) {
-				return nil
-			}
-			return hcloudutil.ErrorToDiag(err)
+	// Action: Delete Protection
+	if !plan.DeleteProtection.IsUnknown() && !plan.DeleteProtection.Equal(data.DeleteProtection) {
+		resp.Diagnostics.Append(setProtection(ctx, r.client, volume, plan.DeleteProtection.ValueBool())...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	if d.HasChange("delete_protection") {
-		deletionProtection := d.Get("delete_protection").(bool)
-		if err := setProtection(ctx, c, volume, deletionProtection); err != nil {
-			return hcloudutil.ErrorToDiag(err)
+	// Update fields on resource
+	opts := hcloud.VolumeUpdateOpts{}
+
+	if !plan.Name.IsUnknown() && !plan.Name.Equal(data.Name) {
+		opts.Name = plan.Name.ValueString()
+	}
+	if !plan.Labels.IsUnknown() && !plan.Labels.Equal(data.Labels) {
+		resp.Diagnostics.Append(hcloudutil.TerraformLabelsToHCloud(ctx, plan.Labels, &opts.Labels)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
-	d.Partial(false)
-	return resourceVolumeRead(ctx, d, m)
+	// Always perform the update call last, even when empty, to populate the state with fresh data returned by
+	// the update.
+	in, _, err := r.client.Volume.Update(ctx, volume, opts)
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Automount = plan.Automount
+	data.Format = plan.Format
+	data.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func resourceVolumeDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	c := m.(*hcloud.Client)
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resourceModel
 
-	volumeID, err := util.ParseID(d.Id())
-	if err != nil {
-		log.Printf("[WARN] invalid volume id (%s), removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	volume, _, err := c.Volume.GetByID(ctx, volumeID)
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
+
+	volume, _, err := r.client.Volume.GetByID(ctx, data.ID.ValueInt64())
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
 	if volume == nil {
-		log.Printf("[WARN] volume (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return nil
+		return
 	}
 
 	if volume.Server != nil {
-		err := control.Retry(control.DefaultRetries, func() error {
-			action, _, err := c.Volume.Detach(ctx, volume)
-			if err != nil {
-				if resourceVolumeIsNotFound(err, d) {
-					return nil
-				}
-				return err
-			}
-
-			return c.Action.WaitFor(ctx, action)
-		})
-		if err != nil {
-			return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(detach(ctx, r.client, volume)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
-	err = control.Retry(control.DefaultRetries, func() error {
-		if _, err := c.Volume.Delete(ctx, volume); err != nil {
-			if resourceVolumeIsNotFound(err, d) {
-				return nil
-			}
-			return err
+
+	err = control.Retry(ctx, control.DefaultRetries, func() error {
+		_, err := r.client.Volume.Delete(ctx, volume)
+		if hcloudutil.APIErrorIsNotFound(err) {
+			return nil
 		}
-		return nil
+		return err
 	})
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-
-	return nil
 }
 
-func resourceVolumeIsNotFound(err error, d *schema.ResourceData) bool {
-	if hcloud.IsError(err, hcloud.ErrorCodeNotFound) {
-		log.Printf("[WARN] volume (%s) not found, removing from state", d.Id())
-		d.SetId("")
-		return true
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity resourceutil.IDIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		return
+	}
+
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.Append(util.InvalidImportID("$VOLUME_ID", req.ID))
+		return
 	}
-	return false
-}
 
-func setVolumeSchema(d *schema.ResourceData, v *hcloud.Volume) {
-	util.SetSchemaFromAttributes(d, getVolumeAttributes(v))
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
-func getVolumeAttributes(v *hcloud.Volume) map[string]any {
-	res := map[string]any{
-		"id":                v.ID,
-		"name":              v.Name,
-		"size":              v.Size,
-		"location":          v.Location.Name,
-		"labels":            v.Labels,
-		"linux_device":      v.LinuxDevice,
-		"delete_protection": v.Protection.Delete,
+// attach attaches the Volume to the Server, and retries while the Server or
+// Volume is locked.
+func attach(ctx context.Context, c *hcloud.Client, v *hcloud.Volume, s *hcloud.Server, automount types.Bool) diag.Diagnostics {
+	opts := hcloud.VolumeAttachOpts{Server: s}
+	if !automount.IsUnknown() && !automount.IsNull() {
+		opts.Automount = automount.ValueBoolPointer()
 	}
 
-	if v.Server != nil {
-		res["server_id"] = v.Server.ID
+	var action *hcloud.Action
+	err := control.Retry(ctx, control.DefaultRetries, func() error {
+		var err error
+
+		action, _, err = c.Volume.AttachWithOpts(ctx, v, opts)
+		if hcloud.IsError(err, hcloud.ErrorCodeLocked) {
+			return err
+		}
+		return control.AbortRetry(err)
+	})
+	if err != nil {
+		return hcloudutil.APIErrorDiagnostics(err)
 	}
 
-	return res
+	return hcloudutil.SettleActions(ctx, &c.Action, action)
 }
 
-func setProtection(ctx context.Context, c *hcloud.Client, v *hcloud.Volume, deleteProtection bool) error {
+// detach detaches the Volume from its Server, and retries while the Server or
+// Volume is locked. A Volume that does not exist anymore is ignored.
+func detach(ctx context.Context, c *hcloud.Client, v *hcloud.Volume) diag.Diagnostics {
+	var action *hcloud.Action
+	err := control.Retry(ctx, control.DefaultRetries, func() error {
+		var err error
+
+		action, _, err = c.Volume.Detach(ctx, v)
+		if hcloud.IsError(err, hcloud.ErrorCodeLocked) {
+			return err
+		}
+		if hcloudutil.APIErrorIsNotFound(err) {
+			action = nil
+			return nil
+		}
+		return control.AbortRetry(err)
+	})
+	if err != nil {
+		return hcloudutil.APIErrorDiagnostics(err)
+	}
+
+	return hcloudutil.SettleActions(ctx, &c.Action, action)
+}
+
+func setProtection(ctx context.Context, c *hcloud.Client, v *hcloud.Volume, deleteProtection bool) diag.Diagnostics {
 	action, _, err := c.Volume.ChangeProtection(ctx, v,
 		hcloud.VolumeChangeProtectionOpts{
 			Delete: &deleteProtection,
 		},
 	)
 	if err != nil {
-		return err
+		return hcloudutil.APIErrorDiagnostics(err)
 	}
 
-	return c.Action.WaitFor(ctx, action)
+	return hcloudutil.SettleActions(ctx, &c.Action, action)
 }
@@ -2,185 +2,261 @@ package volume
 
 import (
 	"context"
-	"log"
+	"strconv"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
-	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/control"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
 )
 
 // AttachmentResourceType is the type name of the Hetzner Cloud Volume
 // attachment resource.
 const AttachmentResourceType = "hcloud_volume_attachment"
 
-// AttachmentResource creates a Terraform schema for the
-// hcloud_volume_attachmetn resource.
-func AttachmentResource() *schema.Resource {
-	return &schema.Resource{
-		CreateContext: resourceVolumeAttachmentCreate,
-		ReadContext:   resourceVolumeAttachmentRead,
-		DeleteContext: resourceVolumeAttachmentDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: map[string]*schema.Schema{
-			"volume_id": {
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: true,
+var _ resource.Resource = (*AttachmentResource)(nil)
+var _ resource.ResourceWithConfigure = (*AttachmentResource)(nil)
+var _ resource.ResourceWithImportState = (*AttachmentResource)(nil)
+var _ resource.ResourceWithIdentity = (*AttachmentResource)(nil)
+var _ resource.ResourceWithUpgradeState = (*AttachmentResource)(nil)
+
+type AttachmentResource struct {
+	client *hcloud.Client
+}
+
+func NewAttachmentResource() resource.Resource {
+	return &AttachmentResource{}
+}
+
+func (r *AttachmentResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = AttachmentResourceType
+}
+
+func (r *AttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	r.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *AttachmentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.Version = 1
+	resp.Schema.MarkdownDescription = util.MarkdownDescription(`
+Provides a Hetzner Cloud Volume attachment to attach a Volume to a Hetzner Cloud Server.
+Deleting a Volume Attachment will detach the Volume from the Server.
+`)
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Volume Attachment, equal to the ID of the Volume.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
 			},
-			"server_id": {
-				Type:     schema.TypeInt,
-				Required: true,
-				ForceNew: true,
+		},
+		"volume_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Volume.",
+			Required:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
 			},
-			"automount": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Computed: true,
+		},
+		"server_id": schema.Int64Attribute{
+			MarkdownDescription: "ID of the Server to attach the Volume to.",
+			Required:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
 			},
 		},
+		"automount": schema.BoolAttribute{
+			MarkdownDescription: "Whether to automount the Volume after attaching it to the Server.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
 	}
 }
 
-func resourceVolumeAttachmentCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	var action *hcloud.Action
+type attachmentResourceModel struct {
+	attachmentModel
 
-	c := m.(*hcloud.Client)
+	// Automount is only used when attaching the Volume, it is not returned by
+	// the API.
+	Automount types.Bool `tfsdk:"automount"`
 
-	volumeID := d.Get("volume_id")
-	volume := &hcloud.Volume{ID: util.CastInt64(volumeID)}
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
 
-	serverID := d.Get("server_id")
+func (r *AttachmentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceutil.IDIdentitySchema("ID of the Volume Attachment, equal to the ID of the Volume.")
+}
 
-	server := &hcloud.Server{ID: util.CastInt64(serverID)}
+func (r *AttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data attachmentResourceModel
 
-	opts := hcloud.VolumeAttachOpts{
-		Server: server,
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if automount, ok := d.GetOk("automount"); ok {
-		opts.Automount = new(automount.(bool))
+
+	createTimeout, diags := data.Timeouts.Create(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	err := control.Retry(control.DefaultRetries, func() error {
-		var err error
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
 
-		action, _, err = c.Volume.AttachWithOpts(ctx, volume, opts)
-		if hcloud.IsError(err, hcloud.ErrorCodeLocked) {
-			return err
-		}
-		return control.AbortRetry(err)
-	})
+	volume := &hcloud.Volume{ID: data.VolumeID.ValueInt64()}
+	server := &hcloud.Server{ID: data.ServerID.ValueInt64()}
+
+	resp.Diagnostics.Append(attach(ctx, r.client, volume, server, data.Automount)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch fresh data from the API
+	in, _, err := r.client.Volume.GetByID(ctx, volume.ID)
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+	if in == nil {
+		resp.Diagnostics.Append(hcloudutil.NotFoundDiagnostic("volume", "id", volume.ID))
+		return
 	}
-	// Since a volume can only be attached to one server
-	// we can use the volume id as volume attachment id.
-	d.SetId(util.FormatID(volume.ID))
 
-	if err = c.Action.WaitFor(ctx, action); err != nil {
-		return hcloudutil.ErrorToDiag(err)
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	return resourceVolumeAttachmentRead(ctx, d, m)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
-func resourceVolumeAttachmentRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := m.(*hcloud.Client)
+func (r *AttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data attachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	volumeID, err := util.ParseID(d.Id())
+	in, _, err := r.client.Volume.GetByID(ctx, data.ID.ValueInt64())
 	if err != nil {
-		log.Printf("[WARN] Volume ID (%s) not found, removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+	if in == nil || in.Server == nil {
+		resp.State.RemoveResource(ctx)
+		return
 	}
 
-	// 'volume_id' and 'server_id' is 'Required' and 'TypeInt'
-	// therefore the cast should always work
-	volume, _, err := client.Volume.GetByID(ctx, volumeID)
+	server, _, err := r.client.Server.GetByID(ctx, in.Server.ID)
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	if volume == nil {
-		log.Printf("[WARN] Volume ID (%v) not found, removing volume attachment from state", d.Get("volume_id"))
-		d.SetId("")
-		return nil
+	if server == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(data.FromAPI(ctx, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
+}
+
+func (r *AttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, plan attachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	// check if volume is attached to any server
-	if volume.Server == nil {
-		log.Printf("[WARN] Volume (%v) is not attached to a server, removing volume attachment from state", d.Get("volume_id"))
-		d.SetId("")
-		return nil
+
+	// Only the automount and timeouts attributes may be updated in place. The
+	// automount option is only applied when attaching the Volume.
+	data.Automount = plan.Automount
+	data.Timeouts = plan.Timeouts
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
+}
+
+func (r *AttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data attachmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// when importing the resource the server_id is not given
-	// because only the terraform ID (volume ID in this case)
-	// is available, so we need to get the ID from the volume
-	// instead of from the configuration
-	serverID := util.CastInt64(d.Get("server_id"))
-	if serverID == 0 {
-		serverID = volume.Server.ID
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	server, _, err := client.Server.GetByID(ctx, serverID)
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
+
+	volume, _, err := r.client.Volume.GetByID(ctx, data.ID.ValueInt64())
 	if err != nil {
-		return hcloudutil.ErrorToDiag(err)
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
 	}
-	if server == nil {
-		log.Printf("[WARN] Server ID (%v) not found, removing volume attachment from state", d.Get("server_id"))
-		d.SetId("")
-		return nil
+	if volume == nil || volume.Server == nil {
+		return
 	}
 
-	d.Set("server_id", volume.Server.ID)
-	d.Set("volume_id", volume.ID)
-	return nil
+	resp.Diagnostics.Append(detach(ctx, r.client, volume)...)
 }
 
-func resourceVolumeAttachmentDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	c := m.(*hcloud.Client)
+func (r *AttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity resourceutil.IDIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume_id"), identity.ID)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("automount"), false)...)
+		return
+	}
 
-	volumeID, err := util.ParseID(d.Id())
+	id, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
-		log.Printf("[WARN] Invalid id (%s), removing from state: %v", d.Id(), err)
-		d.SetId("")
-		return nil
+		resp.Diagnostics.Append(util.InvalidImportID("$VOLUME_ID", req.ID))
+		return
 	}
 
-	volume, _, err := c.Volume.GetByID(ctx, volumeID)
-	if err != nil {
-		log.Printf("[WARN] Volume ID (%v) not found, removing volume attachment from state", d.Get("volume_id"))
-		d.SetId("")
-		return nil
-	}
-	if volume == nil {
-		log.Printf("[WARN] Volume ID (%v) not found, removing volume attachment from state", d.Get("volume_id"))
-		d.SetId("")
-		return nil
-	}
-	if volume.Server != nil {
-		var action *hcloud.Action
-
-		err := control.Retry(control.DefaultRetries, func() error {
-			var err error
-
-			action, _, err = c.Volume.Detach(ctx, volume)
-			if hcloud.IsError(err, hcloud.ErrorCodeLocked) {
-				return err
-			}
-			return control.AbortRetry(err)
-		})
-		if err != nil {
-			return hcloudutil.ErrorToDiag(err)
-		}
-
-		if err = c.Action.WaitFor(ctx, action); err != nil {
-			return hcloudutil.ErrorToDiag(err)
-		}
-	}
-	return nil
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume_id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("automount"), false)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(types.Int64Value(id)))...)
 }
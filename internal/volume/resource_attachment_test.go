@@ -5,7 +5,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/server"
@@ -110,3 +113,119 @@ func TestAccVolumeAttachmentResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccVolumeAttachmentResource_Identity(t *testing.T) {
+	var s hcloud.Server
+	var v hcloud.Volume
+	tmplMan := testtemplate.Manager{}
+
+	resSSHKey := sshkey.NewRData(t, "server-vol-identity")
+	resServer := &server.RData{
+		Name:  "vol-attachment-identity",
+		Type:  teste2e.TestServerType,
+		Image: teste2e.TestImage,
+		Labels: map[string]string{
+			"tf-test": fmt.Sprintf("tf-test-vol-attachment-%d", tmplMan.RandInt),
+		},
+		SSHKeys: []string{resSSHKey.TFID() + ".id"},
+	}
+	resServer.SetRName("server_attachment")
+
+	resVolume := &volume.RData{
+		Name:         "volume-attachment-identity",
+		Size:         10,
+		LocationName: fmt.Sprintf("${%s.location}", resServer.TFID()),
+	}
+	resVolume.SetRName("volume-attachment")
+
+	res := &volume.RDataAttachment{
+		VolumeID: resVolume.TFID() + ".id",
+		ServerID: resServer.TFID() + ".id",
+	}
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		CheckDestroy:             testsupport.CheckResourcesDestroyed(server.ResourceType, server.ByID(t, &s)),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_ssh_key", resSSHKey,
+					"testdata/r/hcloud_server", resServer,
+					"testdata/r/hcloud_volume", resVolume,
+					"testdata/r/hcloud_volume_attachment", res,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testsupport.CheckResourceExists(resServer.TFID(), server.ByID(t, &s)),
+					testsupport.CheckResourceExists(resVolume.TFID(), volume.ByID(t, &v)),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState(res.TFID(), tfjsonpath.New("id")),
+				},
+			},
+			{
+				// Import the Resource using its identity.
+				ResourceName:    res.TFID(),
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestAccVolumeAttachmentResource_UpgradePluginFramework(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	resSSHKey := sshkey.NewRData(t, "server-vol-upgrade")
+	resServer := &server.RData{
+		Name:    "vol-attachment-upgrade",
+		Type:    teste2e.TestServerType,
+		Image:   teste2e.TestImage,
+		SSHKeys: []string{resSSHKey.TFID() + ".id"},
+	}
+	resServer.SetRName("server_attachment")
+
+	resVolume := &volume.RData{
+		Name:         "volume-attachment-upgrade",
+		Size:         10,
+		LocationName: fmt.Sprintf("${%s.location}", resServer.TFID()),
+	}
+	resVolume.SetRName("volume-attachment")
+
+	res := &volume.RDataAttachment{
+		VolumeID: resVolume.TFID() + ".id",
+		ServerID: resServer.TFID() + ".id",
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: teste2e.PreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"hcloud": {
+						VersionConstraint: "1.66.1",
+						Source:            "hetznercloud/hcloud",
+					},
+				},
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_ssh_key", resSSHKey,
+					"testdata/r/hcloud_server", resServer,
+					"testdata/r/hcloud_volume", resVolume,
+					"testdata/r/hcloud_volume_attachment", res,
+				),
+			},
+			{
+				ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_ssh_key", resSSHKey,
+					"testdata/r/hcloud_server", resServer,
+					"testdata/r/hcloud_volume", resVolume,
+					"testdata/r/hcloud_volume_attachment", res,
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
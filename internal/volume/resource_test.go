@@ -302,3 +302,37 @@ func TestAccVolumeResource_Protection(t *testing.T) {
 		},
 	})
 }
+
+func TestAccVolumeResource_UpgradePluginFramework(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	res := VolumeRData()
+	res.Name = "volume-upgrade"
+	res.Labels = map[string]string{
+		"key": "value",
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: teste2e.PreCheck(t),
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"hcloud": {
+						VersionConstraint: "1.66.1",
+						Source:            "hetznercloud/hcloud",
+					},
+				},
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_volume", res,
+				),
+			},
+			{
+				ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_volume", res,
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,163 @@
+package volume
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
+)
+
+// modelV0 is the state of the volume resource, as stored by the SDKv2
+// implementation of the resource.
+type modelV0 struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Size             types.Int64  `tfsdk:"size"`
+	Location         types.String `tfsdk:"location"`
+	ServerID         types.Int64  `tfsdk:"server_id"`
+	Labels           types.Map    `tfsdk:"labels"`
+	LinuxDevice      types.String `tfsdk:"linux_device"`
+	Automount        types.Bool   `tfsdk:"automount"`
+	Format           types.String `tfsdk:"format"`
+	DeleteProtection types.Bool   `tfsdk:"delete_protection"`
+	Project          types.String `tfsdk:"project"`
+}
+
+func (r *Resource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":                schema.StringAttribute{Computed: true},
+					"name":              schema.StringAttribute{Required: true},
+					"size":              schema.Int64Attribute{Required: true},
+					"location":          schema.StringAttribute{Optional: true, Computed: true},
+					"server_id":         schema.Int64Attribute{Optional: true, Computed: true},
+					"labels":            schema.MapAttribute{ElementType: types.StringType, Optional: true},
+					"linux_device":      schema.StringAttribute{Computed: true},
+					"automount":         schema.BoolAttribute{Optional: true},
+					"format":            schema.StringAttribute{Optional: true},
+					"delete_protection": schema.BoolAttribute{Optional: true},
+					"project":           schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: upgradeStateV0,
+		},
+	}
+}
+
+func upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior modelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := util.ParseID(prior.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Volume ID", err.Error())
+		return
+	}
+
+	data := resourceModel{
+		model: model{
+			ID:               types.Int64Value(id),
+			Name:             prior.Name,
+			Size:             prior.Size,
+			Location:         prior.Location,
+			ServerID:         prior.ServerID,
+			Labels:           prior.Labels,
+			LinuxDevice:      prior.LinuxDevice,
+			DeleteProtection: prior.DeleteProtection,
+		},
+		Automount: prior.Automount,
+		Format:    prior.Format,
+		Project:   prior.Project,
+		Timeouts:  resourceutil.TimeoutsNull(),
+	}
+
+	// The SDKv2 stored the zero values for unset attributes.
+	if data.ServerID.IsNull() {
+		data.ServerID = types.Int64Value(0)
+	}
+	if !data.Automount.ValueBool() {
+		data.Automount = types.BoolNull()
+	}
+	if data.Format.ValueString() == "" {
+		data.Format = types.StringNull()
+	}
+	if data.Labels.IsNull() {
+		data.Labels = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+	if data.DeleteProtection.IsNull() {
+		data.DeleteProtection = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// attachmentModelV0 is the state of the volume attachment resource, as stored
+// by the SDKv2 implementation of the resource.
+type attachmentModelV0 struct {
+	ID        types.String `tfsdk:"id"`
+	VolumeID  types.Int64  `tfsdk:"volume_id"`
+	ServerID  types.Int64  `tfsdk:"server_id"`
+	Automount types.Bool   `tfsdk:"automount"`
+	Project   types.String `tfsdk:"project"`
+}
+
+func (r *AttachmentResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id":        schema.StringAttribute{Computed: true},
+					"volume_id": schema.Int64Attribute{Required: true},
+					"server_id": schema.Int64Attribute{Required: true},
+					"automount": schema.BoolAttribute{Optional: true, Computed: true},
+					"project":   schema.StringAttribute{Optional: true},
+				},
+			},
+			StateUpgrader: upgradeAttachmentStateV0,
+		},
+	}
+}
+
+func upgradeAttachmentStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior attachmentModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := util.ParseID(prior.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Volume Attachment ID", err.Error())
+		return
+	}
+
+	data := attachmentResourceModel{
+		attachmentModel: attachmentModel{
+			ID:       types.Int64Value(id),
+			VolumeID: prior.VolumeID,
+			ServerID: prior.ServerID,
+		},
+		Automount: prior.Automount,
+		Project:   prior.Project,
+		Timeouts:  resourceutil.TimeoutsNull(),
+	}
+
+	// The SDKv2 stored the zero values for unset attributes.
+	if data.Automount.IsNull() {
+		data.Automount = types.BoolValue(false)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -83,6 +83,10 @@ func getCommonDataSourceSchema(readOnly bool) map[string]schema.Attribute {
 			MarkdownDescription: "Registrar of the Zone.",
 			Computed:            true,
 		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Status of the Zone, one of `ok`, `updating` or `error`. For secondary Zones, `error` indicates that the Zone could not be transferred from its primary nameservers.",
+			Computed:            true,
+		},
 	}
 }
 
@@ -133,6 +137,7 @@ See the [Zones API documentation](https://docs.hetzner.cloud/reference/cloud#zon
 			MarkdownDescription: "Filter results using a [Label Selector](https://docs.hetzner.cloud/reference/cloud#label-selector).",
 			Optional:            true,
 		},
+		"project": datasourceutil.ProjectAttribute(),
 	})
 }
 
@@ -140,6 +145,7 @@ type dataSourceModel struct {
 	model
 
 	WithSelector types.String `tfsdk:"with_selector"`
+	Project      types.String `tfsdk:"project"`
 }
 
 func populateDataSourceModel(ctx context.Context, data *dataSourceModel, in *hcloud.Zone) diag.Diagnostics {
@@ -0,0 +1,100 @@
+package zone
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/datasourceutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+)
+
+// FileDataSourceType is the type name of the Hetzner Cloud Zone file data source.
+const FileDataSourceType = "hcloud_zone_file"
+
+var _ datasource.DataSource = (*FileDataSource)(nil)
+var _ datasource.DataSourceWithConfigure = (*FileDataSource)(nil)
+
+type FileDataSource struct {
+	client *hcloud.Client
+}
+
+func NewFileDataSource() datasource.DataSource {
+	return &FileDataSource{}
+}
+
+// Metadata should return the full name of the data source.
+func (d *FileDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = FileDataSourceType
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined DataSource type. It is separately executed for each
+// ReadDataSource RPC.
+func (d *FileDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	d.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Schema should return the schema for this data source.
+func (d *FileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = `
+Provides the Zone file of a Hetzner Cloud Zone, generated from its resource record sets (RRSets).
+
+See the [Export a Zone file API documentation](https://docs.hetzner.cloud/reference/cloud#zones-export-a-zone-file) for more details.
+`
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"zone": schema.StringAttribute{
+			MarkdownDescription: "ID or Name of the Zone.",
+			Required:            true,
+		},
+		"zonefile": schema.StringAttribute{
+			MarkdownDescription: "Zone file in BIND (RFC 1034/1035) format.",
+			Computed:            true,
+		},
+		"project": datasourceutil.ProjectAttribute(),
+	}
+}
+
+type fileDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Zone     types.String `tfsdk:"zone"`
+	Zonefile types.String `tfsdk:"zonefile"`
+	Project  types.String `tfsdk:"project"`
+}
+
+// Read is called when the provider must read data source values in
+// order to update state. Config values should be read from the
+// ReadRequest and new state values set on the ReadResponse.
+func (d *FileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data fileDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, _, err := d.client.Zone.ExportZonefile(ctx, &hcloud.Zone{Name: data.Zone.ValueString()})
+	if err != nil {
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	data.ID = data.Zone
+	data.Zonefile = types.StringValue(result.Zonefile)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -69,6 +69,7 @@ See the [Zones API documentation](https://docs.hetzner.cloud/reference/cloud#zon
 			MarkdownDescription: "Filter results using a [Label Selector](https://docs.hetzner.cloud/reference/cloud#label-selector)",
 			Optional:            true,
 		},
+		"project": datasourceutil.ProjectAttribute(),
 	}
 }
 
@@ -77,6 +78,7 @@ type dataSourceListModel struct {
 	Zones types.List   `tfsdk:"zones"`
 
 	WithSelector types.String `tfsdk:"with_selector"`
+	Project      types.String `tfsdk:"project"`
 }
 
 func populateDataSourceListModel(ctx context.Context, data *dataSourceListModel, in []*hcloud.Zone) diag.Diagnostics {
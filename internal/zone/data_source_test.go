@@ -68,6 +68,7 @@ func TestAccZoneDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr(byName.TFID(), "delete_protection", "false"),
 					resource.TestCheckResourceAttr(byName.TFID(), "authoritative_nameservers.assigned.#", "3"),
 					resource.TestMatchResourceAttr(byName.TFID(), "registrar", zone.RegistrarRegexp),
+					resource.TestCheckResourceAttr(byName.TFID(), "status", "ok"),
 
 					resource.TestCheckResourceAttr(byID.TFID(), "name", res.Name),
 					resource.TestCheckResourceAttr(byID.TFID(), "mode", "primary"),
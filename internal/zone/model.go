@@ -24,6 +24,7 @@ type model struct {
 
 	AuthoritativeNameservers types.Object `tfsdk:"authoritative_nameservers"`
 	Registrar                types.String `tfsdk:"registrar"`
+	Status                   types.String `tfsdk:"status"`
 }
 
 func (m *model) tfAttributesTypes() map[string]attr.Type {
@@ -37,6 +38,7 @@ func (m *model) tfAttributesTypes() map[string]attr.Type {
 		"primary_nameservers":       types.ListType{ElemType: (&modelPrimaryNameserver{}).tfType()},
 		"authoritative_nameservers": types.ObjectType{AttrTypes: (&modelAuthoritativeNameservers{}).tfAttributesTypes()},
 		"registrar":                 types.StringType,
+		"status":                    types.StringType,
 	}
 }
 
@@ -78,6 +80,7 @@ func (m *model) FromAPI(ctx context.Context, hc *hcloud.Zone) diag.Diagnostics {
 	}
 
 	m.Registrar = types.StringValue(string(hc.Registrar))
+	m.Status = types.StringValue(string(hc.Status))
 
 	return diags
 }
@@ -32,6 +32,7 @@ func TestModel(t *testing.T) {
 		assert.Equal(t, int64(1234), o.ID.ValueInt64())
 		assert.Equal(t, "example.com", o.Name.ValueString())
 		assert.Equal(t, "primary", o.Mode.ValueString())
+		assert.Equal(t, "ok", o.Status.ValueString())
 		labels := map[string]string{}
 		assert.Nil(t, o.Labels.ElementsAs(ctx, &labels, false))
 		assert.Equal(t, map[string]string{"key": "value"}, labels)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
 	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
 )
@@ -24,9 +26,13 @@ import (
 // ResourceType is the type name of the Hetzner Cloud Zone resource.
 const ResourceType = "hcloud_zone"
 
+// privateKeyImported marks a Zone that was just imported in the private state.
+const privateKeyImported = "imported"
+
 var _ resource.Resource = (*Resource)(nil)
 var _ resource.ResourceWithConfigure = (*Resource)(nil)
 var _ resource.ResourceWithImportState = (*Resource)(nil)
+var _ resource.ResourceWithIdentity = (*Resource)(nil)
 var _ resource.ResourceWithValidateConfig = (*Resource)(nil)
 
 type Resource struct {
@@ -55,7 +61,7 @@ func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, r
 	}
 }
 
-func (r *Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *Resource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema.MarkdownDescription = `
 Provides a Hetzner Cloud Zone resource.
 
@@ -145,11 +151,30 @@ See the [Zones API documentation](https://docs.hetzner.cloud/reference/cloud#zon
 			MarkdownDescription: "Registrar of the Zone.",
 			Computed:            true,
 		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Status of the Zone, one of `ok`, `updating` or `error`. For secondary Zones, `error` indicates that the Zone could not be transferred from its primary nameservers.",
+			Computed:            true,
+		},
+		"zonefile": schema.StringAttribute{
+			MarkdownDescription: util.MarkdownDescription(`
+Zone file in BIND (RFC 1034/1035) format, used to populate the resource record sets (RRSets) of the Zone. Forbidden when mode is secondary.
+
+The Zone file is imported when the Zone is created, and imported again when it changes, replacing all the RRSets of the Zone. A changed Zone file containing the same records, ignoring the SOA and apex NS records managed by the API, is not imported again. The RRSets changed outside of the Zone file are not detected, and the Zone file should not be used together with the ''hcloud_zone_rrset'' resources of the Zone.
+
+When importing a primary Zone, the Zone file exported from the API is stored in the state.
+`),
+			Optional: true,
+		},
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
 	}
 }
 
 func (r *Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
-	var data model
+	var data resourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -173,17 +198,45 @@ func (r *Resource) ValidateConfig(ctx context.Context, req resource.ValidateConf
 				"This attribute is required when mode is secondary.",
 			)
 		}
+		if !data.Zonefile.IsUnknown() && !data.Zonefile.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("zonefile"),
+				"Forbidden attribute",
+				"This attribute is forbidden when mode is secondary.",
+			)
+		}
 	}
 }
 
+func (r *Resource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceutil.IDIdentitySchema("ID of the Zone.")
+}
+
+type resourceModel struct {
+	model
+
+	Zonefile types.String   `tfsdk:"zonefile"`
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data model
+	var data resourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
+
 	opts := hcloud.ZoneCreateOpts{
 		Name: data.Name.ValueString(),
 		Mode: hcloud.ZoneMode(data.Mode.ValueString()),
@@ -194,6 +247,10 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 
 	resp.Diagnostics.Append(hcloudutil.TerraformLabelsToHCloud(ctx, data.Labels, &opts.Labels)...)
 
+	if !data.Zonefile.IsUnknown() && !data.Zonefile.IsNull() {
+		opts.Zonefile = data.Zonefile.ValueString()
+	}
+
 	if !data.PrimaryNameservers.IsUnknown() && !data.PrimaryNameservers.IsNull() {
 		m := modelPrimaryNameservers{}
 		resp.Diagnostics.Append(m.FromTerraform(ctx, data.PrimaryNameservers)...)
@@ -259,10 +316,11 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data model
+	var data resourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -285,11 +343,30 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		return
 	}
 
+	// Store the exported zone file after an import, an equivalent configured zone
+	// file must not be imported again.
+	imported, diags := req.Private.GetKey(ctx, privateKeyImported)
+	resp.Diagnostics.Append(diags...)
+	if imported != nil {
+		if in.Mode == hcloud.ZoneModePrimary {
+			result, _, err := r.client.Zone.ExportZonefile(ctx, in)
+			if err != nil {
+				resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+				return
+			}
+
+			data.Zonefile = types.StringValue(result.Zonefile)
+		}
+
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyImported, nil)...)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
 func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data, plan model
+	var data, plan resourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -297,6 +374,15 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "update", updateTimeout)
+	defer cancel()
+
 	zone := &hcloud.Zone{ID: data.ID.ValueInt64()}
 	actions := make([]*hcloud.Action, 0)
 
@@ -358,6 +444,21 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		actions = append(actions, action)
 	}
 
+	// Removing the zone file keeps the current RRSets of the zone. A zone file
+	// containing the same records as the prior zone file is not imported again.
+	if !plan.Zonefile.IsUnknown() && !plan.Zonefile.IsNull() && !plan.Zonefile.Equal(data.Zonefile) &&
+		(data.Zonefile.IsNull() || !zonefilesEquivalent(data.Zonefile.ValueString(), plan.Zonefile.ValueString(), data.Name.ValueString())) {
+		action, _, err := r.client.Zone.ImportZonefile(ctx, zone, hcloud.ZoneImportZonefileOpts{
+			Zonefile: plan.Zonefile.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
+		}
+
+		actions = append(actions, action)
+	}
+
 	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, actions...)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -386,17 +487,30 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
+	data.Zonefile = plan.Zonefile
+	data.Timeouts = plan.Timeouts
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, resourceutil.NewIDIdentity(data.ID))...)
 }
 
 func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data model
+	var data resourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
+
 	result, _, err := r.client.Zone.Delete(ctx, &hcloud.Zone{ID: data.ID.ValueInt64()})
 	if err != nil {
 		if hcloudutil.APIErrorIsNotFound(err) {
@@ -414,6 +528,15 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 }
 
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, privateKeyImported, []byte("true"))...)
+
+	if req.ID == "" {
+		var identity resourceutil.IDIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.ID)...)
+		return
+	}
+
 	if id, err := strconv.ParseInt(req.ID, 10, 64); err == nil {
 		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 		return
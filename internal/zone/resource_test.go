@@ -2,6 +2,7 @@ package zone_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -62,6 +63,7 @@ func TestAccZoneResource_Primary(t *testing.T) {
 					resource.TestCheckResourceAttr(res1.TFID(), "delete_protection", "true"),
 					resource.TestCheckResourceAttr(res1.TFID(), "authoritative_nameservers.assigned.#", "3"),
 					resource.TestMatchResourceAttr(res1.TFID(), "registrar", zone.RegistrarRegexp),
+					resource.TestCheckResourceAttr(res1.TFID(), "status", "ok"),
 				),
 			},
 			{
@@ -102,6 +104,83 @@ func TestAccZoneResource_Primary(t *testing.T) {
 	})
 }
 
+func TestAccZoneResource_Zonefile(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	res1 := &zone.RData{
+		Zone: schema.Zone{
+			Name: fmt.Sprintf("example-%s.com", randutil.GenerateID()),
+			Mode: "primary",
+		},
+	}
+	res1.SetRName("main")
+	res1.Raw = fmt.Sprintf(`zonefile = <<-EOT
+  $ORIGIN %s.
+  $TTL 3600
+  www IN A 201.42.91.35
+EOT`, res1.Name)
+
+	res2 := &zone.RData{
+		Zone: res1.Zone,
+	}
+	res2.SetRName("main")
+	res2.Raw = fmt.Sprintf(`zonefile = <<-EOT
+  $ORIGIN %s.
+  $TTL 3600
+  api IN A 201.42.91.36
+EOT`, res1.Name)
+
+	// Reference the ID, to read the data source after the zone file is imported.
+	file := &zone.DDataFile{
+		Zone: res1.TFID() + ".id",
+	}
+	file.SetRName("main")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		CheckDestroy:             testsupport.CheckAPIResourceAllAbsent(zone.ResourceType, zone.GetAPIResource()),
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_zone", res1,
+					"testdata/d/hcloud_zone_file", file,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testsupport.CheckAPIResourcePresent(res1.TFID(), zone.GetAPIResource()),
+					resource.TestCheckResourceAttrPair(file.TFID(), "id", res1.TFID(), "id"),
+					resource.TestMatchResourceAttr(file.TFID(), "zonefile", regexp.MustCompile(`www\s+.*A\s+201\.42\.91\.35`)),
+				),
+			},
+			{
+				ResourceName:            res1.TFID(),
+				ImportStateId:           res1.Name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zonefile"},
+			},
+			{
+				Config: tmplMan.Render(t, "testdata/r/hcloud_zone", res2),
+			},
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_zone", res2,
+					"testdata/d/hcloud_zone_file", file,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(file.TFID(), "zonefile", regexp.MustCompile(`api\s+.*A\s+201\.42\.91\.36`)),
+					resource.TestCheckResourceAttrWith(file.TFID(), "zonefile", func(value string) error {
+						if regexp.MustCompile(`www\s+.*A`).MatchString(value) {
+							return fmt.Errorf("expected the www record to be replaced by the zone file import")
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccZoneResource_Secondary(t *testing.T) {
 	tmplMan := testtemplate.Manager{}
 
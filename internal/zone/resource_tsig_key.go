@@ -0,0 +1,159 @@
+package zone
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+)
+
+// TSIGKeyResourceType is the type name of the resource generating a TSIG key
+// for the primary nameservers of a secondary Zone.
+const TSIGKeyResourceType = "hcloud_zone_tsig_key"
+
+var _ resource.Resource = (*TSIGKeyResource)(nil)
+
+type TSIGKeyResource struct{}
+
+func NewTSIGKeyResource() resource.Resource {
+	return &TSIGKeyResource{}
+}
+
+// tsigKeySizes are the sizes in bytes of the generated TSIG keys, matching the
+// output size of the hash function of the algorithm.
+var tsigKeySizes = map[string]int{
+	hcloud.ZoneTSIGAlgorithmHMACMD5:    16,
+	hcloud.ZoneTSIGAlgorithmHMACSHA1:   20,
+	hcloud.ZoneTSIGAlgorithmHMACSHA256: 32,
+}
+
+// Metadata should return the full name of the resource.
+func (r *TSIGKeyResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = TSIGKeyResourceType
+}
+
+func (r *TSIGKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = util.MarkdownDescription(`
+Generates a random transaction signature (TSIG) key, to authenticate the zone transfers from the primary nameservers of a secondary Zone.
+
+The key is generated locally, without any request to the Hetzner Cloud API, and is kept in the Terraform state until the resource is replaced.
+The same key must be configured on the primary nameservers and in the ''primary_nameservers'' of the ''hcloud_zone'' resource.
+`)
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Random ID of the TSIG key.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"algorithm": schema.StringAttribute{
+			MarkdownDescription: "Transaction signature (TSIG) algorithm of the key, one of `hmac-md5`, `hmac-sha1` or `hmac-sha256`.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					hcloud.ZoneTSIGAlgorithmHMACMD5,
+					hcloud.ZoneTSIGAlgorithmHMACSHA1,
+					hcloud.ZoneTSIGAlgorithmHMACSHA256,
+				),
+			},
+		},
+		"key": schema.StringAttribute{
+			MarkdownDescription: "Base64 encoded secret of the TSIG key.",
+			Computed:            true,
+			Sensitive:           true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+type tsigKeyResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Algorithm types.String `tfsdk:"algorithm"`
+	Key       types.String `tfsdk:"key"`
+}
+
+// generateTSIGKey returns a random base64 encoded TSIG key for the algorithm.
+func generateTSIGKey(algorithm string) (string, error) {
+	size, ok := tsigKeySizes[algorithm]
+	if !ok {
+		return "", fmt.Errorf("unsupported TSIG algorithm %q", algorithm)
+	}
+
+	key := make([]byte, size)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+func (r *TSIGKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data tsigKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := generateTSIGKey(data.Algorithm.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to generate TSIG key", err.Error())
+		return
+	}
+
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		resp.Diagnostics.AddError("Failed to generate TSIG key", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(hex.EncodeToString(id))
+	data.Key = types.StringValue(key)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TSIGKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data tsigKeyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TSIGKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data tsigKeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the key from the state, as it only exists locally.
+func (r *TSIGKeyResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
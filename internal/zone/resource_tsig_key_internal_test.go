@@ -0,0 +1,29 @@
+package zone
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTSIGKey(t *testing.T) {
+	for algorithm, size := range tsigKeySizes {
+		t.Run(algorithm, func(t *testing.T) {
+			key, err := generateTSIGKey(algorithm)
+			require.NoError(t, err)
+
+			decoded, err := base64.StdEncoding.DecodeString(key)
+			require.NoError(t, err)
+			assert.Len(t, decoded, size)
+
+			other, err := generateTSIGKey(algorithm)
+			require.NoError(t, err)
+			assert.NotEqual(t, key, other)
+		})
+	}
+
+	_, err := generateTSIGKey("hmac-sha512")
+	assert.EqualError(t, err, `unsupported TSIG algorithm "hmac-sha512"`)
+}
@@ -0,0 +1,55 @@
+package zone_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testmux"
+)
+
+func TestZoneTSIGKeyResource(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "hcloud_zone_tsig_key" "main" {
+					algorithm = "hmac-sha256"
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("hcloud_zone_tsig_key.main", tfjsonpath.New("algorithm"), knownvalue.StringExact("hmac-sha256")),
+					statecheck.ExpectKnownValue("hcloud_zone_tsig_key.main", tfjsonpath.New("key"), knownvalue.StringRegexp(regexp.MustCompile(`^[A-Za-z0-9+/]{43}=$`))),
+				},
+			},
+			{
+				Config: `
+				resource "hcloud_zone_tsig_key" "main" {
+					algorithm = "hmac-sha1"
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("hcloud_zone_tsig_key.main", plancheck.ResourceActionReplace),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("hcloud_zone_tsig_key.main", tfjsonpath.New("key"), knownvalue.StringRegexp(regexp.MustCompile(`^[A-Za-z0-9+/]{27}=$`))),
+				},
+			},
+			{
+				Config: `
+				resource "hcloud_zone_tsig_key" "main" {
+					algorithm = "hmac-sha512"
+				}`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
@@ -39,6 +39,18 @@ func (d *DData) TFID() string {
 	return fmt.Sprintf("data.%s.%s", DataSourceType, d.RName())
 }
 
+// DDataFile defines the fields for the "testdata/d/hcloud_zone_file" template.
+type DDataFile struct {
+	testtemplate.DataCommon
+
+	Zone string
+}
+
+// TFID returns the data source identifier.
+func (d *DDataFile) TFID() string {
+	return fmt.Sprintf("data.%s.%s", FileDataSourceType, d.RName())
+}
+
 // DDataList defines the fields for the "testdata/d/hcloud_zones" template.
 type DDataList struct {
 	testtemplate.DataCommon
@@ -0,0 +1,184 @@
+package zone
+
+import (
+	"strings"
+)
+
+// zonefileRecords returns the records of a zone file in BIND (RFC 1034/1035)
+// format, as a map of "owner type data" to the TTL of the record. The TTL is
+// empty when it is not defined in the zone file.
+//
+// The SOA and apex NS records are managed by the API and are skipped. Only the
+// syntax used by the exported zone files is supported, the records of other
+// zone files may not be recognized, which only results in a new import.
+func zonefileRecords(zonefile, origin string) map[string]string {
+	origin = strings.ToLower(strings.TrimSuffix(origin, ".")) + "."
+
+	records := make(map[string]string)
+
+	ttl := ""
+	owner := origin
+
+	var entry []string
+	var continued bool
+	var blankOwner bool
+
+	depth := 0
+	for _, line := range strings.Split(zonefile, "\n") {
+		if !continued {
+			blankOwner = line != "" && (line[0] == ' ' || line[0] == '\t')
+		}
+
+		tokens, delta := zonefileTokens(line)
+		entry = append(entry, tokens...)
+		depth += delta
+		continued = depth > 0
+		if continued || len(entry) == 0 {
+			continue
+		}
+		depth = 0
+
+		fields := entry
+		entry = nil
+
+		switch strings.ToUpper(fields[0]) {
+		case "$ORIGIN":
+			if len(fields) > 1 {
+				origin = absoluteName(fields[1], origin)
+			}
+			continue
+		case "$TTL":
+			if len(fields) > 1 {
+				ttl = fields[1]
+			}
+			continue
+		}
+
+		if !blankOwner {
+			owner = absoluteName(fields[0], origin)
+			fields = fields[1:]
+		}
+
+		recordTTL := ttl
+		for len(fields) > 0 {
+			switch {
+			case isClass(fields[0]):
+				fields = fields[1:]
+				continue
+			case isTTL(fields[0]):
+				recordTTL = fields[0]
+				fields = fields[1:]
+				continue
+			}
+			break
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		recordType := strings.ToUpper(fields[0])
+		if recordType == "SOA" || (recordType == "NS" && owner == origin) {
+			continue
+		}
+
+		records[owner+" "+recordType+" "+strings.Join(fields[1:], " ")] = recordTTL
+	}
+
+	return records
+}
+
+// zonefileTokens returns the tokens of a zone file line, without the comment
+// and the parentheses, and the difference between the opened and the closed
+// parentheses. Quoted strings are returned as a single token.
+func zonefileTokens(line string) ([]string, int) {
+	var tokens []string
+	var token strings.Builder
+	var quoted, escaped bool
+	delta := 0
+
+	flush := func() {
+		if token.Len() > 0 {
+			tokens = append(tokens, token.String())
+			token.Reset()
+		}
+	}
+
+	for _, r := range line {
+		switch {
+		case escaped:
+			escaped = false
+			token.WriteRune(r)
+		case r == '\\':
+			escaped = true
+			token.WriteRune(r)
+		case r == '"':
+			quoted = !quoted
+			token.WriteRune(r)
+		case quoted:
+			token.WriteRune(r)
+		case r == ';':
+			flush()
+			return tokens, delta
+		case r == '(':
+			delta++
+			flush()
+		case r == ')':
+			delta--
+			flush()
+		case r == ' ' || r == '\t' || r == '\r':
+			flush()
+		default:
+			token.WriteRune(r)
+		}
+	}
+	flush()
+
+	return tokens, delta
+}
+
+// zonefilesEquivalent returns whether both zone files contain the same records.
+// The TTL of the records are only compared when defined in both zone files.
+func zonefilesEquivalent(a, b, origin string) bool {
+	recordsA := zonefileRecords(a, origin)
+	recordsB := zonefileRecords(b, origin)
+
+	if len(recordsA) != len(recordsB) {
+		return false
+	}
+	for key, ttlA := range recordsA {
+		ttlB, ok := recordsB[key]
+		if !ok {
+			return false
+		}
+		if ttlA != "" && ttlB != "" && ttlA != ttlB {
+			return false
+		}
+	}
+	return true
+}
+
+// absoluteName returns the lower case absolute domain name of a name relative
+// to the origin.
+func absoluteName(name, origin string) string {
+	name = strings.ToLower(name)
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + origin
+	}
+}
+
+func isClass(value string) bool {
+	switch strings.ToUpper(value) {
+	case "IN", "CH", "HS", "CS":
+		return true
+	}
+	return false
+}
+
+func isTTL(value string) bool {
+	return value != "" && strings.Trim(value, "0123456789") == ""
+}
@@ -0,0 +1,108 @@
+package zone
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZonefileRecords(t *testing.T) {
+	zonefile := `$ORIGIN example.com.
+$TTL 3600
+@	IN	SOA	hydrogen.ns.hetzner.com. dns.hetzner.com. (
+		2024010101 ; serial
+		86400 10800 3600000 3600 )
+@	IN	NS	hydrogen.ns.hetzner.com.
+@	IN	MX	10 mail ; comment
+www	300	IN	A	201.42.91.35
+	IN	AAAA	2001:db8::1
+sub.example.com.	IN	NS	ns1.example.net.
+@	IN	TXT	"v=DKIM1; k=rsa; p=(abc)"
+`
+
+	assert.Equal(t, map[string]string{
+		"example.com. MX 10 mail":                    "3600",
+		"www.example.com. A 201.42.91.35":            "300",
+		"www.example.com. AAAA 2001:db8::1":          "3600",
+		"sub.example.com. NS ns1.example.net.":       "3600",
+		`example.com. TXT "v=DKIM1; k=rsa; p=(abc)"`: "3600",
+	}, zonefileRecords(zonefile, "example.com"))
+}
+
+func TestZonefilesEquivalent(t *testing.T) {
+	exported := `$ORIGIN example.com.
+$TTL 3600
+@	IN	SOA	hydrogen.ns.hetzner.com. dns.hetzner.com. 2024010101 86400 10800 3600000 3600
+@	IN	NS	hydrogen.ns.hetzner.com.
+@	IN	NS	oxygen.ns.hetzner.com.
+www	3600	IN	A	201.42.91.35
+@	3600	IN	TXT	"v=DKIM1; k=rsa; p=abc"
+`
+
+	for _, tc := range []struct {
+		name     string
+		zonefile string
+		want     bool
+	}{
+		{
+			name:     "identical",
+			zonefile: exported,
+			want:     true,
+		},
+		{
+			name: "user zone file",
+			zonefile: `$ORIGIN example.com.
+$TTL 3600
+www IN A 201.42.91.35 ; web server
+example.com. IN TXT "v=DKIM1; k=rsa; p=abc"
+`,
+			want: true,
+		},
+		{
+			name: "without origin",
+			zonefile: `www.example.com. 3600 IN A 201.42.91.35
+@ IN TXT ( "v=DKIM1; k=rsa; p=abc" )
+`,
+			want: true,
+		},
+		{
+			name: "different record",
+			zonefile: `$ORIGIN example.com.
+$TTL 3600
+www IN A 201.42.91.36
+@ IN TXT "v=DKIM1; k=rsa; p=abc"
+`,
+			want: false,
+		},
+		{
+			name: "different quoted value",
+			zonefile: `$ORIGIN example.com.
+$TTL 3600
+www IN A 201.42.91.35
+@ IN TXT "v=DKIM1; k=rsa; p=def"
+`,
+			want: false,
+		},
+		{
+			name: "different ttl",
+			zonefile: `$ORIGIN example.com.
+$TTL 300
+www IN A 201.42.91.35
+@ IN TXT "v=DKIM1; k=rsa; p=abc"
+`,
+			want: false,
+		},
+		{
+			name: "missing record",
+			zonefile: `$ORIGIN example.com.
+$TTL 3600
+www IN A 201.42.91.35
+`,
+			want: false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, zonefilesEquivalent(exported, tc.zonefile, "example.com"))
+		})
+	}
+}
@@ -3,11 +3,13 @@ package zonerecord
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
 )
 
 type model struct {
@@ -16,15 +18,20 @@ type model struct {
 	Type    types.String `tfsdk:"type"`
 	Value   types.String `tfsdk:"value"`
 	Comment types.String `tfsdk:"comment"`
+
+	Project  types.String   `tfsdk:"project"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m *model) tfAttributesTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"zone":    types.StringType,
-		"name":    types.StringType,
-		"type":    types.StringType,
-		"value":   types.StringType,
-		"comment": types.StringType,
+		"zone":     types.StringType,
+		"name":     types.StringType,
+		"type":     types.StringType,
+		"value":    types.StringType,
+		"comment":  types.StringType,
+		"project":  types.StringType,
+		"timeouts": resourceutil.TimeoutsType(),
 	}
 }
 
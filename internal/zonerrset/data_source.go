@@ -115,6 +115,7 @@ See the [Zone RRSets API documentation](https://docs.hetzner.cloud/reference/clo
 			MarkdownDescription: "Filter results using a [Label Selector](https://docs.hetzner.cloud/reference/cloud#label-selector).",
 			Optional:            true,
 		},
+		"project": datasourceutil.ProjectAttribute(),
 	})
 }
 
@@ -122,6 +123,7 @@ type dataSourceModel struct {
 	model
 
 	WithSelector types.String `tfsdk:"with_selector"`
+	Project      types.String `tfsdk:"project"`
 }
 
 func populateDataSourceModel(ctx context.Context, data *dataSourceModel, in *hcloud.ZoneRRSet) diag.Diagnostics {
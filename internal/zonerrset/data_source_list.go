@@ -72,6 +72,7 @@ See the [Zone RRSets API documentation](https://docs.hetzner.cloud/reference/clo
 			MarkdownDescription: "Filter results using a [Label Selector](https://docs.hetzner.cloud/reference/cloud#label-selector)",
 			Optional:            true,
 		},
+		"project": datasourceutil.ProjectAttribute(),
 	}
 }
 
@@ -82,6 +83,7 @@ type dataSourceListModel struct {
 	RRSets types.List   `tfsdk:"rrsets"`
 
 	WithSelector types.String `tfsdk:"with_selector"`
+	Project      types.String `tfsdk:"project"`
 }
 
 func populateDataSourceListModel(ctx context.Context, data *dataSourceListModel, in []*hcloud.ZoneRRSet) diag.Diagnostics {
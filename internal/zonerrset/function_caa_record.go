@@ -0,0 +1,61 @@
+package zonerrset
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+)
+
+func NewCAARecordFunction() function.Function {
+	return &CAARecordFunction{}
+}
+
+type CAARecordFunction struct{}
+
+func (f *CAARecordFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "caa_record"
+}
+
+func (f *CAARecordFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Format a CAA record",
+		MarkdownDescription: util.MarkdownDescription(`
+Format a Certification Authority Authorization (CAA) record value, quoting and escaping the value.
+`),
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        "flags",
+				Description: "Flags of the record, between 0 and 255.",
+			},
+			function.StringParameter{
+				Name:        "tag",
+				Description: "Tag of the record, for example `issue`, `issuewild` or `iodef`.",
+			},
+			function.StringParameter{
+				Name:        "value",
+				Description: "Value of the record, for example `letsencrypt.org`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CAARecordFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var flags int64
+	var tag, value string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &flags, &tag, &value))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := formatCAARecord(flags, tag, value)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
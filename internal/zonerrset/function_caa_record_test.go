@@ -0,0 +1,42 @@
+package zonerrset_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testmux"
+)
+
+func TestCAARecordFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::hcloud::caa_record(0, "issue", "letsencrypt.org")
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact(`0 issue "letsencrypt.org"`)),
+				},
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::hcloud::caa_record(256, "issue", "letsencrypt.org")
+				}`,
+				ExpectError: regexp.MustCompile(`invalid flags 256`),
+			},
+		},
+	})
+}
@@ -0,0 +1,58 @@
+package zonerrset
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+)
+
+func NewDKIMRecordFunction() function.Function {
+	return &DKIMRecordFunction{}
+}
+
+type DKIMRecordFunction struct{}
+
+func (f *DKIMRecordFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dkim_record"
+}
+
+func (f *DKIMRecordFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Format a DKIM record",
+		MarkdownDescription: util.MarkdownDescription(`
+Format a DomainKeys Identified Mail (DKIM) TXT record from a public key.
+
+The public key may be PEM encoded, the PEM header, footer and line breaks are removed. The record is quoted and split in strings of 255 characters, like the ''provider::hcloud::txt_record'' function.
+`),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "key_type",
+				Description: "Type of the public key, one of `rsa` or `ed25519`.",
+			},
+			function.StringParameter{
+				Name:        "public_key",
+				Description: "Base64 or PEM encoded public key.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DKIMRecordFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var keyType, publicKey string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &keyType, &publicKey))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := formatDKIMRecord(keyType, publicKey)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,61 @@
+package zonerrset
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+)
+
+func NewDMARCRecordFunction() function.Function {
+	return &DMARCRecordFunction{}
+}
+
+type DMARCRecordFunction struct{}
+
+func (f *DMARCRecordFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dmarc_record"
+}
+
+func (f *DMARCRecordFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Format a DMARC record",
+		MarkdownDescription: util.MarkdownDescription(`
+Format a Domain-based Message Authentication, Reporting and Conformance (DMARC) TXT record from a policy and additional tags.
+
+The tags are sorted by name. The record is quoted and split in strings of 255 characters, like the ''provider::hcloud::txt_record'' function.
+`),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "policy",
+				Description: "Policy of the domain, one of `none`, `quarantine` or `reject`.",
+			},
+			function.MapParameter{
+				Name:        "tags",
+				ElementType: types.StringType,
+				Description: "Additional tags of the policy, for example `{ rua = \"mailto:dmarc@example.com\" }`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DMARCRecordFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var policy string
+	var tags map[string]string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &policy, &tags))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := formatDMARCRecord(policy, tags)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
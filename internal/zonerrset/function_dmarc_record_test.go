@@ -0,0 +1,42 @@
+package zonerrset_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testmux"
+)
+
+func TestDMARCRecordFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				output "test" {
+					value = provider::hcloud::dmarc_record("quarantine", { rua = "mailto:dmarc@example.com", pct = "50" })
+				}`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact(`"v=DMARC1; p=quarantine; pct=50; rua=mailto:dmarc@example.com"`)),
+				},
+			},
+			{
+				Config: `
+				output "test" {
+					value = provider::hcloud::dmarc_record("deny", {})
+				}`,
+				ExpectError: regexp.MustCompile(`invalid policy "deny"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package zonerrset
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+)
+
+func NewFQDNFunction() function.Function {
+	return &FQDNFunction{}
+}
+
+type FQDNFunction struct{}
+
+func (f *FQDNFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "fqdn"
+}
+
+func (f *FQDNFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Return the fully qualified domain name of a name",
+		MarkdownDescription: util.MarkdownDescription(`
+Return the fully qualified domain name, with the trailing dot, of a name relative to a zone.
+
+The name ''@'' refers to the apex of the zone. Names ending with a dot are already fully qualified and returned unchanged.
+`),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "Name relative to the zone, for example `www` or `@`.",
+			},
+			function.StringParameter{
+				Name:        "zone",
+				Description: "Name of the zone, for example `example.com`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FQDNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, zone string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name, &zone))
+	if resp.Error != nil {
+		return
+	}
+
+	result := fqdn(name, zone)
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
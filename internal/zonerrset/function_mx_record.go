@@ -0,0 +1,57 @@
+package zonerrset
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+)
+
+func NewMXRecordFunction() function.Function {
+	return &MXRecordFunction{}
+}
+
+type MXRecordFunction struct{}
+
+func (f *MXRecordFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "mx_record"
+}
+
+func (f *MXRecordFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Format a MX record",
+		MarkdownDescription: util.MarkdownDescription(`
+Format a Mail Exchange (MX) record value. The exchange is made fully qualified by appending the trailing dot, if missing.
+`),
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        "priority",
+				Description: "Priority of the exchange, between 0 and 65535.",
+			},
+			function.StringParameter{
+				Name:        "exchange",
+				Description: "Domain name of the mail server.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *MXRecordFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var priority int64
+	var exchange string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &priority, &exchange))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := formatMXRecord(priority, exchange)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,51 @@
+package zonerrset
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/zoneutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+)
+
+func NewParseTXTRecordFunction() function.Function {
+	return &ParseTXTRecordFunction{}
+}
+
+type ParseTXTRecordFunction struct{}
+
+func (f *ParseTXTRecordFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_txt_record"
+}
+
+func (f *ParseTXTRecordFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a TXT record",
+		MarkdownDescription: util.MarkdownDescription(`
+Parse a TXT record by joining its quoted strings, reverse of the ''provider::hcloud::txt_record'' function.
+
+Unquoted values are returned unchanged.
+`),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "record",
+				Description: "Record value to parse.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ParseTXTRecordFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var record string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &record))
+	if resp.Error != nil {
+		return
+	}
+
+	result := zoneutil.ParseTXTRecord(record)
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,58 @@
+package zonerrset
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+)
+
+func NewRelativeNameFunction() function.Function {
+	return &RelativeNameFunction{}
+}
+
+type RelativeNameFunction struct{}
+
+func (f *RelativeNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "relative_name"
+}
+
+func (f *RelativeNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Return the name relative to a zone",
+		MarkdownDescription: util.MarkdownDescription(`
+Return the name relative to a zone, as used by the ''name'' of the ''hcloud_zone_rrset'' resource.
+
+The apex of the zone is returned as ''@''. The name may be fully qualified, with or without the trailing dot, and must be part of the zone.
+`),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "name",
+				Description: "Domain name, for example `www.example.com.`.",
+			},
+			function.StringParameter{
+				Name:        "zone",
+				Description: "Name of the zone, for example `example.com`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *RelativeNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name, zone string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &name, &zone))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := relativeName(name, zone)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,61 @@
+package zonerrset
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+)
+
+func NewSPFRecordFunction() function.Function {
+	return &SPFRecordFunction{}
+}
+
+type SPFRecordFunction struct{}
+
+func (f *SPFRecordFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "spf_record"
+}
+
+func (f *SPFRecordFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Format a SPF record",
+		MarkdownDescription: util.MarkdownDescription(`
+Format a Sender Policy Framework (SPF) TXT record from a list of mechanisms, terminated by the ''all'' mechanism.
+
+The record is quoted and split in strings of 255 characters, like the ''provider::hcloud::txt_record'' function.
+`),
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:        "mechanisms",
+				ElementType: types.StringType,
+				Description: "Mechanisms of the policy, for example `include:_spf.example.com` or `ip4:203.0.113.0/24`.",
+			},
+			function.StringParameter{
+				Name:        "all",
+				Description: "Qualifier of the `all` mechanism, one of `-`, `~`, `?` or `+`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SPFRecordFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var mechanisms []string
+	var all string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &mechanisms, &all))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := formatSPFRecord(mechanisms, all)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
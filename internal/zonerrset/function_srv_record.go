@@ -0,0 +1,65 @@
+package zonerrset
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+)
+
+func NewSRVRecordFunction() function.Function {
+	return &SRVRecordFunction{}
+}
+
+type SRVRecordFunction struct{}
+
+func (f *SRVRecordFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "srv_record"
+}
+
+func (f *SRVRecordFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Format a SRV record",
+		MarkdownDescription: util.MarkdownDescription(`
+Format a Service (SRV) record value. The target is made fully qualified by appending the trailing dot, if missing.
+`),
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:        "priority",
+				Description: "Priority of the target, between 0 and 65535.",
+			},
+			function.Int64Parameter{
+				Name:        "weight",
+				Description: "Weight of the target, between 0 and 65535.",
+			},
+			function.Int64Parameter{
+				Name:        "port",
+				Description: "Port of the service, between 0 and 65535.",
+			},
+			function.StringParameter{
+				Name:        "target",
+				Description: "Domain name of the target.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SRVRecordFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var priority, weight, port int64
+	var target string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &priority, &weight, &port, &target))
+	if resp.Error != nil {
+		return
+	}
+
+	result, err := formatSRVRecord(priority, weight, port, target)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,110 @@
+package zonerrset
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/listresourceutil"
+)
+
+var _ list.ListResource = (*ListResource)(nil)
+var _ list.ListResourceWithConfigure = (*ListResource)(nil)
+
+type ListResource struct {
+	client *hcloud.Client
+}
+
+func NewListResource() list.ListResource {
+	return &ListResource{}
+}
+
+func (r *ListResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = ResourceType
+}
+
+func (r *ListResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	r.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+}
+
+func (r *ListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the Hetzner Cloud Zone Resource Record Sets (RRSets) of a Zone.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "ID or Name of the parent Zone.",
+				Required:            true,
+			},
+			"with_selector": listresourceutil.WithSelectorAttribute(),
+			"project":       listresourceutil.ProjectAttribute(),
+		},
+	}
+}
+
+type listResourceModel struct {
+	Zone         types.String `tfsdk:"zone"`
+	WithSelector types.String `tfsdk:"with_selector"`
+	Project      types.String `tfsdk:"project"`
+}
+
+func (r *ListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data listResourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	zone := &hcloud.Zone{Name: data.Zone.ValueString()}
+
+	opts := hcloud.ZoneRRSetListOpts{}
+	opts.LabelSelector = data.WithSelector.ValueString()
+
+	result, err := r.client.Zone.AllRRSetsWithOpts(ctx, zone, opts)
+	if err != nil {
+		stream.Results = list.ListResultsStreamDiagnostics(hcloudutil.APIErrorDiagnostics(err))
+		return
+	}
+
+	stream.Results = listresourceutil.Results(ctx, req, result, func(ctx context.Context, in *hcloud.ZoneRRSet, item *list.ListResult) {
+		OverrideRecordsSOASerial(in)
+
+		item.DisplayName = in.ID
+		item.Diagnostics.Append(item.Identity.Set(ctx, identityModel{
+			Zone: data.Zone,
+			Name: types.StringValue(in.Name),
+			Type: types.StringValue(string(in.Type)),
+		})...)
+
+		if !req.IncludeResource {
+			return
+		}
+
+		var rrset resourceModel
+
+		item.Diagnostics.Append(item.Resource.SetAttribute(ctx, path.Root("zone"), data.Zone)...)
+		item.Diagnostics.Append(item.Resource.SetAttribute(ctx, path.Root("id"), in.ID)...)
+		item.Diagnostics.Append(item.Resource.Get(ctx, &rrset)...)
+		if item.Diagnostics.HasError() {
+			return
+		}
+
+		item.Diagnostics.Append(rrset.FromAPI(ctx, in)...)
+		if item.Diagnostics.HasError() {
+			return
+		}
+
+		item.Diagnostics.Append(item.Resource.Set(ctx, &rrset)...)
+	})
+}
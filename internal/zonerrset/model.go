@@ -179,3 +179,77 @@ func (m *modelRecords) ToTerraform(ctx context.Context) (tf types.Set, diags dia
 
 	return tf, diags
 }
+
+type identityModel struct {
+	Zone types.String `tfsdk:"zone"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+func newIdentity(m model) identityModel {
+	return identityModel{
+		Zone: m.Zone,
+		Name: m.Name,
+		Type: m.Type,
+	}
+}
+
+// rrsetID returns the ID of the Zone RRSet identified by the identity.
+func (i *identityModel) rrsetID() string {
+	return i.Name.ValueString() + "/" + i.Type.ValueString()
+}
+
+type bulkIdentityModel struct {
+	Zone types.String `tfsdk:"zone"`
+}
+
+// bulkRRSetModel is a Zone RRSet managed by the bulk resource, identified by
+// its key in the map of RRSets.
+type bulkRRSetModel struct {
+	TTL     types.Int32 `tfsdk:"ttl"`
+	Labels  types.Map   `tfsdk:"labels"`
+	Records types.Set   `tfsdk:"records"`
+}
+
+var _ util.ModelFromAPI[*hcloud.ZoneRRSet] = &bulkRRSetModel{}
+var _ util.ModelToTerraform[types.Object] = &bulkRRSetModel{}
+
+func (m *bulkRRSetModel) tfAttributesTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"ttl":     types.Int32Type,
+		"labels":  types.MapType{ElemType: types.StringType},
+		"records": types.SetType{ElemType: (&modelRecord{}).tfType()},
+	}
+}
+
+func (m *bulkRRSetModel) tfType() attr.Type {
+	return basetypes.ObjectType{AttrTypes: m.tfAttributesTypes()}
+}
+
+func (m *bulkRRSetModel) FromAPI(ctx context.Context, hc *hcloud.ZoneRRSet) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	if hc.TTL != nil {
+		m.TTL = types.Int32Value(int32(*hc.TTL)) // nolint: gosec
+	} else {
+		m.TTL = types.Int32Null()
+	}
+
+	m.Labels, newDiags = resourceutil.LabelsMapValueFrom(ctx, hc.Labels)
+	diags.Append(newDiags...)
+
+	{
+		value := modelRecords{}
+		diags.Append(value.FromAPI(ctx, hc.Records)...)
+
+		m.Records, newDiags = value.ToTerraform(ctx)
+		diags.Append(newDiags...)
+	}
+
+	return diags
+}
+
+func (m *bulkRRSetModel) ToTerraform(ctx context.Context) (types.Object, diag.Diagnostics) {
+	return types.ObjectValueFrom(ctx, m.tfAttributesTypes(), m)
+}
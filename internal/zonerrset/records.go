@@ -0,0 +1,167 @@
+package zonerrset
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/zoneutil"
+)
+
+var spfQualifiers = []string{"+", "-", "~", "?"}
+
+// formatSPFRecord returns the quoted TXT record of a Sender Policy Framework
+// (SPF) policy, terminated by the "all" mechanism with the given qualifier.
+func formatSPFRecord(mechanisms []string, qualifier string) (string, error) {
+	if !slices.Contains(spfQualifiers, qualifier) {
+		return "", fmt.Errorf("invalid qualifier %q, must be one of %s", qualifier, strings.Join(spfQualifiers, ", "))
+	}
+
+	parts := make([]string, 0, len(mechanisms)+2)
+	parts = append(parts, "v=spf1")
+	for _, mechanism := range mechanisms {
+		if mechanism == "" || strings.ContainsFunc(mechanism, isSpace) {
+			return "", fmt.Errorf("invalid mechanism %q, must not be empty or contain spaces", mechanism)
+		}
+		parts = append(parts, mechanism)
+	}
+	parts = append(parts, qualifier+"all")
+
+	return zoneutil.FormatTXTRecord(strings.Join(parts, " ")), nil
+}
+
+var dkimKeyTypes = []string{"rsa", "ed25519"}
+
+// formatDKIMRecord returns the quoted TXT record of a DomainKeys Identified
+// Mail (DKIM) public key. The public key may be PEM encoded.
+func formatDKIMRecord(keyType, publicKey string) (string, error) {
+	if !slices.Contains(dkimKeyTypes, keyType) {
+		return "", fmt.Errorf("invalid key type %q, must be one of %s", keyType, strings.Join(dkimKeyTypes, ", "))
+	}
+
+	lines := strings.Split(publicKey, "\n")
+	lines = slices.DeleteFunc(lines, func(line string) bool {
+		return strings.HasPrefix(strings.TrimSpace(line), "-----")
+	})
+	key := strings.Join(strings.FieldsFunc(strings.Join(lines, ""), isSpace), "")
+	if key == "" {
+		return "", fmt.Errorf("public key must not be empty")
+	}
+
+	return zoneutil.FormatTXTRecord(fmt.Sprintf("v=DKIM1; k=%s; p=%s", keyType, key)), nil
+}
+
+var dmarcPolicies = []string{"none", "quarantine", "reject"}
+
+// formatDMARCRecord returns the quoted TXT record of a Domain-based Message
+// Authentication, Reporting and Conformance (DMARC) policy. The tags are
+// sorted by name, after the version and policy tags.
+func formatDMARCRecord(policy string, tags map[string]string) (string, error) {
+	if !slices.Contains(dmarcPolicies, policy) {
+		return "", fmt.Errorf("invalid policy %q, must be one of %s", policy, strings.Join(dmarcPolicies, ", "))
+	}
+
+	parts := make([]string, 0, len(tags)+2)
+	parts = append(parts, "v=DMARC1", "p="+policy)
+	for _, name := range slices.Sorted(maps.Keys(tags)) {
+		if name == "v" || name == "p" {
+			return "", fmt.Errorf("invalid tag %q, the version and policy tags are set by the function", name)
+		}
+		if strings.ContainsAny(tags[name], ";") {
+			return "", fmt.Errorf("invalid value for tag %q, must not contain semicolons", name)
+		}
+		parts = append(parts, name+"="+tags[name])
+	}
+
+	return zoneutil.FormatTXTRecord(strings.Join(parts, "; ")), nil
+}
+
+var caaTagRegexp = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+
+// formatCAARecord returns the value of a Certification Authority
+// Authorization (CAA) record.
+func formatCAARecord(flags int64, tag, value string) (string, error) {
+	if flags < 0 || flags > 255 {
+		return "", fmt.Errorf("invalid flags %d, must be between 0 and 255", flags)
+	}
+	if !caaTagRegexp.MatchString(tag) {
+		return "", fmt.Errorf("invalid tag %q, must only contain alphanumeric characters", tag)
+	}
+
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+
+	return fmt.Sprintf(`%d %s "%s"`, flags, strings.ToLower(tag), value), nil
+}
+
+// formatSRVRecord returns the value of a Service (SRV) record.
+func formatSRVRecord(priority, weight, port int64, target string) (string, error) {
+	for _, field := range []struct {
+		name  string
+		value int64
+	}{{"priority", priority}, {"weight", weight}, {"port", port}} {
+		if field.value < 0 || field.value > 65535 {
+			return "", fmt.Errorf("invalid %s %d, must be between 0 and 65535", field.name, field.value)
+		}
+	}
+	if target == "" {
+		return "", fmt.Errorf("target must not be empty")
+	}
+
+	return fmt.Sprintf("%d %d %d %s", priority, weight, port, absoluteName(target)), nil
+}
+
+// formatMXRecord returns the value of a Mail Exchange (MX) record.
+func formatMXRecord(priority int64, exchange string) (string, error) {
+	if priority < 0 || priority > 65535 {
+		return "", fmt.Errorf("invalid priority %d, must be between 0 and 65535", priority)
+	}
+	if exchange == "" {
+		return "", fmt.Errorf("exchange must not be empty")
+	}
+
+	return fmt.Sprintf("%d %s", priority, absoluteName(exchange)), nil
+}
+
+// fqdn returns the fully qualified domain name of a name relative to the zone.
+// Names ending with a dot are already fully qualified and returned as is.
+func fqdn(name, zone string) string {
+	switch {
+	case name == "" || name == "@":
+		return absoluteName(zone)
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + absoluteName(zone)
+	}
+}
+
+// relativeName returns the name relative to the zone, or "@" for the apex of
+// the zone. The name may be fully qualified, with or without the trailing dot.
+func relativeName(name, zone string) (string, error) {
+	zone = strings.TrimSuffix(zone, ".")
+	trimmed := strings.TrimSuffix(name, ".")
+
+	switch {
+	case strings.EqualFold(trimmed, zone):
+		return "@", nil
+	case len(trimmed) > len(zone) && strings.EqualFold(trimmed[len(trimmed)-len(zone)-1:], "."+zone):
+		return trimmed[:len(trimmed)-len(zone)-1], nil
+	default:
+		return "", fmt.Errorf("name %q is not part of the zone %q", name, zone)
+	}
+}
+
+// absoluteName appends the trailing dot to the name, if missing.
+func absoluteName(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\n' || r == '\r'
+}
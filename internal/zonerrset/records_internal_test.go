@@ -0,0 +1,142 @@
+package zonerrset
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatSPFRecord(t *testing.T) {
+	result, err := formatSPFRecord([]string{"include:_spf.example.com", "ip4:203.0.113.0/24"}, "~")
+	assert.NoError(t, err)
+	assert.Equal(t, `"v=spf1 include:_spf.example.com ip4:203.0.113.0/24 ~all"`, result)
+
+	result, err = formatSPFRecord(nil, "-")
+	assert.NoError(t, err)
+	assert.Equal(t, `"v=spf1 -all"`, result)
+
+	_, err = formatSPFRecord(nil, "~all")
+	assert.EqualError(t, err, `invalid qualifier "~all", must be one of +, -, ~, ?`)
+
+	_, err = formatSPFRecord([]string{"a mx"}, "-")
+	assert.EqualError(t, err, `invalid mechanism "a mx", must not be empty or contain spaces`)
+}
+
+func TestFormatDKIMRecord(t *testing.T) {
+	key := strings.Repeat("A", 300)
+
+	result, err := formatDKIMRecord("rsa", fmt.Sprintf("-----BEGIN PUBLIC KEY-----\n%s\n%s\n-----END PUBLIC KEY-----\n", key[:64], key[64:]))
+	assert.NoError(t, err)
+	value := "v=DKIM1; k=rsa; p=" + key
+	assert.Equal(t, fmt.Sprintf(`"%s" "%s"`, value[:255], value[255:]), result)
+
+	result, err = formatDKIMRecord("ed25519", "MCowBQYDK2VwAyEA")
+	assert.NoError(t, err)
+	assert.Equal(t, `"v=DKIM1; k=ed25519; p=MCowBQYDK2VwAyEA"`, result)
+
+	_, err = formatDKIMRecord("dsa", key)
+	assert.EqualError(t, err, `invalid key type "dsa", must be one of rsa, ed25519`)
+
+	_, err = formatDKIMRecord("rsa", "-----BEGIN PUBLIC KEY-----\n-----END PUBLIC KEY-----")
+	assert.EqualError(t, err, "public key must not be empty")
+}
+
+func TestFormatDMARCRecord(t *testing.T) {
+	result, err := formatDMARCRecord("reject", map[string]string{
+		"rua": "mailto:dmarc@example.com",
+		"pct": "100",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `"v=DMARC1; p=reject; pct=100; rua=mailto:dmarc@example.com"`, result)
+
+	_, err = formatDMARCRecord("deny", nil)
+	assert.EqualError(t, err, `invalid policy "deny", must be one of none, quarantine, reject`)
+
+	_, err = formatDMARCRecord("none", map[string]string{"p": "reject"})
+	assert.EqualError(t, err, `invalid tag "p", the version and policy tags are set by the function`)
+
+	_, err = formatDMARCRecord("none", map[string]string{"rua": "mailto:a@example.com; ruf=x"})
+	assert.EqualError(t, err, `invalid value for tag "rua", must not contain semicolons`)
+}
+
+func TestFormatCAARecord(t *testing.T) {
+	result, err := formatCAARecord(0, "issue", "letsencrypt.org")
+	assert.NoError(t, err)
+	assert.Equal(t, `0 issue "letsencrypt.org"`, result)
+
+	result, err = formatCAARecord(128, "IODEF", `mailto:"security"@example.com`)
+	assert.NoError(t, err)
+	assert.Equal(t, `128 iodef "mailto:\"security\"@example.com"`, result)
+
+	_, err = formatCAARecord(256, "issue", "letsencrypt.org")
+	assert.EqualError(t, err, "invalid flags 256, must be between 0 and 255")
+
+	_, err = formatCAARecord(0, "issue-wild", "letsencrypt.org")
+	assert.EqualError(t, err, `invalid tag "issue-wild", must only contain alphanumeric characters`)
+}
+
+func TestFormatSRVRecord(t *testing.T) {
+	result, err := formatSRVRecord(10, 5, 5060, "sip.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "10 5 5060 sip.example.com.", result)
+
+	result, err = formatSRVRecord(0, 0, 0, ".")
+	assert.NoError(t, err)
+	assert.Equal(t, "0 0 0 .", result)
+
+	_, err = formatSRVRecord(10, 5, 65536, "sip.example.com")
+	assert.EqualError(t, err, "invalid port 65536, must be between 0 and 65535")
+
+	_, err = formatSRVRecord(10, 5, 5060, "")
+	assert.EqualError(t, err, "target must not be empty")
+}
+
+func TestFormatMXRecord(t *testing.T) {
+	result, err := formatMXRecord(10, "mail.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "10 mail.example.com.", result)
+
+	result, err = formatMXRecord(20, "mail.example.com.")
+	assert.NoError(t, err)
+	assert.Equal(t, "20 mail.example.com.", result)
+
+	_, err = formatMXRecord(-1, "mail.example.com")
+	assert.EqualError(t, err, "invalid priority -1, must be between 0 and 65535")
+}
+
+func TestFQDN(t *testing.T) {
+	assert.Equal(t, "example.com.", fqdn("@", "example.com"))
+	assert.Equal(t, "example.com.", fqdn("", "example.com."))
+	assert.Equal(t, "www.example.com.", fqdn("www", "example.com"))
+	assert.Equal(t, "mail.example.org.", fqdn("mail.example.org.", "example.com"))
+}
+
+func TestRelativeName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		zone     string
+		expected string
+		err      string
+	}{
+		{name: "example.com", zone: "example.com", expected: "@"},
+		{name: "Example.com.", zone: "example.com.", expected: "@"},
+		{name: "www.example.com.", zone: "example.com", expected: "www"},
+		{name: "_dmarc.mail.example.com", zone: "example.com", expected: "_dmarc.mail"},
+		{name: "notexample.com", zone: "example.com", err: `name "notexample.com" is not part of the zone "example.com"`},
+		{name: "www.example.org", zone: "example.com", err: `name "www.example.org" is not part of the zone "example.com"`},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := relativeName(testCase.name, testCase.zone)
+			if testCase.err != "" {
+				assert.EqualError(t, err, testCase.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, result)
+		})
+	}
+}
@@ -0,0 +1,604 @@
+package zonerrset
+
+import (
+	"context"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/hcloudutil"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/util/resourceutil"
+)
+
+// BulkResourceType is the type name of the Hetzner Cloud Zone Resource Record Sets resource.
+const BulkResourceType = "hcloud_zone_rrsets"
+
+var _ resource.Resource = (*BulkResource)(nil)
+var _ resource.ResourceWithConfigure = (*BulkResource)(nil)
+var _ resource.ResourceWithValidateConfig = (*BulkResource)(nil)
+var _ resource.ResourceWithImportState = (*BulkResource)(nil)
+var _ resource.ResourceWithIdentity = (*BulkResource)(nil)
+
+type BulkResource struct {
+	client *hcloud.Client
+}
+
+func NewBulkResource() resource.Resource {
+	return &BulkResource{}
+}
+
+// Metadata should return the full name of the resource.
+func (r *BulkResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = BulkResourceType
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined Resource type.
+func (r *BulkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var newDiags diag.Diagnostics
+
+	r.client, newDiags = hcloudutil.ConfigureClient(req.ProviderData)
+	resp.Diagnostics.Append(newDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+var bulkRRSetKeyRegexp = regexp.MustCompile(`^[^/\s]+/[A-Z]+$`)
+
+func (r *BulkResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema.MarkdownDescription = util.MarkdownDescription(`
+Provides a Hetzner Cloud Zone Resource Record Sets (RRSets) resource, to manage many RRSets of a Zone in a single resource.
+
+All the RRSets of the Zone are read with a single paginated list request, and only the
+RRSets that differ from the configuration are created, updated or deleted. This keeps
+the plan of Zones with many records fast, compared to one ''hcloud_zone_rrset'' resource
+per RRSet.
+
+The RRSets are configured in a map, where the key is the name and type of the RRSet,
+separated by a slash, for example ''www/A'' or ''@/MX''.
+
+**Exclusive mode:**
+
+When ''exclusive'' is enabled, the RRSets of the Zone that are not configured are deleted.
+The SOA RRSet and the NS RRSet of the Zone apex (''@/NS'') are managed by the API and are
+never deleted.
+
+When importing the resource, all the RRSets of the Zone are adopted, except the SOA and
+apex NS RRSets.
+
+**RRSets managed by the API:**
+
+SOA records and the NS records of the Zone apex (''@/NS'') are managed by the Hetzner Cloud
+API and cannot be managed with this resource. Use the ''hcloud_zone_rrset'' resource instead.
+
+See the [Zone RRSets API documentation](https://docs.hetzner.cloud/reference/cloud#zone-rrsets) for more details.
+`)
+
+	resp.Schema.Attributes = map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "ID of the resource, the ID or Name of the parent Zone.",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"zone": schema.StringAttribute{
+			MarkdownDescription: "ID or Name of the parent Zone.",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"exclusive": schema.BoolAttribute{
+			MarkdownDescription: "Whether to delete the RRSets of the Zone that are not configured, except the SOA and apex NS RRSets.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"rrsets": schema.MapNestedAttribute{
+			MarkdownDescription: "Zone RRSets, keyed by the name and type of the RRSet separated by a slash (e.g. `www/A`).",
+			Required:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"ttl": schema.Int32Attribute{
+						MarkdownDescription: "Time To Live (TTL) of the Zone RRSet.",
+						Optional:            true,
+					},
+					"labels":  resourceutil.LabelsSchema(),
+					"records": recordsSchema(),
+				},
+			},
+			Validators: []validator.Map{
+				mapvalidator.KeysAre(
+					stringvalidator.RegexMatches(bulkRRSetKeyRegexp, "must be the name and type of the RRSet separated by a slash, for example www/A"),
+				),
+			},
+		},
+		"project": resourceutil.ProjectAttribute(),
+	}
+
+	resp.Schema.Blocks = map[string]schema.Block{
+		"timeouts": resourceutil.TimeoutsBlock(ctx),
+	}
+}
+
+type bulkResourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	Zone      types.String   `tfsdk:"zone"`
+	Exclusive types.Bool     `tfsdk:"exclusive"`
+	RRSets    types.Map      `tfsdk:"rrsets"`
+	Project   types.String   `tfsdk:"project"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *BulkResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"zone": identityschema.StringAttribute{
+				Description:       "ID or Name of the parent Zone.",
+				RequiredForImport: true,
+			},
+		},
+	}
+}
+
+// rrsetKey returns the key of a Zone RRSet in the map of RRSets.
+func rrsetKey(hc *hcloud.ZoneRRSet) string {
+	return hc.Name + "/" + string(hc.Type)
+}
+
+// rrsetFromKey returns the Zone RRSet identified by its key in the map of RRSets.
+func rrsetFromKey(zone *hcloud.Zone, key string) *hcloud.ZoneRRSet {
+	rrsetName, rrsetType, _ := strings.Cut(key, "/")
+	return &hcloud.ZoneRRSet{
+		Zone: zone,
+		Name: rrsetName,
+		Type: hcloud.ZoneRRSetType(rrsetType),
+	}
+}
+
+// isAPIManagedRRSet returns whether the Zone RRSet is managed by the API,
+// these RRSets are never deleted by the bulk resource.
+func isAPIManagedRRSet(hc *hcloud.ZoneRRSet) bool {
+	return hc.Type == hcloud.ZoneRRSetTypeSOA ||
+		(hc.Type == hcloud.ZoneRRSetTypeNS && hc.Name == "@")
+}
+
+func (r *BulkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data bulkResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RRSets.IsUnknown() || data.RRSets.IsNull() {
+		return
+	}
+
+	for key := range data.RRSets.Elements() {
+		if isAPIManagedRRSet(rrsetFromKey(nil, key)) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("rrsets").AtMapKey(key),
+				"RRSet is managed by the API",
+				"The SOA and apex NS RRSets cannot be managed by the "+BulkResourceType+" resource, use the hcloud_zone_rrset resource instead.",
+			)
+		}
+	}
+}
+
+// bulkRRSetsFromTerraform returns the RRSets models, keyed by RRSet key.
+func bulkRRSetsFromTerraform(ctx context.Context, tf types.Map) (map[string]bulkRRSetModel, diag.Diagnostics) {
+	result := make(map[string]bulkRRSetModel, len(tf.Elements()))
+	if tf.IsUnknown() || tf.IsNull() {
+		return result, nil
+	}
+	diags := tf.ElementsAs(ctx, &result, false)
+	return result, diags
+}
+
+// bulkRRSetsFromAPI returns the RRSets of the Zone to store in the state. The
+// managed RRSets are always returned, while the other RRSets of the Zone are
+// only returned when all must be returned, except the RRSets managed by the API.
+func bulkRRSetsFromAPI(ctx context.Context, hcItems []*hcloud.ZoneRRSet, managed map[string]bulkRRSetModel, all bool) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfItems := make(map[string]attr.Value, len(managed))
+	for _, hcItem := range hcItems {
+		key := rrsetKey(hcItem)
+		if _, ok := managed[key]; !ok && (!all || isAPIManagedRRSet(hcItem)) {
+			continue
+		}
+
+		value := bulkRRSetModel{}
+		diags.Append(value.FromAPI(ctx, hcItem)...)
+
+		tfItem, newDiags := value.ToTerraform(ctx)
+		diags.Append(newDiags...)
+
+		tfItems[key] = tfItem
+	}
+
+	tf, newDiags := types.MapValue((&bulkRRSetModel{}).tfType(), tfItems)
+	diags.Append(newDiags...)
+
+	return tf, diags
+}
+
+// recordsEqual returns whether both lists contain the same records, in any order.
+func recordsEqual(a, b []hcloud.ZoneRRSetRecord) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	compare := func(x, y hcloud.ZoneRRSetRecord) int {
+		if c := strings.Compare(x.Value, y.Value); c != 0 {
+			return c
+		}
+		return strings.Compare(x.Comment, y.Comment)
+	}
+	a = slices.SortedFunc(slices.Values(a), compare)
+	b = slices.SortedFunc(slices.Values(b), compare)
+	return slices.Equal(a, b)
+}
+
+// ttlEqual returns whether both TTL are equal, a nil TTL uses the TTL of the Zone.
+func ttlEqual(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// applyRRSets creates, updates and deletes the RRSets of the Zone, so they match
+// the planned RRSets. RRSets not planned are only deleted when they were
+// previously managed or in exclusive mode.
+func (r *BulkResource) applyRRSets(
+	ctx context.Context,
+	zone *hcloud.Zone,
+	prior map[string]bulkRRSetModel,
+	planned map[string]bulkRRSetModel,
+	exclusive bool,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current, err := r.client.Zone.AllRRSets(ctx, zone)
+	if err != nil {
+		diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return diags
+	}
+
+	currentByKey := make(map[string]*hcloud.ZoneRRSet, len(current))
+	for _, hc := range current {
+		hc.Zone = zone
+		currentByKey[rrsetKey(hc)] = hc
+	}
+
+	actions := make([]*hcloud.Action, 0)
+
+	// Delete the RRSets first, to release the records before creating others.
+	for _, hc := range current {
+		key := rrsetKey(hc)
+		if _, ok := planned[key]; ok || isAPIManagedRRSet(hc) {
+			continue
+		}
+		if _, ok := prior[key]; !ok && !exclusive {
+			continue
+		}
+
+		result, _, err := r.client.Zone.DeleteRRSet(ctx, hc)
+		if err != nil {
+			if hcloudutil.APIErrorIsNotFound(err) {
+				continue
+			}
+			diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return diags
+		}
+
+		actions = append(actions, result.Action)
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(planned)) {
+		value := planned[key]
+
+		var ttl *int
+		if !value.TTL.IsUnknown() && !value.TTL.IsNull() {
+			ttl = new(int(value.TTL.ValueInt32()))
+		}
+
+		var labels map[string]string
+		diags.Append(hcloudutil.TerraformLabelsToHCloud(ctx, value.Labels, &labels)...)
+
+		records := modelRecords{}
+		diags.Append(records.FromTerraform(ctx, value.Records)...)
+
+		hcRecords, newDiags := records.ToAPI(ctx)
+		diags.Append(newDiags...)
+
+		// If data conversion failed we should abort before sending API requests.
+		if diags.HasError() {
+			return diags
+		}
+
+		hc, ok := currentByKey[key]
+		if !ok {
+			rrsetName, rrsetType, _ := strings.Cut(key, "/")
+
+			result, _, err := r.client.Zone.CreateRRSet(ctx, zone, hcloud.ZoneRRSetCreateOpts{
+				Name:    rrsetName,
+				Type:    hcloud.ZoneRRSetType(rrsetType),
+				TTL:     ttl,
+				Labels:  labels,
+				Records: hcRecords,
+			})
+			if err != nil {
+				diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+				return diags
+			}
+
+			actions = append(actions, result.Action)
+			continue
+		}
+
+		if !ttlEqual(ttl, hc.TTL) {
+			action, _, err := r.client.Zone.ChangeRRSetTTL(ctx, hc, hcloud.ZoneRRSetChangeTTLOpts{TTL: ttl})
+			if err != nil {
+				diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+				return diags
+			}
+
+			actions = append(actions, action)
+		}
+
+		if !recordsEqual(hcRecords, hc.Records) {
+			action, _, err := r.client.Zone.SetRRSetRecords(ctx, hc, hcloud.ZoneRRSetSetRecordsOpts{Records: hcRecords})
+			if err != nil {
+				diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+				return diags
+			}
+
+			actions = append(actions, action)
+		}
+
+		if !maps.Equal(labels, hc.Labels) {
+			_, _, err := r.client.Zone.UpdateRRSet(ctx, hc, hcloud.ZoneRRSetUpdateOpts{Labels: labels})
+			if err != nil {
+				diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+				return diags
+			}
+		}
+	}
+
+	diags.Append(hcloudutil.SettleActions(ctx, &r.client.Action, actions...)...)
+
+	return diags
+}
+
+// readRRSets stores the RRSets of the Zone in the state.
+func (r *BulkResource) readRRSets(ctx context.Context, data *bulkResourceModel, managed map[string]bulkRRSetModel, all bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var newDiags diag.Diagnostics
+
+	zone := &hcloud.Zone{Name: data.Zone.ValueString()}
+
+	hcItems, err := r.client.Zone.AllRRSets(ctx, zone)
+	if err != nil {
+		diags.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return diags
+	}
+
+	data.ID = data.Zone
+	data.RRSets, newDiags = bulkRRSetsFromAPI(ctx, hcItems, managed, all)
+	diags.Append(newDiags...)
+
+	return diags
+}
+
+func (r *BulkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data bulkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "create", createTimeout)
+	defer cancel()
+
+	planned, diags := bulkRRSetsFromTerraform(ctx, data.RRSets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := &hcloud.Zone{Name: data.Zone.ValueString()}
+
+	resp.Diagnostics.Append(r.applyRRSets(ctx, zone, nil, planned, data.Exclusive.ValueBool())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readRRSets(ctx, &data, planned, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, bulkIdentityModel{Zone: data.Zone})...)
+}
+
+func (r *BulkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data bulkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, diags := bulkRRSetsFromTerraform(ctx, data.RRSets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// After an import, all the RRSets of the Zone are adopted.
+	all := data.Exclusive.ValueBool() || data.RRSets.IsNull()
+
+	zone := &hcloud.Zone{Name: data.Zone.ValueString()}
+
+	hcItems, err := r.client.Zone.AllRRSets(ctx, zone)
+	if err != nil {
+		if hcloudutil.APIErrorIsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+		return
+	}
+
+	data.ID = data.Zone
+	data.RRSets, diags = bulkRRSetsFromAPI(ctx, hcItems, managed, all)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, bulkIdentityModel{Zone: data.Zone})...)
+}
+
+func (r *BulkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, plan bulkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "update", updateTimeout)
+	defer cancel()
+
+	prior, diags := bulkRRSetsFromTerraform(ctx, data.RRSets)
+	resp.Diagnostics.Append(diags...)
+
+	planned, diags := bulkRRSetsFromTerraform(ctx, plan.RRSets)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := &hcloud.Zone{Name: plan.Zone.ValueString()}
+
+	resp.Diagnostics.Append(r.applyRRSets(ctx, zone, prior, planned, plan.Exclusive.ValueBool())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.readRRSets(ctx, &plan, planned, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, bulkIdentityModel{Zone: plan.Zone})...)
+}
+
+func (r *BulkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data bulkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, resourceutil.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := hcloudutil.ContextWithTimeout(ctx, "delete", deleteTimeout)
+	defer cancel()
+
+	prior, diags := bulkRRSetsFromTerraform(ctx, data.RRSets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actions := make([]*hcloud.Action, 0, len(prior))
+	for _, key := range slices.Sorted(maps.Keys(prior)) {
+		rrset := rrsetFromKey(&hcloud.Zone{Name: data.Zone.ValueString()}, key)
+
+		// RRSets managed by the API are only removed from the state.
+		if isAPIManagedRRSet(rrset) {
+			continue
+		}
+
+		result, _, err := r.client.Zone.DeleteRRSet(ctx, rrset)
+		if err != nil {
+			if hcloudutil.APIErrorIsNotFound(err) {
+				continue
+			}
+
+			resp.Diagnostics.Append(hcloudutil.APIErrorDiagnostics(err)...)
+			return
+		}
+
+		actions = append(actions, result.Action)
+	}
+
+	resp.Diagnostics.Append(hcloudutil.SettleActions(ctx, &r.client.Action, actions...)...)
+}
+
+func (r *BulkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var identity bulkIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), identity.Zone)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), identity.Zone)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exclusive"), false)...)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("exclusive"), false)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, bulkIdentityModel{Zone: types.StringValue(req.ID)})...)
+}
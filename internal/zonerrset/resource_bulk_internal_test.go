@@ -0,0 +1,98 @@
+package zonerrset
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+)
+
+func TestRecordsEqual(t *testing.T) {
+	a := []hcloud.ZoneRRSetRecord{
+		{Value: "201.42.91.35"},
+		{Value: "201.42.91.36", Comment: "web"},
+	}
+
+	assert.True(t, recordsEqual(a, []hcloud.ZoneRRSetRecord{
+		{Value: "201.42.91.36", Comment: "web"},
+		{Value: "201.42.91.35"},
+	}))
+	assert.False(t, recordsEqual(a, []hcloud.ZoneRRSetRecord{
+		{Value: "201.42.91.35"},
+		{Value: "201.42.91.36"},
+	}))
+	assert.False(t, recordsEqual(a, a[:1]))
+
+	// The records must not be sorted in place.
+	assert.Equal(t, "201.42.91.35", a[0].Value)
+}
+
+func TestTTLEqual(t *testing.T) {
+	assert.True(t, ttlEqual(nil, nil))
+	assert.True(t, ttlEqual(new(600), new(600)))
+	assert.False(t, ttlEqual(new(600), nil))
+	assert.False(t, ttlEqual(nil, new(600)))
+	assert.False(t, ttlEqual(new(600), new(3600)))
+}
+
+func TestIsAPIManagedRRSet(t *testing.T) {
+	assert.True(t, isAPIManagedRRSet(&hcloud.ZoneRRSet{Name: "@", Type: hcloud.ZoneRRSetTypeSOA}))
+	assert.True(t, isAPIManagedRRSet(&hcloud.ZoneRRSet{Name: "@", Type: hcloud.ZoneRRSetTypeNS}))
+	assert.False(t, isAPIManagedRRSet(&hcloud.ZoneRRSet{Name: "sub", Type: hcloud.ZoneRRSetTypeNS}))
+	assert.False(t, isAPIManagedRRSet(&hcloud.ZoneRRSet{Name: "@", Type: hcloud.ZoneRRSetTypeA}))
+}
+
+func TestRRSetFromKey(t *testing.T) {
+	zone := &hcloud.Zone{Name: "example.com"}
+
+	rrset := rrsetFromKey(zone, "www/A")
+	assert.Equal(t, zone, rrset.Zone)
+	assert.Equal(t, "www", rrset.Name)
+	assert.Equal(t, hcloud.ZoneRRSetTypeA, rrset.Type)
+	assert.Equal(t, "www/A", rrsetKey(rrset))
+
+	assert.True(t, isAPIManagedRRSet(rrsetFromKey(zone, "@/SOA")))
+	assert.True(t, isAPIManagedRRSet(rrsetFromKey(zone, "@/NS")))
+	assert.False(t, isAPIManagedRRSet(rrsetFromKey(zone, "sub/NS")))
+}
+
+func TestBulkRRSetsFromAPI(t *testing.T) {
+	ctx := context.Background()
+
+	hcItems := []*hcloud.ZoneRRSet{
+		{Name: "@", Type: hcloud.ZoneRRSetTypeSOA, Records: []hcloud.ZoneRRSetRecord{{Value: "hydrogen.ns.hetzner.com. dns.hetzner.com. 2025102142 86400 10800 3600000 3600"}}},
+		{Name: "@", Type: hcloud.ZoneRRSetTypeNS, Records: []hcloud.ZoneRRSetRecord{{Value: "hydrogen.ns.hetzner.com."}}},
+		{Name: "www", Type: hcloud.ZoneRRSetTypeA, TTL: new(600), Labels: map[string]string{"key": "value"}, Records: []hcloud.ZoneRRSetRecord{{Value: "201.42.91.35"}}},
+		{Name: "mail", Type: hcloud.ZoneRRSetTypeA, Records: []hcloud.ZoneRRSetRecord{{Value: "201.42.91.36", Comment: "mail server"}}},
+	}
+	managed := map[string]bulkRRSetModel{"www/A": {}, "gone/A": {}}
+
+	t.Run("managed", func(t *testing.T) {
+		result, diags := bulkRRSetsFromAPI(ctx, hcItems, managed, false)
+		assert.False(t, diags.HasError())
+
+		rrsets, diags := bulkRRSetsFromTerraform(ctx, result)
+		assert.False(t, diags.HasError())
+
+		assert.Len(t, rrsets, 1)
+		assert.Equal(t, int32(600), rrsets["www/A"].TTL.ValueInt32())
+		assert.Equal(t, types.StringValue("value"), rrsets["www/A"].Labels.Elements()["key"])
+		assert.Len(t, rrsets["www/A"].Records.Elements(), 1)
+	})
+
+	t.Run("all", func(t *testing.T) {
+		result, diags := bulkRRSetsFromAPI(ctx, hcItems, managed, true)
+		assert.False(t, diags.HasError())
+
+		rrsets, diags := bulkRRSetsFromTerraform(ctx, result)
+		assert.False(t, diags.HasError())
+
+		assert.Len(t, rrsets, 2)
+		assert.Contains(t, rrsets, "www/A")
+		assert.Contains(t, rrsets, "mail/A")
+		assert.True(t, rrsets["mail/A"].TTL.IsNull())
+	})
+}
@@ -0,0 +1,264 @@
+package zonerrset_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hetznercloud/hcloud-go/v2/hcloud"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/exp/kit/randutil"
+	"github.com/hetznercloud/hcloud-go/v2/hcloud/schema"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/teste2e"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testmux"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testsupport"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/testtemplate"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/zone"
+	"github.com/hetznercloud/terraform-provider-hcloud/internal/zonerrset"
+)
+
+func TestAccZoneRRSetsResource(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	resZone := &zone.RData{
+		Zone: schema.Zone{
+			Name: fmt.Sprintf("example-%s.com", randutil.GenerateID()),
+			Mode: "primary",
+		},
+	}
+	resZone.SetRName("main")
+
+	res1 := &zonerrset.RDataBulk{
+		Zone: resZone.TFID() + ".name",
+		RRSets: map[string]schema.ZoneRRSet{
+			"www/A": {
+				TTL:    new(10800),
+				Labels: map[string]string{"key": "value"},
+				Records: []schema.ZoneRRSetRecord{
+					{Value: "201.42.91.35"},
+					{Value: "201.42.91.36", Comment: "some web server"},
+				},
+			},
+			"@/MX": {
+				Records: []schema.ZoneRRSetRecord{
+					{Value: "10 mail.example.com."},
+				},
+			},
+		},
+	}
+	res1.SetRName("main")
+
+	res2 := &zonerrset.RDataBulk{
+		Zone: res1.Zone,
+		RRSets: map[string]schema.ZoneRRSet{
+			"www/A": {
+				TTL: new(600),
+				Records: []schema.ZoneRRSetRecord{
+					{Value: "42.42.91.35"},
+				},
+			},
+			"mail/A": {
+				Records: []schema.ZoneRRSetRecord{
+					{Value: "42.42.91.36"},
+				},
+			},
+		},
+	}
+	res2.SetRName("main")
+
+	res3 := &zonerrset.RDataBulk{
+		Zone:      res1.Zone,
+		Exclusive: true,
+		RRSets:    res2.RRSets,
+	}
+	res3.SetRName("main")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		CheckDestroy:             testsupport.CheckAPIResourceAllAbsent(zone.ResourceType, zone.GetAPIResource()),
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_zone", resZone,
+					"testdata/r/hcloud_zone_rrsets", res1,
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(res1.TFID(), tfjsonpath.New("exclusive"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(res1.TFID(), tfjsonpath.New("rrsets"), knownvalue.MapSizeExact(2)),
+					statecheck.ExpectKnownValue(res1.TFID(), tfjsonpath.New("rrsets").AtMapKey("www/A").AtMapKey("ttl"), knownvalue.Int32Exact(10800)),
+					statecheck.ExpectKnownValue(res1.TFID(), tfjsonpath.New("rrsets").AtMapKey("www/A").AtMapKey("labels"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"key": knownvalue.StringExact("value"),
+					})),
+					statecheck.ExpectKnownValue(res1.TFID(),
+						tfjsonpath.New("rrsets").AtMapKey("www/A").AtMapKey("records"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"value":   knownvalue.StringExact("201.42.91.35"),
+								"comment": knownvalue.Null(),
+							}),
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"value":   knownvalue.StringExact("201.42.91.36"),
+								"comment": knownvalue.StringExact("some web server"),
+							}),
+						})),
+					statecheck.ExpectKnownValue(res1.TFID(), tfjsonpath.New("rrsets").AtMapKey("@/MX").AtMapKey("ttl"), knownvalue.Null()),
+				},
+			},
+			{
+				ResourceName:      res1.TFID(),
+				ImportStateId:     resZone.Name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_zone", resZone,
+					"testdata/r/hcloud_zone_rrsets", res2,
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(res2.TFID(), tfjsonpath.New("rrsets"), knownvalue.MapSizeExact(2)),
+					statecheck.ExpectKnownValue(res2.TFID(), tfjsonpath.New("rrsets").AtMapKey("www/A").AtMapKey("ttl"), knownvalue.Int32Exact(600)),
+					statecheck.ExpectKnownValue(res2.TFID(), tfjsonpath.New("rrsets").AtMapKey("www/A").AtMapKey("labels"), knownvalue.MapSizeExact(0)),
+					statecheck.ExpectKnownValue(res2.TFID(), tfjsonpath.New("rrsets").AtMapKey("mail/A").AtMapKey("records"), knownvalue.SetSizeExact(1)),
+				},
+			},
+			{
+				PreConfig: func() {
+					ctx := t.Context()
+					client, err := testsupport.CreateClient()
+					require.NoError(t, err, "failed to create client")
+
+					result, _, err := client.Zone.CreateRRSet(ctx, &hcloud.Zone{Name: resZone.Name}, hcloud.ZoneRRSetCreateOpts{
+						Name:    "unmanaged",
+						Type:    hcloud.ZoneRRSetTypeTXT,
+						Records: []hcloud.ZoneRRSetRecord{{Value: `"hello world"`}},
+					})
+					require.NoError(t, err, "failed to trigger create rrset")
+
+					err = client.Action.WaitFor(ctx, result.Action)
+					require.NoError(t, err, "failed to create rrset")
+				},
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_zone", resZone,
+					"testdata/r/hcloud_zone_rrsets", res3,
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(res3.TFID(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: func(_ *terraform.State) error {
+					client, err := testsupport.CreateClient()
+					if err != nil {
+						return err
+					}
+					rrset, _, err := client.Zone.GetRRSetByNameAndType(t.Context(), &hcloud.Zone{Name: resZone.Name}, "unmanaged", hcloud.ZoneRRSetTypeTXT)
+					if err != nil {
+						return err
+					}
+					if rrset != nil {
+						return fmt.Errorf("unmanaged rrset was not deleted")
+					}
+					return nil
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(res3.TFID(), tfjsonpath.New("exclusive"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(res3.TFID(), tfjsonpath.New("rrsets"), knownvalue.MapSizeExact(2)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccZoneRRSetsResource_Identity(t *testing.T) {
+	tmplMan := testtemplate.Manager{}
+
+	resZone := &zone.RData{
+		Zone: schema.Zone{
+			Name: fmt.Sprintf("example-%s.com", randutil.GenerateID()),
+			Mode: "primary",
+		},
+	}
+	resZone.SetRName("main")
+
+	res := &zonerrset.RDataBulk{
+		Zone: resZone.TFID() + ".name",
+		RRSets: map[string]schema.ZoneRRSet{
+			"www/A": {
+				Records: []schema.ZoneRRSetRecord{
+					{Value: "201.42.91.35"},
+				},
+			},
+		},
+	}
+	res.SetRName("main")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 teste2e.PreCheck(t),
+		ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+		CheckDestroy:             testsupport.CheckAPIResourceAllAbsent(zone.ResourceType, zone.GetAPIResource()),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: tmplMan.Render(t,
+					"testdata/r/hcloud_zone", resZone,
+					"testdata/r/hcloud_zone_rrsets", res,
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState(res.TFID(), tfjsonpath.New("zone")),
+				},
+			},
+			{
+				ResourceName:    res.TFID(),
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
+func TestZoneRRSetsResource_ValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	tmplMan := testtemplate.Manager{}
+
+	for _, key := range []string{"@/SOA", "@/NS"} {
+		t.Run(key, func(t *testing.T) {
+			t.Parallel()
+
+			res := &zonerrset.RDataBulk{
+				Zone: `"example.com"`,
+				RRSets: map[string]schema.ZoneRRSet{
+					key: {
+						Records: []schema.ZoneRRSetRecord{
+							{Value: "hydrogen.ns.hetzner.com."},
+						},
+					},
+				},
+			}
+			res.SetRName("main")
+
+			resource.UnitTest(t, resource.TestCase{
+				ProtoV6ProviderFactories: testmux.ProtoV6ProviderFactories(),
+				Steps: []resource.TestStep{
+					{
+						Config:      tmplMan.Render(t, "testdata/r/hcloud_zone_rrsets", res),
+						PlanOnly:    true,
+						ExpectError: regexp.MustCompile(`RRSet is managed by the API`),
+					},
+				},
+			})
+		})
+	}
+}
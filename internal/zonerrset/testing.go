@@ -61,3 +61,18 @@ type RData struct {
 func (d *RData) TFID() string {
 	return fmt.Sprintf("%s.%s", ResourceType, d.RName())
 }
+
+// RDataBulk defines the fields for the "testdata/r/hcloud_zone_rrsets" template.
+type RDataBulk struct {
+	testtemplate.DataCommon
+	Raw string
+
+	Zone      string
+	Exclusive bool
+	RRSets    map[string]schema.ZoneRRSet
+}
+
+// TFID returns the resource identifier.
+func (d *RDataBulk) TFID() string {
+	return fmt.Sprintf("%s.%s", BulkResourceType, d.RName())
+}